@@ -50,6 +50,8 @@ type FilerOptions struct {
 	saveToFilerLimit        *int
 	defaultLevelDbDirectory *string
 	concurrentUploadLimitMB *int
+	lifecycleScanInterval   *time.Duration
+	trashPurgeInterval      *time.Duration
 }
 
 func init() {
@@ -73,6 +75,8 @@ func init() {
 	f.saveToFilerLimit = cmdFiler.Flag.Int("saveToFilerLimit", 0, "files smaller than this limit will be saved in filer store")
 	f.defaultLevelDbDirectory = cmdFiler.Flag.String("defaultStoreDir", ".", "if filer.toml is empty, use an embedded filer store in the directory")
 	f.concurrentUploadLimitMB = cmdFiler.Flag.Int("concurrentUploadLimitMB", 128, "limit total concurrent upload size")
+	f.lifecycleScanInterval = cmdFiler.Flag.Duration("lifecycleScanInterval", time.Hour, "interval to apply S3 bucket lifecycle rules, 0 to disable")
+	f.trashPurgeInterval = cmdFiler.Flag.Duration("trashPurgeInterval", time.Hour, "interval to delete the expired entries in the trash, 0 to disable")
 
 	// start s3 on filer
 	filerStartS3 = cmdFiler.Flag.Bool("s3", false, "whether to start S3 gateway")
@@ -82,6 +86,8 @@ func init() {
 	filerS3Options.tlsCertificate = cmdFiler.Flag.String("s3.cert.file", "", "path to the TLS certificate file")
 	filerS3Options.config = cmdFiler.Flag.String("s3.config", "", "path to the config file")
 	filerS3Options.allowEmptyFolder = cmdFiler.Flag.Bool("s3.allowEmptyFolder", false, "allow empty folders")
+	filerS3Options.websitePort = cmdFiler.Flag.Int("s3.websitePort", 0, "s3 static website http listen port, 0 to disable")
+	filerS3Options.websiteDomainName = cmdFiler.Flag.String("s3.websiteDomainName", "", "suffix of the website host name in comma separated list, {bucket}.{websiteDomainName}")
 
 	// start webdav on filer
 	filerStartWebDav = cmdFiler.Flag.Bool("webdav", false, "whether to start webdav gateway")
@@ -194,6 +200,8 @@ func (fo *FilerOptions) startFiler() {
 		SaveToFilerLimit:      int64(*fo.saveToFilerLimit),
 		Filers:                peers,
 		ConcurrentUploadLimit: int64(*fo.concurrentUploadLimitMB) * 1024 * 1024,
+		LifecycleScanInterval: *fo.lifecycleScanInterval,
+		TrashPurgeInterval:    *fo.trashPurgeInterval,
 	})
 	if nfs_err != nil {
 		glog.Fatalf("Filer startup error: %v", nfs_err)
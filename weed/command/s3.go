@@ -23,14 +23,16 @@ var (
 )
 
 type S3Options struct {
-	filer            *string
-	port             *int
-	config           *string
-	domainName       *string
-	tlsPrivateKey    *string
-	tlsCertificate   *string
-	metricsHttpPort  *int
-	allowEmptyFolder *bool
+	filer             *string
+	port              *int
+	config            *string
+	domainName        *string
+	tlsPrivateKey     *string
+	tlsCertificate    *string
+	metricsHttpPort   *int
+	allowEmptyFolder  *bool
+	websitePort       *int
+	websiteDomainName *string
 }
 
 func init() {
@@ -43,6 +45,8 @@ func init() {
 	s3StandaloneOptions.tlsCertificate = cmdS3.Flag.String("cert.file", "", "path to the TLS certificate file")
 	s3StandaloneOptions.metricsHttpPort = cmdS3.Flag.Int("metricsPort", 0, "Prometheus metrics listen port")
 	s3StandaloneOptions.allowEmptyFolder = cmdS3.Flag.Bool("allowEmptyFolder", false, "allow empty folders")
+	s3StandaloneOptions.websitePort = cmdS3.Flag.Int("websitePort", 0, "static website http listen port, 0 to disable")
+	s3StandaloneOptions.websiteDomainName = cmdS3.Flag.String("websiteDomainName", "", "suffix of the website host name in comma separated list, {bucket}.{websiteDomainName}")
 }
 
 var cmdS3 = &Command{
@@ -104,6 +108,25 @@ var cmdS3 = &Command{
         "Write"
       ]
     },
+    {
+      "name": "some_tenant_user",
+      "credentials": [
+        {
+          "accessKey": "some_access_key5",
+          "secretKey": "some_secret_key5"
+        }
+      ],
+      "actions": [
+        "Read",
+        "List",
+        "Write"
+      ],
+      "account": "tenant1",
+      "rateLimit": {
+        "writeRequests": 100,
+        "writeBytes": 104857600
+      }
+    },
     {
       "name": "user_limited_to_bucket1",
       "credentials": [
@@ -119,9 +142,82 @@ var cmdS3 = &Command{
         "Write:bucket1"
       ]
     }
-  ]
+  ],
+  "roles": [
+    {
+      "name": "deployer",
+      "actions": [
+        "Read:releases",
+        "Write:releases"
+      ],
+      "trustedIdentities": [
+        "some_normal_user"
+      ],
+      "trustedProviders": [
+        "ci"
+      ],
+      "trustedSubjects": [
+        "repo:some_org/*"
+      ],
+      "maxSessionDurationSeconds": 3600
+    }
+  ],
+  "webIdentityProviders": [
+    {
+      "name": "ci",
+      "issuer": "https://token.actions.githubusercontent.com",
+      "audiences": [
+        "seaweedfs"
+      ]
+    }
+  ],
+  "sessionSigningKey": "some_random_session_signing_key",
+  "bucketRateLimits": {
+    "bucket1": {
+      "readRequests": 1000,
+      "readBytes": 524288000
+    }
+  }
 }
 
+	The roles can be assumed with temporary credentials from the STS api on the same port,
+	with AssumeRole by the trusted identities, or AssumeRoleWithWebIdentity by the OpenID Connect
+	tokens of the trusted providers. GetSessionToken returns temporary credentials of the caller.
+	The STS api is disabled if "sessionSigningKey" is not set.
+
+	The identities with an "account" have a separate bucket namespace. Their bucket "logs" is kept
+	in the filer as "<buckets dir>/tenant1@logs", and ListBuckets only shows the buckets of the account.
+	Their actions only apply to the buckets of the account. The buckets of other accounts are named
+	as "tenant1@logs", and are only accessible by their bucket policies or acls.
+
+	The "rateLimit" of an identity limits the requests per second and the bytes per second of each
+	of its access keys, and "bucketRateLimits" limit the requests of all the users of a bucket, by the
+	bucket name in the filer, e.g. "tenant1@logs" for the bucket "logs" of the account "tenant1".
+	The reads are GET and HEAD requests, and the writes are all the others.
+	The requests over the limit are rejected with SlowDown, and the data transfer is slowed down to
+	the bandwidth. 0 means no limit.
+
+	The buckets with access logging turned on by PutBucketLogging log each request in the AWS server
+	access log format, with the requester, operation, key, status, bytes, latency and error code.
+	The logs are buffered, and written every minute as objects under the target prefix of the target
+	bucket, which must be owned by the same user and account.
+
+	The buckets with replication rules, set by PutBucketReplication, are replicated by the filers to the
+	destination buckets, addressed as "arn:seaweed:s3:<target>::<bucket>" for the [s3_replication.<target>]
+	endpoints in the notification.toml of the filers. The replication status of an object is returned
+	in the "x-amz-replication-status" header of HEAD and GET, as PENDING, COMPLETED, FAILED, or REPLICA
+	for the replicas. The objects pending when the filer stops, and the objects encrypted with customer
+	keys, are not replicated.
+
+	CopyObject and UploadPartCopy, with or without x-amz-copy-source-range, are done by the filer. The whole
+	chunks of the copy source are copied from volume server to volume server, and only the partial chunks
+	at the ends of the range are read by the filer, so the data is not read through the s3 gateway.
+
+	With -websitePort, the buckets with a website configuration, set by PutBucketWebsite, are also
+	served as static web sites on the port, as {bucket}.{websiteDomainName} or as /{bucket}/.
+	Only the objects readable by anonymous users, by the "anonymous" identity, the bucket policy or
+	the acls, are served.
+
 `,
 }
 
@@ -175,20 +271,37 @@ func (s3opt *S3Options) startS3Server() bool {
 
 	router := mux.NewRouter().SkipClean(true)
 
-	_, s3ApiServer_err := s3api.NewS3ApiServer(router, &s3api.S3ApiServerOption{
-		Filer:            *s3opt.filer,
-		Port:             *s3opt.port,
-		FilerGrpcAddress: filerGrpcAddress,
-		Config:           *s3opt.config,
-		DomainName:       *s3opt.domainName,
-		BucketsPath:      filerBucketsPath,
-		GrpcDialOption:   grpcDialOption,
-		AllowEmptyFolder: *s3opt.allowEmptyFolder,
+	s3ApiServer, s3ApiServer_err := s3api.NewS3ApiServer(router, &s3api.S3ApiServerOption{
+		Filer:             *s3opt.filer,
+		Port:              *s3opt.port,
+		FilerGrpcAddress:  filerGrpcAddress,
+		Config:            *s3opt.config,
+		DomainName:        *s3opt.domainName,
+		BucketsPath:       filerBucketsPath,
+		GrpcDialOption:    grpcDialOption,
+		AllowEmptyFolder:  *s3opt.allowEmptyFolder,
+		WebsiteDomainName: *s3opt.websiteDomainName,
 	})
 	if s3ApiServer_err != nil {
 		glog.Fatalf("S3 API Server startup error: %v", s3ApiServer_err)
 	}
 
+	if *s3opt.websitePort > 0 {
+		websiteRouter := mux.NewRouter().SkipClean(true)
+		s3ApiServer.RegisterWebsiteRouter(websiteRouter, *s3opt.websitePort)
+		websiteListenAddress := fmt.Sprintf(":%d", *s3opt.websitePort)
+		websiteListener, err := util.NewListener(websiteListenAddress, time.Duration(10)*time.Second)
+		if err != nil {
+			glog.Fatalf("S3 website listener on %s error: %v", websiteListenAddress, err)
+		}
+		go func() {
+			glog.V(0).Infof("Start Seaweed S3 website endpoint at http port %d", *s3opt.websitePort)
+			if err := http.Serve(websiteListener, websiteRouter); err != nil {
+				glog.Fatalf("S3 website endpoint Fail to serve: %v", err)
+			}
+		}()
+	}
+
 	httpS := &http.Server{Handler: router}
 
 	listenAddress := fmt.Sprintf(":%d", *s3opt.port)
@@ -352,6 +352,44 @@ enabled = false
 # create binding myexchange => myqueue
 topic_url = "rabbit://myexchange"
 sub_url = "rabbit://myqueue"
+
+####################################################
+# s3 event notifications
+# send the S3 events of the buckets, in the AWS S3 event format, to the targets below.
+# Each bucket chooses the targets and the events by PutBucketNotificationConfiguration,
+# with the target ARN as the Queue, Topic, or CloudFunction, e.g., "arn:seaweed:sqs:::webhook"
+####################################################
+[s3_event.webhook]
+enabled = false
+endpoint = "http://localhost:8080/s3-events"
+auth_token = ""                       # sent as "Authorization: Bearer <auth_token>" if not empty
+max_retries = 5
+
+[s3_event.broker]
+# the SeaweedFS message broker started by "weed msgBroker"
+enabled = false
+brokers = [
+  "localhost:17777"
+]
+namespace = "s3"
+topic = "bucket_events"
+max_retries = 5
+
+####################################################
+# s3 bucket replication
+# replicate the objects of the buckets to the S3 endpoints below, e.g., another SeaweedFS S3 gateway.
+# To another SeaweedFS S3 gateway with identities, use the credentials of an admin identity, so the replicas are
+# marked and not replicated back.
+# Each bucket chooses the objects and the destination buckets by PutBucketReplication,
+# with the destination bucket ARN as "arn:seaweed:s3:<target>::<bucket>", e.g., "arn:seaweed:s3:backup::photos"
+####################################################
+[s3_replication.backup]
+enabled = false
+endpoint = "http://localhost:8334"
+region = "us-east-1"
+aws_access_key_id = ""                # if empty, loads from the shared credentials file (~/.aws/credentials).
+aws_secret_access_key = ""            # if empty, loads from the shared credentials file (~/.aws/credentials).
+max_retries = 5
 `
 
 	REPLICATION_TOML_EXAMPLE = `
@@ -452,6 +490,13 @@ expires_after_seconds = 10           # seconds
 key = ""
 expires_after_seconds = 10           # seconds
 
+# the jwt signing key is read by filer and s3 gateway.
+# the s3 gateway signs the requests bypassing the GOVERNANCE retention of the object lock,
+# which the filer refuses if this key is not set.
+[jwt.filer_signing]
+key = ""
+expires_after_seconds = 10           # seconds
+
 # all grpc tls authentications are mutual
 # the values for the following ca, cert, and key are paths to the PERM files.
 # the host name is not checked, so the PERM files can be shared.
@@ -495,6 +540,13 @@ enabled = true
 cert = ""
 key  = ""
 
+# the master key of the S3 server side encryption with S3 managed keys (SSE-S3), read by the filer.
+# the data key of each chunk is encrypted by the master key before being saved in the filer metadata.
+# only one key management service can be enabled.
+[kms.local]
+enabled = false
+key_file = ""                        # a base64 encoded 256 bit key, e.g., generated by "openssl rand -base64 32"
+
 
 `
 
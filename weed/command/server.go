@@ -101,6 +101,8 @@ func init() {
 	filerOptions.peers = cmdServer.Flag.String("filer.peers", "", "all filers sharing the same filer store in comma separated ip:port list")
 	filerOptions.saveToFilerLimit = cmdServer.Flag.Int("filer.saveToFilerLimit", 0, "Small files smaller than this limit can be cached in filer store.")
 	filerOptions.concurrentUploadLimitMB = cmdServer.Flag.Int("filer.concurrentUploadLimitMB", 64, "limit total concurrent upload size")
+	filerOptions.lifecycleScanInterval = cmdServer.Flag.Duration("filer.lifecycleScanInterval", time.Hour, "interval to apply S3 bucket lifecycle rules, 0 to disable")
+	filerOptions.trashPurgeInterval = cmdServer.Flag.Duration("filer.trashPurgeInterval", time.Hour, "interval to delete the expired entries in the trash, 0 to disable")
 
 	serverOptions.v.port = cmdServer.Flag.Int("volume.port", 8080, "volume server http listen port")
 	serverOptions.v.publicPort = cmdServer.Flag.Int("volume.port.public", 0, "volume server public port")
@@ -123,6 +125,8 @@ func init() {
 	s3Options.tlsCertificate = cmdServer.Flag.String("s3.cert.file", "", "path to the TLS certificate file")
 	s3Options.config = cmdServer.Flag.String("s3.config", "", "path to the config file")
 	s3Options.allowEmptyFolder = cmdServer.Flag.Bool("s3.allowEmptyFolder", false, "allow empty folders")
+	s3Options.websitePort = cmdServer.Flag.Int("s3.websitePort", 0, "s3 static website http listen port, 0 to disable")
+	s3Options.websiteDomainName = cmdServer.Flag.String("s3.websiteDomainName", "", "suffix of the website host name in comma separated list, {bucket}.{websiteDomainName}")
 
 	webdavOptions.port = cmdServer.Flag.Int("webdav.port", 7333, "webdav server http listen port")
 	webdavOptions.collection = cmdServer.Flag.String("webdav.collection", "", "collection to create the files")
@@ -42,6 +42,9 @@ type Entry struct {
 	HardLinkId      HardLinkId
 	HardLinkCounter int32
 	Content         []byte
+
+	// only for directories returned to the clients, not stored with the entry
+	DirectoryStats *DirectoryStats `json:",omitempty"`
 }
 
 func (entry *Entry) Size() uint64 {
@@ -60,7 +63,7 @@ func (entry *Entry) ToProtoEntry() *filer_pb.Entry {
 	if entry == nil {
 		return nil
 	}
-	return &filer_pb.Entry{
+	message := &filer_pb.Entry{
 		Name:            entry.FullPath.Name(),
 		IsDirectory:     entry.IsDirectory(),
 		Attributes:      EntryAttributeToPb(entry),
@@ -70,6 +73,8 @@ func (entry *Entry) ToProtoEntry() *filer_pb.Entry {
 		HardLinkCounter: entry.HardLinkCounter,
 		Content:         entry.Content,
 	}
+	message.Attributes.DirectoryStats = entry.DirectoryStats.ToPb()
+	return message
 }
 
 func (entry *Entry) ToProtoFullEntry() *filer_pb.FullEntry {
@@ -99,9 +104,11 @@ func FromPbEntry(dir string, entry *filer_pb.Entry) *Entry {
 		FullPath:        util.NewFullPath(dir, entry.Name),
 		Attr:            PbToEntryAttribute(entry.Attributes),
 		Chunks:          entry.Chunks,
+		Extended:        entry.Extended,
 		HardLinkId:      HardLinkId(entry.HardLinkId),
 		HardLinkCounter: entry.HardLinkCounter,
 		Content:         entry.Content,
+		DirectoryStats:  PbToDirectoryStats(entry.Attributes.GetDirectoryStats()),
 	}
 }
 
@@ -94,6 +94,16 @@ func fetchChunk(lookupFileIdFn wdclient.LookupFileIdFunctionType, fileId string,
 	return retriedFetchChunkData(urlStrings, cipherKey, isGzipped, true, 0, 0)
 }
 
+// FetchChunkView reads the data of one chunk view
+func FetchChunkView(lookupFileIdFn wdclient.LookupFileIdFunctionType, chunkView *ChunkView) ([]byte, error) {
+	urlStrings, err := lookupFileIdFn(chunkView.FileId)
+	if err != nil {
+		glog.Errorf("operation LookupFileId %s failed, err: %v", chunkView.FileId, err)
+		return nil, err
+	}
+	return retriedFetchChunkData(urlStrings, chunkView.CipherKey, chunkView.IsGzipped, chunkView.IsFullChunk(), chunkView.Offset, int(chunkView.Size))
+}
+
 func retriedFetchChunkData(urlStrings []string, cipherKey []byte, isGzipped bool, isFullChunk bool, offset int64, size int) ([]byte, error) {
 
 	var err error
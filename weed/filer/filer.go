@@ -2,9 +2,11 @@ package filer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/grpc"
@@ -25,12 +27,15 @@ const (
 var (
 	OS_UID = uint32(os.Getuid())
 	OS_GID = uint32(os.Getgid())
+
+	ErrEntryExists = errors.New("EEXIST")
 )
 
 type Filer struct {
 	Store               VirtualFilerStore
 	MasterClient        *wdclient.MasterClient
 	fileIdDeletionQueue *util.UnboundedQueue
+	dedupDeletionQueue  *util.UnboundedQueue
 	GrpcDialOption      grpc.DialOption
 	DirBucketsPath      string
 	FsyncBuckets        []string
@@ -42,6 +47,16 @@ type Filer struct {
 	MetaAggregator      *MetaAggregator
 	Signature           int32
 	FilerConf           *FilerConf
+	quota               *QuotaTracker
+	// the exclusive creates of the same entry are serialized, so only one of them succeeds
+	pathLocks          pathLocks
+	snapshots          *snapshotCatalog
+	snapshotChunkLock  sync.Mutex
+	dedupLock          sync.Mutex
+	dedupUsed          int32
+	dirStatsLock       sync.Mutex
+	dirStats           dirStatsBatch
+	dirStatsRebuilding int32
 }
 
 func NewFiler(masters []string, grpcDialOption grpc.DialOption,
@@ -49,9 +64,12 @@ func NewFiler(masters []string, grpcDialOption grpc.DialOption,
 	f := &Filer{
 		MasterClient:        wdclient.NewMasterClient(grpcDialOption, "filer", filerHost, filerGrpcPort, dataCenter, masters),
 		fileIdDeletionQueue: util.NewUnboundedQueue(),
+		dedupDeletionQueue:  util.NewUnboundedQueue(),
 		GrpcDialOption:      grpcDialOption,
 		FilerConf:           NewFilerConf(),
+		snapshots:           &snapshotCatalog{snapshots: make(map[util.FullPath]map[string]*Snapshot)},
 	}
+	f.quota = newQuotaTracker(f)
 	f.LocalMetaLogBuffer = log_buffer.NewLogBuffer(LogFlushInterval, f.logFlushFunc, notifyFn)
 	f.metaLogCollection = collection
 	f.metaLogReplication = replication
@@ -134,6 +152,18 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry, o_excl bool, isFr
 		return nil
 	}
 
+	if err := f.checkSnapshotWrite(entry.FullPath); err != nil {
+		return err
+	}
+
+	if o_excl {
+		unlock, err := f.lockStorePath(exclusiveCreateLockName, entry.FullPath)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
+
 	oldEntry, _ := f.FindEntry(ctx, entry.FullPath)
 
 	/*
@@ -159,7 +189,7 @@ func (f *Filer) CreateEntry(ctx context.Context, entry *Entry, o_excl bool, isFr
 	} else {
 		if o_excl {
 			glog.V(3).Infof("EEXIST: entry %s already exists", entry.FullPath)
-			return fmt.Errorf("EEXIST: entry %s already exists", entry.FullPath)
+			return fmt.Errorf("%w: entry %s already exists", ErrEntryExists, entry.FullPath)
 		}
 		glog.V(4).Infof("UpdateEntry %s: old entry: %v", entry.FullPath, oldEntry.Name())
 		if err := f.UpdateEntry(ctx, oldEntry, entry); err != nil {
@@ -238,6 +268,9 @@ func (f *Filer) ensureParentDirecotryEntry(ctx context.Context, entry *Entry, di
 }
 
 func (f *Filer) UpdateEntry(ctx context.Context, oldEntry, entry *Entry) (err error) {
+	if err = f.checkSnapshotWrite(entry.FullPath); err != nil {
+		return err
+	}
 	if oldEntry != nil {
 		entry.Attr.Crtime = oldEntry.Attr.Crtime
 		if oldEntry.IsDirectory() && !entry.IsDirectory() {
@@ -248,6 +281,10 @@ func (f *Filer) UpdateEntry(ctx context.Context, oldEntry, entry *Entry) (err er
 			glog.Errorf("existing %s is a file", oldEntry.FullPath)
 			return fmt.Errorf("existing %s is a file", oldEntry.FullPath)
 		}
+		if err := checkUpdateObjectLock(ctx, oldEntry, entry, time.Now()); err != nil {
+			glog.V(1).Infof("update entry: %v", err)
+			return err
+		}
 	}
 	return f.Store.UpdateEntry(ctx, entry)
 }
@@ -270,6 +307,9 @@ func (f *Filer) FindEntry(ctx context.Context, p util.FullPath) (entry *Entry, e
 	if string(p) == "/" {
 		return Root, nil
 	}
+	if snapshotEntry, isSnapshot, snapshotErr := f.findSnapshotEntry(ctx, p); isSnapshot {
+		return snapshotEntry, snapshotErr
+	}
 	entry, err = f.Store.FindEntry(ctx, p)
 	if entry != nil && entry.TtlSec > 0 {
 		if entry.Crtime.Add(time.Duration(entry.TtlSec) * time.Second).Before(time.Now()) {
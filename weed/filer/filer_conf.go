@@ -114,6 +114,34 @@ func (fc *FilerConf) MatchStorageRule(path string) (pathConf *filer_pb.FilerConf
 	return pathConf
 }
 
+// MatchQuotaRules returns all the location rules with quotas for the path, which are enforced separately
+func (fc *FilerConf) MatchQuotaRules(path string) (pathConfs []*filer_pb.FilerConf_PathConf) {
+	fc.rules.MatchPrefix([]byte(path), func(key []byte, value interface{}) bool {
+		t := value.(*filer_pb.FilerConf_PathConf)
+		if hasQuota(t) {
+			pathConfs = append(pathConfs, t)
+		}
+		return true
+	})
+	return
+}
+
+// QuotaRules returns all the location rules with quotas
+func (fc *FilerConf) QuotaRules() (pathConfs []*filer_pb.FilerConf_PathConf) {
+	fc.rules.Walk(func(key []byte, value interface{}) bool {
+		t := value.(*filer_pb.FilerConf_PathConf)
+		if hasQuota(t) {
+			pathConfs = append(pathConfs, t)
+		}
+		return true
+	})
+	return
+}
+
+func hasQuota(pathConf *filer_pb.FilerConf_PathConf) bool {
+	return pathConf.QuotaBytes > 0 || pathConf.QuotaObjects > 0
+}
+
 // merge if values in b is not empty, merge them into a
 func mergePathConf(a, b *filer_pb.FilerConf_PathConf) {
 	a.Collection = util.Nvl(b.Collection, a.Collection)
@@ -126,6 +154,10 @@ func mergePathConf(a, b *filer_pb.FilerConf_PathConf) {
 	if b.VolumeGrowthCount > 0 {
 		a.VolumeGrowthCount = b.VolumeGrowthCount
 	}
+	if b.TrashRetentionDays > 0 {
+		a.TrashRetentionDays = b.TrashRetentionDays
+	}
+	a.Dedup = b.Dedup || a.Dedup
 }
 
 func (fc *FilerConf) ToProto() *filer_pb.FilerConf {
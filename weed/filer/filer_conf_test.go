@@ -24,11 +24,28 @@ func TestFilerConf(t *testing.T) {
 			LocationPrefix: "/buckets/",
 			Replication:    "001",
 		},
+		{
+			LocationPrefix: "/buckets/abc/",
+			QuotaBytes:     1024,
+		},
+		{
+			LocationPrefix:     "/buckets/abc/docs/",
+			TrashRetentionDays: 7,
+			Dedup:              true,
+		},
 	}}
 	fc.doLoadConf(conf)
 
 	assert.Equal(t, "abc", fc.MatchStorageRule("/buckets/abc/jasdf").Collection)
 	assert.Equal(t, "abcd", fc.MatchStorageRule("/buckets/abcd/jasdf").Collection)
 	assert.Equal(t, "001", fc.MatchStorageRule("/buckets/abc/jasdf").Replication)
+	assert.Equal(t, uint32(7), fc.MatchStorageRule("/buckets/abc/docs/jasdf").TrashRetentionDays)
+	assert.Equal(t, uint32(0), fc.MatchStorageRule("/buckets/abc/jasdf").TrashRetentionDays)
+	assert.True(t, fc.MatchStorageRule("/buckets/abc/docs/jasdf").Dedup)
+	assert.False(t, fc.MatchStorageRule("/buckets/abc/jasdf").Dedup)
+
+	assert.Equal(t, 1, len(fc.MatchQuotaRules("/buckets/abc/jasdf")))
+	assert.Equal(t, 0, len(fc.MatchQuotaRules("/buckets/abcd/jasdf")))
+	assert.Equal(t, 1, len(fc.QuotaRules()))
 
 }
@@ -0,0 +1,401 @@
+package filer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+// The chunks uploaded to the paths configured with dedup are indexed by the sha256 of the content,
+// and the same content is stored only once for the same collection, replication and disk type.
+//
+// The references to each indexed chunk are counted in the filer store, and the chunks are marked as deduplicated
+// in the entries. The deleted chunks are only freed when the last entry referencing them is deleted.
+// The counts are changed under the filer store lock, shared by the peer filers.
+//
+// The peer filers with separate filer stores count the references of the replicated entries in their own stores,
+// but only the filer deleting the last reference frees the chunk.
+
+const (
+	dedupHashKeyPrefix  = "dedup.hash."
+	dedupChunkKeyPrefix = "dedup.chunk."
+	dedupUsedKey        = "dedup.used"
+	dedupLockName       = "dedup"
+)
+
+type dedupChunkRef struct {
+	count   int64
+	hashKey string
+}
+
+func DedupScope(collection, replication, diskType string) string {
+	return collection + "," + replication + "," + diskType
+}
+
+func dedupHashKey(scope string, hash []byte) string {
+	return dedupHashKeyPrefix + scope + "." + hex.EncodeToString(hash)
+}
+
+// FindDedupChunk returns the indexed chunk with the same content, referenced once more, or nil
+func (f *Filer) FindDedupChunk(ctx context.Context, scope string, hash []byte) *filer_pb.FileChunk {
+
+	hashKey := dedupHashKey(scope, hash)
+
+	value, err := f.Store.KvGet(ctx, []byte(hashKey))
+	if err != nil {
+		if err != ErrKvNotFound {
+			glog.Errorf("find dedup chunk %s: %v", hashKey, err)
+		}
+		return nil
+	}
+	chunk := &filer_pb.FileChunk{}
+	if err = proto.Unmarshal(value, chunk); err != nil {
+		glog.Errorf("decode dedup chunk %s: %v", hashKey, err)
+		return nil
+	}
+	fileId := chunk.GetFileIdString()
+
+	// the volume could be deleted together with its collection, without deleting the chunks one by one
+	if _, found := f.MasterClient.GetLocations(chunk.Fid.GetVolumeId()); !found {
+		glog.V(0).Infof("drop dedup chunk %s in a deleted volume", fileId)
+		if err = f.dropDedupChunk(ctx, hashKey, fileId); err != nil {
+			glog.Errorf("drop dedup chunk %s: %v", fileId, err)
+		}
+		return nil
+	}
+
+	found, err := f.referenceDedupChunk(ctx, fileId)
+	if err != nil {
+		glog.Errorf("reference dedup chunk %s: %v", fileId, err)
+		return nil
+	}
+	if !found {
+		// released and deleted after being looked up
+		return nil
+	}
+
+	chunk.IsDedup = true
+	return chunk
+}
+
+// referenceDedupChunk counts one more reference to the chunk, if it is still counted
+func (f *Filer) referenceDedupChunk(ctx context.Context, fileId string) (found bool, err error) {
+	unlock, err := f.lockStore(&f.dedupLock, dedupLockName)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	ref, err := f.readDedupChunkRef(ctx, fileId)
+	if err != nil || ref == nil {
+		return false, err
+	}
+	ref.count++
+	return true, f.writeDedupChunkRef(ctx, fileId, ref)
+}
+
+func (f *Filer) dropDedupChunk(ctx context.Context, hashKey, fileId string) error {
+	unlock, err := f.lockStore(&f.dedupLock, dedupLockName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	ref, err := f.readDedupChunkRef(ctx, fileId)
+	if err != nil {
+		return err
+	}
+	if err = f.Store.KvDelete(ctx, []byte(hashKey)); err != nil || ref == nil {
+		return err
+	}
+	return f.Store.KvDelete(ctx, []byte(dedupChunkKeyPrefix+fileId))
+}
+
+// AddDedupChunk indexes a newly uploaded chunk, which is referenced once, and marks the chunk as deduplicated
+func (f *Filer) AddDedupChunk(ctx context.Context, scope string, hash []byte, chunk *filer_pb.FileChunk) error {
+
+	hashKey := dedupHashKey(scope, hash)
+	fileId := chunk.GetFileIdString()
+
+	indexed := &filer_pb.FileChunk{
+		FileId:       fileId,
+		Size:         chunk.Size,
+		ETag:         chunk.ETag,
+		IsCompressed: chunk.IsCompressed,
+		Fid:          chunk.Fid,
+	}
+	if indexed.Fid == nil {
+		indexed.Fid, _ = filer_pb.ToFileIdObject(fileId)
+	}
+	value, err := proto.Marshal(indexed)
+	if err != nil {
+		return fmt.Errorf("encode dedup chunk %s: %v", fileId, err)
+	}
+
+	if err = f.markDedupUsed(ctx); err != nil {
+		return fmt.Errorf("add dedup chunk %s: %v", fileId, err)
+	}
+
+	unlock, err := f.lockStore(&f.dedupLock, dedupLockName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// the same content uploaded at the same time is kept as a chunk not in the index
+	if _, err = f.Store.KvGet(ctx, []byte(hashKey)); err != ErrKvNotFound {
+		return err
+	}
+
+	if err = f.writeDedupChunkRef(ctx, fileId, &dedupChunkRef{count: 1, hashKey: hashKey}); err != nil {
+		return fmt.Errorf("add dedup chunk %s: %v", fileId, err)
+	}
+	if err = f.Store.KvPut(ctx, []byte(hashKey), value); err != nil {
+		f.Store.KvDelete(ctx, []byte(dedupChunkKeyPrefix+fileId))
+		return fmt.Errorf("add dedup chunk %s: %v", fileId, err)
+	}
+	chunk.IsDedup = true
+	return nil
+}
+
+// RetainDedupChunks references the deduplicated chunks once more, for the chunks copied to another entry.
+// The chunks no longer counted in this filer store, e.g. replicated from a peer with a separate store, are counted again.
+func (f *Filer) RetainDedupChunks(ctx context.Context, chunks []*filer_pb.FileChunk) error {
+
+	var fileIds []string
+	for _, chunk := range chunks {
+		if chunk.IsDedup {
+			fileIds = append(fileIds, chunk.GetFileIdString())
+		}
+	}
+	if len(fileIds) == 0 {
+		return nil
+	}
+	if err := f.markDedupUsed(ctx); err != nil {
+		return fmt.Errorf("retain dedup chunks: %v", err)
+	}
+
+	unlock, err := f.lockStore(&f.dedupLock, dedupLockName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	for _, fileId := range fileIds {
+		ref, err := f.readDedupChunkRef(ctx, fileId)
+		if err != nil {
+			return fmt.Errorf("retain dedup chunk %s: %v", fileId, err)
+		}
+		if ref == nil {
+			ref = &dedupChunkRef{}
+		}
+		ref.count++
+		if err = f.writeDedupChunkRef(ctx, fileId, ref); err != nil {
+			return fmt.Errorf("retain dedup chunk %s: %v", fileId, err)
+		}
+	}
+	return nil
+}
+
+// releaseDedupChunks returns the file ids to delete now, which are not counted or no longer referenced.
+// Nothing is released if the filer store lock is not taken.
+func (f *Filer) releaseDedupChunks(fileIds []string) (toDelete []string, err error) {
+
+	ctx := context.Background()
+
+	if !f.isDedupUsed(ctx) {
+		return fileIds, nil
+	}
+
+	unlock, err := f.lockStore(&f.dedupLock, dedupLockName)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	for _, fileId := range fileIds {
+		ref, err := f.readDedupChunkRef(ctx, fileId)
+		if err == nil && ref == nil {
+			toDelete = append(toDelete, fileId)
+			continue
+		}
+		if err == nil {
+			err = f.doReleaseDedupChunk(ctx, fileId, ref)
+		}
+		if err != nil {
+			// keep the chunk, which is safer than losing the data of the other entries
+			glog.Errorf("release dedup chunk %s: %v", fileId, err)
+			continue
+		}
+		if ref.count <= 0 {
+			toDelete = append(toDelete, fileId)
+		}
+	}
+	return toDelete, nil
+}
+
+// ReleaseRetainedDedupChunks takes back the references counted by RetainDedupChunks, without deleting any chunk
+func (f *Filer) ReleaseRetainedDedupChunks(ctx context.Context, chunks []*filer_pb.FileChunk) error {
+	return f.forgetDedupChunks(ctx, chunks)
+}
+
+// forgetDedupChunks releases the deduplicated chunks without deleting them, for the entries deleted by a peer filer
+func (f *Filer) forgetDedupChunks(ctx context.Context, chunks []*filer_pb.FileChunk) error {
+
+	unlock, err := f.lockStore(&f.dedupLock, dedupLockName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	for _, chunk := range chunks {
+		if !chunk.IsDedup {
+			continue
+		}
+		fileId := chunk.GetFileIdString()
+		ref, err := f.readDedupChunkRef(ctx, fileId)
+		if err == nil && ref != nil {
+			err = f.doReleaseDedupChunk(ctx, fileId, ref)
+		}
+		if err != nil {
+			return fmt.Errorf("release dedup chunk %s: %v", fileId, err)
+		}
+	}
+	return nil
+}
+
+func (f *Filer) doReleaseDedupChunk(ctx context.Context, fileId string, ref *dedupChunkRef) error {
+	ref.count--
+	if ref.count > 0 {
+		return f.writeDedupChunkRef(ctx, fileId, ref)
+	}
+	// remove from the index first, so the chunk to delete is not referenced again
+	if ref.hashKey != "" {
+		if err := f.Store.KvDelete(ctx, []byte(ref.hashKey)); err != nil {
+			return err
+		}
+	}
+	return f.Store.KvDelete(ctx, []byte(dedupChunkKeyPrefix+fileId))
+}
+
+// replayDedupChunks counts the references of a change replicated from a peer filer with a separate filer store
+func (f *Filer) replayDedupChunks(ctx context.Context, oldEntry, newEntry *Entry) error {
+
+	var oldChunks, newChunks []*filer_pb.FileChunk
+	if oldEntry != nil {
+		oldChunks = f.resolveDedupChunks(oldEntry)
+	}
+	if newEntry != nil {
+		newChunks = f.resolveDedupChunks(newEntry)
+	}
+	if len(oldChunks) == 0 && len(newChunks) == 0 {
+		return nil
+	}
+
+	// a renamed or updated entry references most chunks both before and after the change
+	references := make(map[string]int)
+	for _, chunk := range oldChunks {
+		references[chunkReference(chunk)]--
+	}
+	var added, removed []*filer_pb.FileChunk
+	for _, chunk := range newChunks {
+		if references[chunkReference(chunk)] < 0 {
+			references[chunkReference(chunk)]++
+			continue
+		}
+		added = append(added, chunk)
+	}
+	for _, chunk := range oldChunks {
+		if references[chunkReference(chunk)] < 0 {
+			references[chunkReference(chunk)]++
+			removed = append(removed, chunk)
+		}
+	}
+
+	if err := f.RetainDedupChunks(ctx, added); err != nil {
+		return err
+	}
+	return f.forgetDedupChunks(ctx, removed)
+}
+
+// resolveDedupChunks returns the deduplicated data chunks of the entry
+func (f *Filer) resolveDedupChunks(entry *Entry) (chunks []*filer_pb.FileChunk) {
+	dataChunks := entry.Chunks
+	// the chunk manifests are only read for the paths with dedup
+	if HasChunkManifest(dataChunks) && f.FilerConf.MatchStorageRule(string(entry.FullPath)).Dedup {
+		var err error
+		if dataChunks, _, err = ResolveChunkManifest(f.MasterClient.GetLookupFileIdFunction(), entry.Chunks); err != nil {
+			glog.Errorf("resolve chunk manifests of %s: %v", entry.FullPath, err)
+		}
+	}
+	for _, chunk := range dataChunks {
+		if chunk.IsDedup {
+			chunks = append(chunks, chunk)
+		}
+	}
+	return
+}
+
+// isDedupUsed tells whether any chunk is ever counted in the filer store
+func (f *Filer) isDedupUsed(ctx context.Context) bool {
+	if atomic.LoadInt32(&f.dedupUsed) == 1 {
+		return true
+	}
+	_, err := f.Store.KvGet(ctx, []byte(dedupUsedKey))
+	if err == ErrKvNotFound {
+		return false
+	}
+	if err != nil {
+		glog.Errorf("read %s: %v", dedupUsedKey, err)
+		return true
+	}
+	atomic.StoreInt32(&f.dedupUsed, 1)
+	return true
+}
+
+func (f *Filer) markDedupUsed(ctx context.Context) error {
+	if f.isDedupUsed(ctx) {
+		return nil
+	}
+	if err := f.Store.KvPut(ctx, []byte(dedupUsedKey), []byte("1")); err != nil {
+		return err
+	}
+	atomic.StoreInt32(&f.dedupUsed, 1)
+	return nil
+}
+
+func (f *Filer) readDedupChunkRef(ctx context.Context, fileId string) (*dedupChunkRef, error) {
+	value, err := f.Store.KvGet(ctx, []byte(dedupChunkKeyPrefix+fileId))
+	if err == ErrKvNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the chunks counted for the replicated entries are not in the index
+	parts := strings.SplitN(string(value), " ", 2)
+	ref := &dedupChunkRef{}
+	if len(parts) == 2 {
+		ref.hashKey = parts[1]
+	}
+	if ref.count, err = strconv.ParseInt(parts[0], 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid reference %q: %v", value, err)
+	}
+	return ref, nil
+}
+
+func (f *Filer) writeDedupChunkRef(ctx context.Context, fileId string, ref *dedupChunkRef) error {
+	value := strconv.FormatInt(ref.count, 10)
+	if ref.hashKey != "" {
+		value += " " + ref.hashKey
+	}
+	return f.Store.KvPut(ctx, []byte(dedupChunkKeyPrefix+fileId), []byte(value))
+}
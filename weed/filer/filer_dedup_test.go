@@ -0,0 +1,140 @@
+package filer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func TestDedupChunkReferences(t *testing.T) {
+
+	ctx := context.Background()
+	store := &testKvStore{kv: make(map[string][]byte)}
+	f := &Filer{Store: store}
+	scope := DedupScope("", "000", "")
+	hash := []byte{1, 2, 3}
+
+	assertCount := func(fileId string, count int64) {
+		ref, err := f.readDedupChunkRef(ctx, fileId)
+		assert.NoError(t, err)
+		if count == 0 {
+			assert.Nil(t, ref, "reference of %s", fileId)
+			return
+		}
+		if assert.NotNil(t, ref, "reference of %s", fileId) {
+			assert.Equal(t, count, ref.count, "reference of %s", fileId)
+		}
+	}
+
+	assertReleased := func(toDelete []string, fileIds ...string) {
+		released, err := f.releaseDedupChunks(fileIds)
+		assert.NoError(t, err)
+		assert.Equal(t, toDelete, released)
+	}
+
+	// nothing is counted before the first chunk is indexed
+	assertReleased([]string{"3,01637037d6"}, "3,01637037d6")
+
+	chunk := &filer_pb.FileChunk{FileId: "3,01637037d6", Size: 100}
+	assert.NoError(t, f.AddDedupChunk(ctx, scope, hash, chunk))
+	assert.True(t, chunk.IsDedup)
+	assertCount("3,01637037d6", 1)
+
+	// the same content uploaded at the same time is not indexed or counted
+	duplicate := &filer_pb.FileChunk{FileId: "3,02637037d7", Size: 100}
+	assert.NoError(t, f.AddDedupChunk(ctx, scope, hash, duplicate))
+	assert.False(t, duplicate.IsDedup)
+	assertCount("3,02637037d7", 0)
+
+	found, err := f.referenceDedupChunk(ctx, "3,01637037d6")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assertCount("3,01637037d6", 2)
+
+	// the chunks not counted are deleted at once, the counted ones with the last reference
+	assertReleased([]string{"3,02637037d7"}, "3,01637037d6", "3,02637037d7")
+	assertCount("3,01637037d6", 1)
+	assertReleased([]string{"3,01637037d6"}, "3,01637037d6")
+	assertCount("3,01637037d6", 0)
+	_, err = store.KvGet(ctx, []byte(dedupHashKey(scope, hash)))
+	assert.Equal(t, ErrKvNotFound, err)
+
+	// released after being looked up
+	found, err = f.referenceDedupChunk(ctx, "3,01637037d6")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+}
+
+func TestReplayDedupChunks(t *testing.T) {
+
+	ctx := context.Background()
+	f := &Filer{Store: &testKvStore{kv: make(map[string][]byte)}}
+
+	file := func(p util.FullPath, chunks ...*filer_pb.FileChunk) *Entry {
+		return &Entry{FullPath: p, Attr: Attr{Mode: 0644}, Chunks: chunks}
+	}
+	shared := &filer_pb.FileChunk{FileId: "3,01637037d6", Size: 100, IsDedup: true}
+	sharedAgain := &filer_pb.FileChunk{FileId: "3,01637037d6", Offset: 100, Size: 100, IsDedup: true}
+	plain := &filer_pb.FileChunk{FileId: "3,02637037d7", Size: 100}
+	assertCount := func(count int64) {
+		ref, err := f.readDedupChunkRef(ctx, "3,01637037d6")
+		assert.NoError(t, err)
+		if count == 0 {
+			assert.Nil(t, ref)
+			return
+		}
+		if assert.NotNil(t, ref) {
+			assert.Equal(t, count, ref.count)
+		}
+	}
+
+	// the entries replicated from a peer filer are counted in this filer store
+	assert.NoError(t, f.replayDedupChunks(ctx, nil, file("/a/f1", shared, sharedAgain, plain)))
+	assertCount(2)
+	assert.NoError(t, f.replayDedupChunks(ctx, nil, file("/a/f2", shared)))
+	assertCount(3)
+
+	// renamed
+	assert.NoError(t, f.replayDedupChunks(ctx, file("/a/f1", shared, sharedAgain, plain), file("/b/f1", shared, sharedAgain, plain)))
+	assertCount(3)
+
+	// updated in place
+	assert.NoError(t, f.replayDedupChunks(ctx, file("/b/f1", shared, sharedAgain, plain), file("/b/f1", shared)))
+	assertCount(2)
+
+	// so the deletion in this filer does not free the chunk still referenced
+	released, err := f.releaseDedupChunks([]string{"3,01637037d6"})
+	assert.NoError(t, err)
+	assert.Empty(t, released)
+	assertCount(1)
+
+	// deleted by the peer filer, which deletes the chunk itself
+	assert.NoError(t, f.replayDedupChunks(ctx, file("/a/f2", shared), nil))
+	assertCount(0)
+
+}
+
+func TestDeleteDedupChunks(t *testing.T) {
+
+	f := &Filer{fileIdDeletionQueue: util.NewUnboundedQueue(), dedupDeletionQueue: util.NewUnboundedQueue()}
+	consume := func(q *util.UnboundedQueue) (fileIds []string) {
+		q.Consume(func(items []string) {
+			fileIds = append(fileIds, items...)
+		})
+		return
+	}
+
+	// only the chunks marked as deduplicated are released before being deleted
+	f.DeleteChunks([]*filer_pb.FileChunk{
+		{FileId: "3,01637037d6", IsDedup: true},
+		{FileId: "3,02637037d7"},
+	})
+	assert.Equal(t, []string{"3,02637037d7"}, consume(f.fileIdDeletionQueue))
+	assert.Equal(t, []string{"3,01637037d6"}, consume(f.dedupDeletionQueue))
+
+}
@@ -2,11 +2,13 @@ package filer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
 	"github.com/chrislusf/seaweedfs/weed/util"
+	"time"
 )
 
 type HardLinkId []byte
@@ -19,13 +21,35 @@ func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p util.FullPath, isR
 	if p == "/" {
 		return nil
 	}
+	if err = f.checkSnapshotWrite(p); err != nil {
+		return err
+	}
 
 	entry, findErr := f.FindEntry(ctx, p)
 	if findErr != nil {
 		return findErr
 	}
 
+	if entry.IsDirectory() && isRecursive {
+		err = f.checkFolderObjectLock(ctx, entry, time.Now())
+	} else {
+		err = checkDeleteObjectLock(ctx, entry, time.Now())
+	}
+	if err != nil {
+		return err
+	}
+
 	isDeleteCollection := f.isBucket(entry)
+	if isDeleteCollection && f.HasSnapshotsUsingCollection(p) {
+		return fmt.Errorf("%w: %s", ErrSnapshotsExist, p)
+	}
+
+	// the deletions replicated from other clusters are trashed there, and the trashed entries are replicated
+	if shouldDeleteChunks && !isDeleteCollection && !isFromOtherCluster {
+		if retention := f.trashRetention(p); retention > 0 {
+			return f.moveToTrash(ctx, entry, isRecursive, retention, time.Now())
+		}
+	}
 
 	var chunks []*filer_pb.FileChunk
 	var hardLinkIds []HardLinkId
@@ -37,7 +61,7 @@ func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p util.FullPath, isR
 		dirChunks, dirHardLinkIds, err = f.doBatchDeleteFolderMetaAndData(ctx, entry, isRecursive, ignoreRecursiveError, shouldDeleteChunks && !isDeleteCollection, isDeleteCollection, isFromOtherCluster, signatures)
 		if err != nil {
 			glog.V(0).Infof("delete directory %s: %v", p, err)
-			return fmt.Errorf("delete directory %s: %v", p, err)
+			return fmt.Errorf("delete directory %s: %w", p, err)
 		}
 		chunks = append(chunks, dirChunks...)
 		hardLinkIds = append(hardLinkIds, dirHardLinkIds...)
@@ -59,6 +83,9 @@ func (f *Filer) DeleteEntryMetaAndData(ctx context.Context, p util.FullPath, isR
 	}
 
 	if isDeleteCollection {
+		if trashErr := f.deleteTrashOfBucket(ctx, p); trashErr != nil {
+			glog.Errorf("delete trashed entries of bucket %s: %v", p, trashErr)
+		}
 		collectionName := entry.Name()
 		f.doDeleteCollection(collectionName)
 		f.deleteBucket(collectionName)
@@ -94,6 +121,9 @@ func (f *Filer) doBatchDeleteFolderMetaAndData(ctx context.Context, entry *Entry
 					chunks = append(chunks, dirChunks...)
 					hardlinkIds = append(hardlinkIds, dirHardLinkIds...)
 				} else {
+					if lockErr := checkDeleteObjectLock(ctx, sub, time.Now()); lockErr != nil {
+						return nil, nil, lockErr
+					}
 					f.NotifyUpdateEvent(ctx, sub, nil, shouldDeleteChunks, isFromOtherCluster, nil)
 					if len(sub.HardLinkId) != 0 {
 						// hard link chunk data are deleted separately
@@ -102,7 +132,8 @@ func (f *Filer) doBatchDeleteFolderMetaAndData(ctx context.Context, entry *Entry
 						chunks = append(chunks, sub.Chunks...)
 					}
 				}
-				if err != nil && !ignoreRecursiveError {
+				// locked objects are never skipped, or the folder deletion would remove them
+				if err != nil && (!ignoreRecursiveError || errors.Is(err, ErrObjectLocked)) {
 					return nil, nil, err
 				}
 			}
@@ -115,6 +146,11 @@ func (f *Filer) doBatchDeleteFolderMetaAndData(ctx context.Context, entry *Entry
 
 	glog.V(3).Infof("deleting directory %v delete %d chunks: %v", entry.FullPath, len(chunks), shouldDeleteChunks)
 
+	if isDeletingBucket {
+		// the bucket entries are deleted without events
+		f.forgetSubDirectoryStats(ctx, entry.FullPath)
+	}
+
 	if storeDeletionErr := f.Store.DeleteFolderChildren(ctx, entry.FullPath); storeDeletionErr != nil {
 		return nil, nil, fmt.Errorf("filer store delete: %v", storeDeletionErr)
 	}
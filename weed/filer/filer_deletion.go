@@ -1,6 +1,7 @@
 package filer
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -50,6 +51,7 @@ func (f *Filer) loopProcessingDeletion() {
 					toDeleteFileIds = fileIds
 					fileIds = fileIds[:0]
 				}
+				toDeleteFileIds = f.skipSnapshotChunks(toDeleteFileIds)
 				deletionCount = len(toDeleteFileIds)
 				_, err := operation.DeleteFilesWithLookupVolumeId(f.GrpcDialOption, toDeleteFileIds, lookupFunc)
 				if err != nil {
@@ -62,6 +64,18 @@ func (f *Filer) loopProcessingDeletion() {
 			}
 		})
 
+		f.dedupDeletionQueue.Consume(func(fileIds []string) {
+			toDeleteFileIds, err := f.releaseDedupChunks(fileIds)
+			if err != nil {
+				// retried later, which is safer than losing the data of the other entries
+				glog.Errorf("release dedup chunks: %v", err)
+				f.dedupDeletionQueue.EnQueue(fileIds...)
+				return
+			}
+			deletionCount += len(toDeleteFileIds)
+			f.doDeleteFileIds(toDeleteFileIds)
+		})
+
 		if deletionCount == 0 {
 			time.Sleep(1123 * time.Millisecond)
 		}
@@ -82,6 +96,7 @@ func (f *Filer) doDeleteFileIds(fileIds []string) {
 			toDeleteFileIds = fileIds
 			fileIds = fileIds[:0]
 		}
+		toDeleteFileIds = f.skipSnapshotChunks(toDeleteFileIds)
 		deletionCount := len(toDeleteFileIds)
 		_, err := operation.DeleteFilesWithLookupVolumeId(f.GrpcDialOption, toDeleteFileIds, lookupFunc)
 		if err != nil {
@@ -96,7 +111,7 @@ func (f *Filer) DirectDeleteChunks(chunks []*filer_pb.FileChunk) {
 	var fildIdsToDelete []string
 	for _, chunk := range chunks {
 		if !chunk.IsChunkManifest {
-			fildIdsToDelete = append(fildIdsToDelete, chunk.GetFileIdString())
+			fildIdsToDelete = f.appendChunkToDelete(fildIdsToDelete, chunk)
 			continue
 		}
 		dataChunks, manifestResolveErr := ResolveOneChunkManifest(f.MasterClient.LookupFileId, chunk)
@@ -104,7 +119,7 @@ func (f *Filer) DirectDeleteChunks(chunks []*filer_pb.FileChunk) {
 			glog.V(0).Infof("failed to resolve manifest %s: %v", chunk.FileId, manifestResolveErr)
 		}
 		for _, dChunk := range dataChunks {
-			fildIdsToDelete = append(fildIdsToDelete, dChunk.GetFileIdString())
+			fildIdsToDelete = f.appendChunkToDelete(fildIdsToDelete, dChunk)
 		}
 		fildIdsToDelete = append(fildIdsToDelete, chunk.GetFileIdString())
 	}
@@ -112,10 +127,20 @@ func (f *Filer) DirectDeleteChunks(chunks []*filer_pb.FileChunk) {
 	f.doDeleteFileIds(fildIdsToDelete)
 }
 
+// appendChunkToDelete appends the file id of the chunk, except the deduplicated chunks,
+// which are queued to be deleted only after their references are released
+func (f *Filer) appendChunkToDelete(fileIds []string, chunk *filer_pb.FileChunk) []string {
+	if chunk.IsDedup {
+		f.dedupDeletionQueue.EnQueue(chunk.GetFileIdString())
+		return fileIds
+	}
+	return append(fileIds, chunk.GetFileIdString())
+}
+
 func (f *Filer) DeleteChunks(chunks []*filer_pb.FileChunk) {
 	for _, chunk := range chunks {
 		if !chunk.IsChunkManifest {
-			f.fileIdDeletionQueue.EnQueue(chunk.GetFileIdString())
+			f.enqueueChunkToDelete(chunk)
 			continue
 		}
 		dataChunks, manifestResolveErr := ResolveOneChunkManifest(f.MasterClient.LookupFileId, chunk)
@@ -123,12 +148,20 @@ func (f *Filer) DeleteChunks(chunks []*filer_pb.FileChunk) {
 			glog.V(0).Infof("failed to resolve manifest %s: %v", chunk.FileId, manifestResolveErr)
 		}
 		for _, dChunk := range dataChunks {
-			f.fileIdDeletionQueue.EnQueue(dChunk.GetFileIdString())
+			f.enqueueChunkToDelete(dChunk)
 		}
 		f.fileIdDeletionQueue.EnQueue(chunk.GetFileIdString())
 	}
 }
 
+func (f *Filer) enqueueChunkToDelete(chunk *filer_pb.FileChunk) {
+	if chunk.IsDedup {
+		f.dedupDeletionQueue.EnQueue(chunk.GetFileIdString())
+		return
+	}
+	f.fileIdDeletionQueue.EnQueue(chunk.GetFileIdString())
+}
+
 func (f *Filer) deleteChunksIfNotNew(oldEntry, newEntry *Entry) {
 
 	if oldEntry == nil {
@@ -140,14 +173,25 @@ func (f *Filer) deleteChunksIfNotNew(oldEntry, newEntry *Entry) {
 
 	var toDelete []*filer_pb.FileChunk
 	newChunkIds := make(map[string]bool)
+	newChunks := make(map[string]bool)
 	for _, newChunk := range newEntry.Chunks {
 		newChunkIds[newChunk.GetFileIdString()] = true
+		newChunks[chunkReference(newChunk)] = true
 	}
 
 	for _, oldChunk := range oldEntry.Chunks {
 		if _, found := newChunkIds[oldChunk.GetFileIdString()]; !found {
 			toDelete = append(toDelete, oldChunk)
+			continue
+		}
+		// the same content is uploaded again, and the counted chunk is referenced again by the new chunk
+		if !newChunks[chunkReference(oldChunk)] && oldChunk.IsDedup {
+			toDelete = append(toDelete, oldChunk)
 		}
 	}
 	f.DeleteChunks(toDelete)
 }
+
+func chunkReference(chunk *filer_pb.FileChunk) string {
+	return fmt.Sprintf("%s@%d@%d", chunk.GetFileIdString(), chunk.Offset, chunk.Mtime)
+}
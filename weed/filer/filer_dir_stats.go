@@ -0,0 +1,336 @@
+package filer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// The total file size, the file count and the latest file mtime under each directory are counted in the filer store,
+// and updated for all the parent directories from the metadata events, in batches. Each filer counts its own events,
+// and the events of the peer filers with separate filer stores. The latest mtime is not lowered when the latest file
+// is deleted. The counters are rebuilt by scanning the directories, once after the filer is upgraded, or on request.
+// The changes counted while scanning a directory, by any filer, are kept on top of the scanned counters, so the changes
+// seen by the scan can be counted twice, and the counters are approximate.
+
+const (
+	dirStatsKeyPrefix          = "dirstats."
+	dirStatsBuiltKey           = "dirstats.built"
+	dirStatsLockName           = "dirstats"
+	dirStatsRebuildLockName    = "dirstats.rebuild"
+	dirStatsBuildRetryInterval = time.Minute
+)
+
+var ErrDirectoryStatsRebuilding = errors.New("directory stats are being rebuilt")
+
+type DirectoryStats struct {
+	TotalSize   uint64
+	FileCount   uint64
+	LatestMtime time.Time
+}
+
+func (stats *DirectoryStats) ToPb() *filer_pb.DirectoryStats {
+	if stats == nil {
+		return nil
+	}
+	return &filer_pb.DirectoryStats{
+		TotalSize:   stats.TotalSize,
+		FileCount:   stats.FileCount,
+		LatestMtime: stats.LatestMtime.Unix(),
+	}
+}
+
+func PbToDirectoryStats(stats *filer_pb.DirectoryStats) *DirectoryStats {
+	if stats == nil {
+		return nil
+	}
+	return &DirectoryStats{
+		TotalSize:   stats.TotalSize,
+		FileCount:   stats.FileCount,
+		LatestMtime: time.Unix(stats.LatestMtime, 0),
+	}
+}
+
+func dirStatsKey(dir util.FullPath) []byte {
+	return []byte(dirStatsKeyPrefix + string(dir))
+}
+
+// FindDirectoryStats returns the counters of the directory, which are zero if nothing is counted yet
+func (f *Filer) FindDirectoryStats(ctx context.Context, dir util.FullPath) (*DirectoryStats, error) {
+	stats, err := f.readDirectoryStats(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	return PbToDirectoryStats(stats), nil
+}
+
+// FillDirectoryStats sets the counters of the directory entries, to be returned to the clients
+func (f *Filer) FillDirectoryStats(ctx context.Context, entries ...*Entry) {
+	for _, entry := range entries {
+		if !entry.IsDirectory() {
+			continue
+		}
+		stats, err := f.FindDirectoryStats(ctx, entry.FullPath)
+		if err != nil {
+			glog.V(0).Infof("read directory stats of %s: %v", entry.FullPath, err)
+			continue
+		}
+		entry.DirectoryStats = stats
+	}
+}
+
+func (f *Filer) readDirectoryStats(ctx context.Context, dir util.FullPath) (*filer_pb.DirectoryStats, error) {
+	value, err := f.Store.KvGet(ctx, dirStatsKey(dir))
+	if err == ErrKvNotFound {
+		return &filer_pb.DirectoryStats{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	stats := &filer_pb.DirectoryStats{}
+	if err = proto.Unmarshal(value, stats); err != nil {
+		return nil, fmt.Errorf("decode directory stats of %s: %v", dir, err)
+	}
+	return stats, nil
+}
+
+func (f *Filer) writeDirectoryStats(ctx context.Context, dir util.FullPath, stats *filer_pb.DirectoryStats) error {
+	if stats.FileCount == 0 && stats.TotalSize == 0 && stats.LatestMtime == 0 {
+		return f.Store.KvDelete(ctx, dirStatsKey(dir))
+	}
+	value, err := proto.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	return f.Store.KvPut(ctx, dirStatsKey(dir), value)
+}
+
+func (f *Filer) addDirectoryStats(ctx context.Context, dirs []util.FullPath, size, count, mtime int64) {
+	for _, dir := range dirs {
+		if err := f.doAddDirectoryStats(ctx, dir, size, count, mtime); err != nil {
+			glog.Errorf("update directory stats of %s: %v", dir, err)
+		}
+	}
+}
+
+func (f *Filer) doAddDirectoryStats(ctx context.Context, dir util.FullPath, size, count, mtime int64) error {
+	stats, err := f.readDirectoryStats(ctx, dir)
+	if err != nil {
+		return err
+	}
+	if size == 0 && count == 0 && mtime <= stats.LatestMtime {
+		return nil
+	}
+	stats.TotalSize = addWithoutUnderflow(stats.TotalSize, size)
+	stats.FileCount = addWithoutUnderflow(stats.FileCount, count)
+	if mtime > stats.LatestMtime {
+		stats.LatestMtime = mtime
+	}
+	return f.writeDirectoryStats(ctx, dir, stats)
+}
+
+// dropDirectoryStats removes what is still counted under the directory from its parent directories
+func (f *Filer) dropDirectoryStats(ctx context.Context, dir util.FullPath) {
+	stats, err := f.readDirectoryStats(ctx, dir)
+	if err == nil && (stats.TotalSize > 0 || stats.FileCount > 0) {
+		f.addDirectoryStats(ctx, parentDirectories(dir), -int64(stats.TotalSize), -int64(stats.FileCount), 0)
+	}
+	if err == nil {
+		err = f.Store.KvDelete(ctx, dirStatsKey(dir))
+	}
+	if err != nil {
+		glog.Errorf("drop directory stats of %s: %v", dir, err)
+	}
+}
+
+func addWithoutUnderflow(x uint64, delta int64) uint64 {
+	if delta < 0 && uint64(-delta) > x {
+		return 0
+	}
+	return uint64(int64(x) + delta)
+}
+
+// isDirectoryStatsCounted is false for the system logs, and for the snapshots, which share the chunks with the live tree
+func isDirectoryStatsCounted(p util.FullPath) bool {
+	for _, dir := range []string{SystemLogDir, DirectorySnapshots} {
+		if string(p) == dir || strings.HasPrefix(string(p), dir+"/") {
+			return false
+		}
+	}
+	return true
+}
+
+// parentDirectories returns the parent directories of the path, from the nearest one to the root
+func parentDirectories(p util.FullPath) (dirs []util.FullPath) {
+	for p != "/" && p != "" {
+		dir, _ := p.DirAndName()
+		p = util.FullPath(dir)
+		dirs = append(dirs, p)
+	}
+	return
+}
+
+// StartDirectoryStats starts to count the metadata events, and counts all the directories once if the counters
+// are not built yet. With peer filers sharing the filer store, only one of them counts the directories.
+func (f *Filer) StartDirectoryStats() {
+	go f.loopFlushingDirectoryStats()
+	go func() {
+		ctx := context.Background()
+		for {
+			if _, err := f.Store.KvGet(ctx, []byte(dirStatsBuiltKey)); err != ErrKvNotFound {
+				if err != nil {
+					glog.Errorf("read directory stats state: %v", err)
+				}
+				return
+			}
+			if f.buildDirectoryStats(ctx) {
+				return
+			}
+			time.Sleep(dirStatsBuildRetryInterval)
+		}
+	}()
+}
+
+// buildDirectoryStats returns false if the counters are being rebuilt by another filer
+func (f *Filer) buildDirectoryStats(ctx context.Context) bool {
+	unlock, isLocked := f.TryLockStore(&f.dirStatsRebuilding, dirStatsRebuildLockName)
+	if !isLocked {
+		return false
+	}
+	defer unlock()
+
+	// built by another filer before locked
+	if _, err := f.Store.KvGet(ctx, []byte(dirStatsBuiltKey)); err != ErrKvNotFound {
+		return true
+	}
+	if err := f.doRebuildDirectoryStats(ctx, "/"); err != nil {
+		glog.Errorf("build directory stats: %v", err)
+		return true
+	}
+	if err := f.Store.KvPut(ctx, []byte(dirStatsBuiltKey), []byte(time.Now().Format(time.RFC3339))); err != nil {
+		glog.Errorf("save directory stats state: %v", err)
+	}
+	return true
+}
+
+// RebuildDirectoryStats starts to count the files under the directory again, unless the counters are
+// being rebuilt already by this filer or by a peer filer sharing the filer store
+func (f *Filer) RebuildDirectoryStats(dir util.FullPath) error {
+	unlock, isLocked := f.TryLockStore(&f.dirStatsRebuilding, dirStatsRebuildLockName)
+	if !isLocked {
+		return ErrDirectoryStatsRebuilding
+	}
+	go func() {
+		defer unlock()
+		if err := f.doRebuildDirectoryStats(context.Background(), dir); err != nil {
+			glog.Errorf("rebuild directory stats of %s: %v", dir, err)
+		}
+	}()
+	return nil
+}
+
+// doRebuildDirectoryStats counts the files under the directory again, and corrects the parent directories
+func (f *Filer) doRebuildDirectoryStats(ctx context.Context, dir util.FullPath) error {
+
+	glog.V(0).Infof("rebuild directory stats of %s", dir)
+	start := time.Now()
+
+	_, stats, err := f.rebuildAndSaveDirectoryStats(ctx, dir, true)
+	if err != nil {
+		return err
+	}
+
+	glog.V(0).Infof("rebuilt directory stats of %s in %v: %d files, %d bytes", dir, time.Since(start), stats.FileCount, stats.TotalSize)
+	return nil
+}
+
+// rebuildAndSaveDirectoryStats counts the files under the directory again, and saves the counters of the directory,
+// with the changes counted during the scan added, and optionally the same correction to the parent directories.
+// It returns the counters as scanned, and as saved.
+func (f *Filer) rebuildAndSaveDirectoryStats(ctx context.Context, dir util.FullPath, isCorrectingParents bool) (scanned, saved *filer_pb.DirectoryStats, err error) {
+
+	before, err := f.readDirectoryStats(ctx, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	if scanned, err = f.rebuildDirectoryStats(ctx, dir); err != nil {
+		return nil, nil, err
+	}
+
+	unlock, err := f.lockStore(&f.dirStatsLock, dirStatsLockName)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer unlock()
+
+	current, err := f.readDirectoryStats(ctx, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	saved = &filer_pb.DirectoryStats{
+		TotalSize:   addWithoutUnderflow(scanned.TotalSize, int64(current.TotalSize)-int64(before.TotalSize)),
+		FileCount:   addWithoutUnderflow(scanned.FileCount, int64(current.FileCount)-int64(before.FileCount)),
+		LatestMtime: scanned.LatestMtime,
+	}
+	if current.LatestMtime > before.LatestMtime && current.LatestMtime > saved.LatestMtime {
+		saved.LatestMtime = current.LatestMtime
+	}
+	if err = f.writeDirectoryStats(ctx, dir, saved); err != nil {
+		return nil, nil, fmt.Errorf("update directory stats of %s: %v", dir, err)
+	}
+	if isCorrectingParents {
+		f.addDirectoryStats(ctx, parentDirectories(dir), int64(saved.TotalSize)-int64(current.TotalSize), int64(saved.FileCount)-int64(current.FileCount), saved.LatestMtime)
+	}
+	return scanned, saved, nil
+}
+
+// rebuildDirectoryStats saves the counters of all the sub directories, and returns the counters of the directory as scanned
+func (f *Filer) rebuildDirectoryStats(ctx context.Context, dir util.FullPath) (*filer_pb.DirectoryStats, error) {
+	stats := &filer_pb.DirectoryStats{}
+	if !isDirectoryStatsCounted(dir) {
+		return stats, nil
+	}
+	lastFileName := ""
+	for {
+		var entries []*Entry
+		_, err := f.Store.ListDirectoryEntries(ctx, dir, lastFileName, false, PaginationSize, func(entry *Entry) bool {
+			entries = append(entries, entry)
+			return true
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list %s: %v", dir, err)
+		}
+		for _, entry := range entries {
+			lastFileName = entry.Name()
+			if !entry.IsDirectory() {
+				stats.TotalSize += entry.Size()
+				stats.FileCount++
+				if mtime := entry.Mtime.Unix(); mtime > stats.LatestMtime {
+					stats.LatestMtime = mtime
+				}
+				continue
+			}
+			// the changes counted during the scan of the sub directory are also counted for this directory
+			subStats, _, err := f.rebuildAndSaveDirectoryStats(ctx, entry.FullPath, false)
+			if err != nil {
+				return nil, err
+			}
+			stats.TotalSize += subStats.TotalSize
+			stats.FileCount += subStats.FileCount
+			if subStats.LatestMtime > stats.LatestMtime {
+				stats.LatestMtime = subStats.LatestMtime
+			}
+		}
+		if len(entries) < PaginationSize {
+			return stats, nil
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package filer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// The changes counted from the metadata events are merged for each directory, and written to the filer store
+// in batches under the store lock, so the peer filers sharing the filer store do not overwrite each other.
+
+const (
+	dirStatsFlushInterval = time.Second
+	dirStatsFlushSize     = 10000
+)
+
+type dirStatsDelta struct {
+	size  int64
+	count int64
+	mtime int64
+}
+
+// dirStatsStep is either the merged changes of the directories, or a directory dropped or reset
+type dirStatsStep struct {
+	deltas map[util.FullPath]*dirStatsDelta
+	dir    util.FullPath
+	isDrop bool
+}
+
+func (step *dirStatsStep) size() int {
+	if step.deltas != nil {
+		return len(step.deltas)
+	}
+	return 1
+}
+
+type dirStatsBatch struct {
+	sync.Mutex
+	steps []*dirStatsStep
+	size  int
+	// the counted sub directories of the directories deleted without events, forgotten when the deletion is counted
+	forgotten map[util.FullPath][]util.FullPath
+	flushNow  chan struct{}
+}
+
+// add queues the change of an entry, for the same changes as the metadata events, and returns the queued size
+func (b *dirStatsBatch) add(oldEntry, newEntry *Entry) int {
+
+	b.Lock()
+	defer b.Unlock()
+
+	if oldEntry != nil && newEntry != nil && oldEntry.FullPath == newEntry.FullPath && !oldEntry.IsDirectory() && !newEntry.IsDirectory() {
+		b.addDeltas(newEntry.FullPath, int64(newEntry.Size())-int64(oldEntry.Size()), 0, newEntry.Mtime.Unix())
+		return b.size
+	}
+
+	if oldEntry != nil {
+		if !oldEntry.IsDirectory() {
+			b.addDeltas(oldEntry.FullPath, -int64(oldEntry.Size()), -1, 0)
+		} else if newEntry == nil || newEntry.FullPath != oldEntry.FullPath {
+			// the files of a deleted bucket are deleted without events
+			b.addStep(&dirStatsStep{dir: oldEntry.FullPath, isDrop: true})
+		}
+	}
+
+	if newEntry != nil {
+		if !newEntry.IsDirectory() {
+			b.addDeltas(newEntry.FullPath, int64(newEntry.Size()), 1, newEntry.Mtime.Unix())
+		} else if oldEntry == nil {
+			// a directory with the same path could be deleted without counting its sub directories
+			b.addStep(&dirStatsStep{dir: newEntry.FullPath})
+		}
+	}
+
+	return b.size
+}
+
+func (b *dirStatsBatch) addDeltas(p util.FullPath, size, count, mtime int64) {
+	var step *dirStatsStep
+	if n := len(b.steps); n > 0 && b.steps[n-1].deltas != nil {
+		step = b.steps[n-1]
+	} else {
+		step = &dirStatsStep{deltas: make(map[util.FullPath]*dirStatsDelta)}
+		b.steps = append(b.steps, step)
+	}
+	for _, dir := range parentDirectories(p) {
+		delta, found := step.deltas[dir]
+		if !found {
+			delta = &dirStatsDelta{}
+			step.deltas[dir] = delta
+			b.size++
+		}
+		delta.size += size
+		delta.count += count
+		if mtime > delta.mtime {
+			delta.mtime = mtime
+		}
+	}
+}
+
+func (b *dirStatsBatch) addStep(step *dirStatsStep) {
+	b.steps = append(b.steps, step)
+	b.size += step.size()
+}
+
+func (b *dirStatsBatch) take() (steps []*dirStatsStep) {
+	b.Lock()
+	defer b.Unlock()
+	steps, b.steps, b.size = b.steps, nil, 0
+	return
+}
+
+// putBack queues the steps not written yet, before the steps queued since they were taken
+func (b *dirStatsBatch) putBack(steps []*dirStatsStep) {
+	b.Lock()
+	defer b.Unlock()
+	for _, step := range steps {
+		b.size += step.size()
+	}
+	b.steps = append(steps, b.steps...)
+}
+
+func (b *dirStatsBatch) forget(dir util.FullPath, subDirs []util.FullPath) {
+	b.Lock()
+	defer b.Unlock()
+	if b.forgotten == nil {
+		b.forgotten = make(map[util.FullPath][]util.FullPath)
+	}
+	b.forgotten[dir] = subDirs
+}
+
+func (b *dirStatsBatch) takeForgotten(dir util.FullPath) []util.FullPath {
+	b.Lock()
+	defer b.Unlock()
+	subDirs := b.forgotten[dir]
+	delete(b.forgotten, dir)
+	return subDirs
+}
+
+func (b *dirStatsBatch) flushSignal() chan struct{} {
+	b.Lock()
+	defer b.Unlock()
+	if b.flushNow == nil {
+		b.flushNow = make(chan struct{}, 1)
+	}
+	return b.flushNow
+}
+
+// countDirectoryStats queues the change of a metadata event, to be written to the directory stats
+func (f *Filer) countDirectoryStats(event *filer_pb.SubscribeMetadataResponse) {
+	oldEntry, newEntry := ReplayedEntries(event)
+	if oldEntry != nil && !isDirectoryStatsCounted(oldEntry.FullPath) {
+		oldEntry = nil
+	}
+	if newEntry != nil && !isDirectoryStatsCounted(newEntry.FullPath) {
+		newEntry = nil
+	}
+	if oldEntry == nil && newEntry == nil {
+		return
+	}
+	if f.dirStats.add(oldEntry, newEntry) >= dirStatsFlushSize {
+		select {
+		case f.dirStats.flushSignal() <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (f *Filer) loopFlushingDirectoryStats() {
+	flushNow := f.dirStats.flushSignal()
+	ticker := time.NewTicker(dirStatsFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-flushNow:
+		}
+		if err := f.flushDirectoryStats(context.Background()); err != nil {
+			glog.Errorf("update directory stats: %v", err)
+		}
+	}
+}
+
+// flushDirectoryStats writes the queued changes, which are queued again if the store lock is not available
+func (f *Filer) flushDirectoryStats(ctx context.Context) error {
+
+	steps := f.dirStats.take()
+	if len(steps) == 0 {
+		return nil
+	}
+
+	unlock, err := f.lockStore(&f.dirStatsLock, dirStatsLockName)
+	if err != nil {
+		f.dirStats.putBack(steps)
+		return err
+	}
+	defer unlock()
+
+	for _, step := range steps {
+		switch {
+		case step.deltas != nil:
+			for dir, delta := range step.deltas {
+				if err := f.doAddDirectoryStats(ctx, dir, delta.size, delta.count, delta.mtime); err != nil {
+					glog.Errorf("update directory stats of %s: %v", dir, err)
+				}
+			}
+		case step.isDrop:
+			f.dropDirectoryStats(ctx, step.dir)
+			for _, subDir := range f.dirStats.takeForgotten(step.dir) {
+				if err := f.Store.KvDelete(ctx, dirStatsKey(subDir)); err != nil {
+					glog.Errorf("drop directory stats of %s: %v", subDir, err)
+				}
+			}
+		default:
+			if err := f.Store.KvDelete(ctx, dirStatsKey(step.dir)); err != nil {
+				glog.Errorf("reset directory stats of %s: %v", step.dir, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// forgetSubDirectoryStats finds the counted sub directories of a directory whose entries are deleted without
+// events, to drop their counters after the changes queued before the directory deletion
+func (f *Filer) forgetSubDirectoryStats(ctx context.Context, dir util.FullPath) {
+	if _, err := f.Store.KvGet(ctx, dirStatsKey(dir)); err != nil {
+		return
+	}
+	var subDirs []util.FullPath
+	if err := f.findCountedSubDirectories(ctx, dir, &subDirs); err != nil {
+		glog.Errorf("find directory stats under %s: %v", dir, err)
+	}
+	f.dirStats.forget(dir, subDirs)
+}
+
+// findCountedSubDirectories skips the directories not counted, since nothing is counted under them
+func (f *Filer) findCountedSubDirectories(ctx context.Context, dir util.FullPath, subDirs *[]util.FullPath) error {
+	lastFileName := ""
+	for {
+		var dirs []util.FullPath
+		count := 0
+		_, err := f.Store.ListDirectoryEntries(ctx, dir, lastFileName, false, PaginationSize, func(entry *Entry) bool {
+			count++
+			lastFileName = entry.Name()
+			if entry.IsDirectory() {
+				dirs = append(dirs, entry.FullPath)
+			}
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("list %s: %v", dir, err)
+		}
+		for _, subDir := range dirs {
+			if _, err := f.Store.KvGet(ctx, dirStatsKey(subDir)); err == ErrKvNotFound {
+				continue
+			} else if err != nil {
+				return err
+			}
+			*subDirs = append(*subDirs, subDir)
+			if err := f.findCountedSubDirectories(ctx, subDir, subDirs); err != nil {
+				return err
+			}
+		}
+		if count < PaginationSize {
+			return nil
+		}
+	}
+}
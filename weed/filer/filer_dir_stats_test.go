@@ -0,0 +1,192 @@
+package filer
+
+import (
+	"context"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// only the key value methods are used by the counters kept in the filer store
+type testKvStore struct {
+	VirtualFilerStore
+	kv map[string][]byte
+}
+
+func (store *testKvStore) KvPut(ctx context.Context, key []byte, value []byte) error {
+	store.kv[string(key)] = value
+	return nil
+}
+
+func (store *testKvStore) KvGet(ctx context.Context, key []byte) ([]byte, error) {
+	value, found := store.kv[string(key)]
+	if !found {
+		return nil, ErrKvNotFound
+	}
+	return value, nil
+}
+
+func (store *testKvStore) KvDelete(ctx context.Context, key []byte) error {
+	delete(store.kv, string(key))
+	return nil
+}
+
+func statsTestFile(p util.FullPath, size uint64, mtime int64) *Entry {
+	return &Entry{FullPath: p, Attr: Attr{Mode: 0644, FileSize: size, Mtime: time.Unix(mtime, 0)}}
+}
+
+func statsTestDir(p util.FullPath) *Entry {
+	return &Entry{FullPath: p, Attr: Attr{Mode: os.ModeDir | 0755}}
+}
+
+func assertDirectoryStats(t *testing.T, f *Filer, p util.FullPath, size, count uint64, mtime int64) {
+	stats, err := f.readDirectoryStats(context.Background(), p)
+	assert.NoError(t, err)
+	assert.Equal(t, size, stats.TotalSize, "size of %s", p)
+	assert.Equal(t, count, stats.FileCount, "count of %s", p)
+	assert.Equal(t, mtime, stats.LatestMtime, "mtime of %s", p)
+}
+
+func TestUpdateDirectoryStats(t *testing.T) {
+
+	ctx := context.Background()
+	f := &Filer{Store: &testKvStore{kv: make(map[string][]byte)}}
+
+	file, dir := statsTestFile, statsTestDir
+	update := func(oldEntry, newEntry *Entry) {
+		f.dirStats.add(oldEntry, newEntry)
+		assert.NoError(t, f.flushDirectoryStats(ctx))
+	}
+	assertStats := func(p util.FullPath, size, count uint64, mtime int64) {
+		assertDirectoryStats(t, f, p, size, count, mtime)
+	}
+
+	update(nil, dir("/a"))
+	update(nil, dir("/a/b"))
+	update(nil, file("/a/b/f1", 10, 1))
+	update(nil, file("/a/f2", 20, 2))
+	assertStats("/a/b", 10, 1, 1)
+	assertStats("/a", 30, 2, 2)
+	assertStats("/", 30, 2, 2)
+
+	// overwritten, and moved to another directory
+	update(file("/a/b/f1", 10, 1), file("/a/b/f1", 15, 3))
+	update(nil, file("/c/f2", 20, 2))
+	update(file("/a/f2", 20, 2), nil)
+	assertStats("/a/b", 15, 1, 3)
+	assertStats("/a", 15, 1, 3)
+	assertStats("/c", 20, 1, 2)
+	assertStats("/", 35, 2, 3)
+
+	// the files are deleted without events, and the directory is created again
+	update(dir("/a"), nil)
+	assertStats("/", 20, 1, 3)
+	update(nil, dir("/a"))
+	update(nil, dir("/a/b"))
+	assertStats("/a/b", 0, 0, 0)
+
+	assert.Equal(t, []util.FullPath{"/a/b", "/a", "/"}, parentDirectories("/a/b/f1"))
+	assert.Equal(t, 0, len(parentDirectories("/")))
+
+}
+
+func TestDirectoryStatsBatch(t *testing.T) {
+
+	ctx := context.Background()
+	f, store := newSnapshotTestFiler()
+	file, dir := statsTestFile, statsTestDir
+
+	// the changes of the same directories are merged until written
+	f.dirStats.add(nil, dir("/b"))
+	f.dirStats.add(nil, file("/b/x/f1", 10, 1))
+	f.dirStats.add(nil, file("/b/x/f2", 20, 2))
+	f.dirStats.add(file("/b/x/f1", 10, 1), file("/b/x/f1", 15, 3))
+	assert.Equal(t, 5, f.dirStats.add(nil, file("/c/f3", 5, 1)))
+	_, err := store.KvGet(ctx, dirStatsKey("/"))
+	assert.Equal(t, ErrKvNotFound, err)
+
+	assert.NoError(t, f.flushDirectoryStats(ctx))
+	assertDirectoryStats(t, f, "/b/x", 35, 2, 3)
+	assertDirectoryStats(t, f, "/", 40, 3, 3)
+	assert.Equal(t, 0, f.dirStats.add(nil, nil))
+
+	// the bucket entries are deleted without events, after a change not counted yet
+	for _, p := range []util.FullPath{"/b", "/b/x", "/b/x/y"} {
+		store.entries[p] = dir(p)
+	}
+	f.dirStats.add(nil, file("/b/x/f4", 1, 4))
+	f.forgetSubDirectoryStats(ctx, "/b")
+	f.dirStats.add(dir("/b"), nil)
+
+	assert.NoError(t, f.flushDirectoryStats(ctx))
+	assertDirectoryStats(t, f, "/", 5, 1, 4)
+	assert.Equal(t, []string{dirStatsKeyPrefix + "/", dirStatsKeyPrefix + "/c"}, sortedKeys(store.kv))
+
+	// the system logs and the snapshots are not counted
+	f.countDirectoryStats(&filer_pb.SubscribeMetadataResponse{
+		Directory: SystemLogDir + "/2021-01-01",
+		EventNotification: &filer_pb.EventNotification{
+			NewEntry: &filer_pb.Entry{Name: "00-00.segment", Attributes: &filer_pb.FuseAttributes{FileSize: 100}},
+		},
+	})
+	assert.Equal(t, 0, f.dirStats.add(nil, nil))
+
+}
+
+// the changes counted by the peer filers while a directory is listed
+type testListingStore struct {
+	*testSnapshotStore
+	onListing map[util.FullPath]func()
+}
+
+func (store *testListingStore) ListDirectoryEntries(ctx context.Context, dirPath util.FullPath, startFileName string, includeStartFile bool, limit int64, eachEntryFunc ListEachEntryFunc) (string, error) {
+	if onListing, found := store.onListing[dirPath]; found {
+		delete(store.onListing, dirPath)
+		onListing()
+	}
+	return store.testSnapshotStore.ListDirectoryEntries(ctx, dirPath, startFileName, includeStartFile, limit, eachEntryFunc)
+}
+
+func TestRebuildDirectoryStats(t *testing.T) {
+
+	ctx := context.Background()
+	f, snapshotStore := newSnapshotTestFiler()
+	store := &testListingStore{testSnapshotStore: snapshotStore, onListing: make(map[util.FullPath]func())}
+	f.Store = store
+	file, dir := statsTestFile, statsTestDir
+
+	for _, entry := range []*Entry{dir("/a"), dir("/a/b"), file("/a/f1", 10, 1), file("/a/b/f2", 20, 2), file("/c", 5, 1)} {
+		store.entries[entry.FullPath] = entry
+	}
+	// the counters are wrong before the rebuild
+	f.addDirectoryStats(ctx, []util.FullPath{"/a/b", "/a", "/"}, 100, 10, 1)
+
+	// a file written by a peer filer while /a/b is scanned
+	store.onListing["/a/b"] = func() {
+		f.addDirectoryStats(ctx, []util.FullPath{"/a/b", "/a", "/"}, 7, 1, 9)
+	}
+
+	assert.NoError(t, f.doRebuildDirectoryStats(ctx, "/a"))
+	assertDirectoryStats(t, f, "/a/b", 27, 2, 9)
+	assertDirectoryStats(t, f, "/a", 37, 3, 9)
+	assertDirectoryStats(t, f, "/", 37, 3, 9)
+
+	assert.NoError(t, f.doRebuildDirectoryStats(ctx, "/"))
+	assertDirectoryStats(t, f, "/a/b", 20, 1, 2)
+	assertDirectoryStats(t, f, "/", 35, 3, 2)
+
+}
+
+func sortedKeys(kv map[string][]byte) (keys []string) {
+	for key := range kv {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return
+}
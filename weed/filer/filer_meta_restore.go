@@ -0,0 +1,279 @@
+package filer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/chrislusf/seaweedfs/weed/util/log_buffer"
+)
+
+// The metadata of a directory tree as of a time is reconstructed from the current entries,
+// by undoing the logged changes made after the time, from the last change back to the first one.
+// Each logged change has both the old and the new entry, so only the log since the time is needed.
+
+// MetadataChange is one change to bring the current entries back to the restored ones
+type MetadataChange struct {
+	FullPath util.FullPath
+	// nil if the entry is created
+	OldEntry *Entry
+	// nil if the entry is deleted
+	NewEntry *Entry
+}
+
+// ReadMetadataLog calls the function for each logged metadata change made through this filer after the time,
+// first from the persisted log files and then from the in-memory log buffer, until all the changes are read.
+func (f *Filer) ReadMetadataLog(startTime time.Time, eachEventFn func(event *filer_pb.SubscribeMetadataResponse) error) error {
+
+	var lastTsNs int64
+	eachLogEntryFn := func(logEntry *filer_pb.LogEntry) error {
+		if logEntry.TsNs <= lastTsNs {
+			return nil
+		}
+		lastTsNs = logEntry.TsNs
+		event := &filer_pb.SubscribeMetadataResponse{}
+		if err := proto.Unmarshal(logEntry.Data, event); err != nil {
+			return fmt.Errorf("unexpected unmarshal filer_pb.SubscribeMetadataResponse: %v", err)
+		}
+		return eachEventFn(event)
+	}
+
+	lastReadTime := startTime
+	for {
+		processedTsNs, err := f.ReadPersistedLogBuffer(lastReadTime, eachLogEntryFn)
+		if err != nil {
+			return fmt.Errorf("reading from persisted logs: %v", err)
+		}
+		if processedTsNs != 0 {
+			lastReadTime = time.Unix(0, processedTsNs)
+		}
+
+		// stop waiting once the in-memory log buffer is read
+		lastReadTime, err = f.LocalMetaLogBuffer.LoopProcessLogData(lastReadTime, func() bool {
+			return false
+		}, eachLogEntryFn)
+		if err == log_buffer.ResumeFromDiskError {
+			continue
+		}
+		return err
+	}
+
+}
+
+// metaLogStartTime returns the start time of the first persisted log file
+func (f *Filer) metaLogStartTime(ctx context.Context) (startTime time.Time, found bool, err error) {
+
+	var day, hourMinute string
+	if _, err = f.Store.ListDirectoryEntries(ctx, SystemLogDir, "", false, 1, func(entry *Entry) bool {
+		day = entry.Name()
+		return false
+	}); err != nil || day == "" {
+		return
+	}
+	if _, err = f.Store.ListDirectoryEntries(ctx, util.NewFullPath(SystemLogDir, day), "", false, 1, func(entry *Entry) bool {
+		hourMinute = entry.Name()
+		return false
+	}); err != nil || hourMinute == "" {
+		return
+	}
+	startTime, err = time.Parse("2006-01-02/15-04.segment", day+"/"+hourMinute)
+	return startTime, err == nil, err
+
+}
+
+// MetadataAt reconstructs the entries of the directory tree as of the time, keyed by the full path,
+// and also returns the current entries
+func (f *Filer) MetadataAt(ctx context.Context, dir util.FullPath, t time.Time) (current, restored map[util.FullPath]*Entry, err error) {
+
+	// the changes made through the peer filers are only in their own metadata logs
+	if f.hasPeerFilers() {
+		return nil, nil, fmt.Errorf("the metadata log of this filer misses the changes made through the peer filers")
+	}
+
+	logStartTime, found, err := f.metaLogStartTime(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("find the metadata log start: %v", err)
+	}
+	if found && t.Before(logStartTime) {
+		return nil, nil, fmt.Errorf("the metadata log starts at %v, after %v", logStartTime, t)
+	}
+
+	var events []*filer_pb.SubscribeMetadataResponse
+	if err := f.ReadMetadataLog(t, func(event *filer_pb.SubscribeMetadataResponse) error {
+		if event.TsNs > t.UnixNano() && isMetadataChangeInTree(event, dir) {
+			events = append(events, event)
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	current, err = f.listTree(ctx, dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	glog.V(1).Infof("restore %s to %v: undo %d changes on %d entries", dir, t, len(events), len(current))
+
+	restored = make(map[util.FullPath]*Entry, len(current))
+	for p, entry := range current {
+		restored[p] = entry
+	}
+	undoMetadataChanges(restored, events, dir)
+
+	return current, restored, nil
+}
+
+// listTree returns the stored entries of the directory tree, including the directory itself
+func (f *Filer) listTree(ctx context.Context, dir util.FullPath) (map[util.FullPath]*Entry, error) {
+
+	entries := make(map[util.FullPath]*Entry)
+
+	dirEntry, err := f.Store.FindEntry(ctx, dir)
+	if err == filer_pb.ErrNotFound {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	entries[dir] = dirEntry
+	if !dirEntry.IsDirectory() {
+		return entries, nil
+	}
+
+	var dirs []util.FullPath
+	for parent := dir; ; {
+		lastFileName := ""
+		for {
+			var count int
+			if _, err := f.Store.ListDirectoryEntries(ctx, parent, lastFileName, false, PaginationSize, func(entry *Entry) bool {
+				entries[entry.FullPath] = entry
+				if entry.IsDirectory() {
+					dirs = append(dirs, entry.FullPath)
+				}
+				lastFileName = entry.Name()
+				count++
+				return true
+			}); err != nil {
+				return nil, fmt.Errorf("list %s: %v", parent, err)
+			}
+			if count < PaginationSize {
+				break
+			}
+		}
+		if len(dirs) == 0 {
+			break
+		}
+		parent, dirs = dirs[0], dirs[1:]
+	}
+
+	return entries, nil
+}
+
+func isInTree(p, dir util.FullPath) bool {
+	return p == dir || dir == "/" || strings.HasPrefix(string(p), string(dir)+"/")
+}
+
+func isMetadataChangeInTree(event *filer_pb.SubscribeMetadataResponse, dir util.FullPath) bool {
+	message := event.EventNotification
+	if message.OldEntry != nil && isInTree(util.NewFullPath(event.Directory, message.OldEntry.Name), dir) {
+		return true
+	}
+	if message.NewEntry != nil {
+		newParentPath := message.NewParentPath
+		if newParentPath == "" {
+			newParentPath = event.Directory
+		}
+		return isInTree(util.NewFullPath(newParentPath, message.NewEntry.Name), dir)
+	}
+	return false
+}
+
+// undoMetadataChanges applies the changes in reverse order, replacing the new entries with the old ones
+func undoMetadataChanges(entries map[util.FullPath]*Entry, events []*filer_pb.SubscribeMetadataResponse, dir util.FullPath) {
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		message := event.EventNotification
+		if message.NewEntry != nil {
+			newParentPath := message.NewParentPath
+			if newParentPath == "" {
+				newParentPath = event.Directory
+			}
+			if p := util.NewFullPath(newParentPath, message.NewEntry.Name); isInTree(p, dir) {
+				delete(entries, p)
+			}
+		}
+		if message.OldEntry != nil {
+			if p := util.NewFullPath(event.Directory, message.OldEntry.Name); isInTree(p, dir) {
+				entries[p] = FromPbEntry(event.Directory, message.OldEntry)
+			}
+		}
+	}
+}
+
+// RebaseTree moves the entries of the directory tree to the target directory
+func RebaseTree(entries map[util.FullPath]*Entry, dir, targetDir util.FullPath) map[util.FullPath]*Entry {
+	if dir == targetDir {
+		return entries
+	}
+	rebased := make(map[util.FullPath]*Entry, len(entries))
+	for p, entry := range entries {
+		newPath := targetDir + p[len(dir):]
+		newEntry := *entry
+		newEntry.FullPath = newPath
+		rebased[newPath] = &newEntry
+	}
+	return rebased
+}
+
+// DiffTrees lists the changes from the current entries to the restored ones.
+// The deletions come first, children before their parents, and then the creations and updates, parents before their children.
+func DiffTrees(current, restored map[util.FullPath]*Entry) (changes []*MetadataChange) {
+
+	var deletions, updates []*MetadataChange
+	for p, oldEntry := range current {
+		newEntry, found := restored[p]
+		if !found || oldEntry.IsDirectory() != newEntry.IsDirectory() {
+			deletions = append(deletions, &MetadataChange{FullPath: p, OldEntry: oldEntry})
+		}
+	}
+	for p, newEntry := range restored {
+		oldEntry, found := current[p]
+		if found && oldEntry.IsDirectory() != newEntry.IsDirectory() {
+			oldEntry, found = nil, false
+		}
+		if !found || !isSameRestoredEntry(oldEntry, newEntry) {
+			updates = append(updates, &MetadataChange{FullPath: p, OldEntry: oldEntry, NewEntry: newEntry})
+		}
+	}
+
+	sort.Slice(deletions, func(i, j int) bool {
+		return deletions[i].FullPath > deletions[j].FullPath
+	})
+	sort.Slice(updates, func(i, j int) bool {
+		return updates[i].FullPath < updates[j].FullPath
+	})
+	return append(deletions, updates...)
+}
+
+// isSameRestoredEntry compares the entries, with the recovered chunks copied to other file ids
+func isSameRestoredEntry(a, b *Entry) bool {
+	if len(a.Chunks) != len(b.Chunks) {
+		return false
+	}
+	for i, chunk := range a.Chunks {
+		other := b.Chunks[i]
+		if chunk.Offset != other.Offset || chunk.Size != other.Size || chunk.Mtime != other.Mtime || chunk.IsChunkManifest != other.IsChunkManifest {
+			return false
+		}
+	}
+	x, y := a.ToProtoEntry(), b.ToProtoEntry()
+	x.Chunks, y.Chunks = nil, nil
+	return proto.Equal(x, y)
+}
@@ -0,0 +1,96 @@
+package filer
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func TestUndoMetadataChanges(t *testing.T) {
+
+	file := func(p util.FullPath, fileId string, mtime int64) *Entry {
+		return &Entry{
+			FullPath: p,
+			Attr:     Attr{Mode: 0644},
+			Chunks:   []*filer_pb.FileChunk{{FileId: fileId, Size: 3, Mtime: mtime}},
+		}
+	}
+	event := func(dir string, oldEntry, newEntry *Entry) *filer_pb.SubscribeMetadataResponse {
+		message := &filer_pb.EventNotification{
+			OldEntry: oldEntry.ToProtoEntry(),
+			NewEntry: newEntry.ToProtoEntry(),
+		}
+		if newEntry != nil {
+			message.NewParentPath, _ = newEntry.FullPath.DirAndName()
+		}
+		return &filer_pb.SubscribeMetadataResponse{Directory: dir, EventNotification: message}
+	}
+
+	dir := &Entry{FullPath: "/d", Attr: Attr{Mode: os.ModeDir | 0755}}
+	current := map[util.FullPath]*Entry{
+		"/d":   dir,
+		"/d/a": file("/d/a", "1,a2", 2),
+		"/d/c": file("/d/c", "1,c", 1),
+		"/d/y": file("/d/y", "1,x", 1),
+	}
+	events := []*filer_pb.SubscribeMetadataResponse{
+		event("/d", file("/d/a", "1,a1", 1), file("/d/a", "1,a2", 2)),
+		event("/d", file("/d/b", "1,b", 1), nil),
+		event("/d", nil, file("/d/c", "1,c", 1)),
+		// renamed in the tree, and moved out of the tree
+		event("/d", file("/d/x", "1,x", 1), file("/d/y", "1,x", 1)),
+		event("/d", file("/d/z", "1,z", 1), file("/e/z", "1,z", 1)),
+	}
+
+	restored := make(map[util.FullPath]*Entry)
+	for p, entry := range current {
+		restored[p] = entry
+	}
+	undoMetadataChanges(restored, events, "/d")
+
+	assert.Equal(t, 5, len(restored))
+	assert.Equal(t, "1,a1", restored["/d/a"].Chunks[0].FileId)
+	assert.Contains(t, restored, util.FullPath("/d/b"))
+	assert.Contains(t, restored, util.FullPath("/d/x"))
+	assert.Contains(t, restored, util.FullPath("/d/z"))
+	assert.NotContains(t, restored, util.FullPath("/d/c"))
+	assert.NotContains(t, restored, util.FullPath("/d/y"))
+
+	var changes []string
+	for _, change := range DiffTrees(current, restored) {
+		action := "update"
+		if change.OldEntry == nil {
+			action = "create"
+		} else if change.NewEntry == nil {
+			action = "delete"
+		}
+		changes = append(changes, action+" "+string(change.FullPath))
+	}
+	assert.Equal(t, []string{"delete /d/y", "delete /d/c", "update /d/a", "create /d/b", "create /d/x", "create /d/z"}, changes)
+
+	// the recovered chunks are copied to other file ids
+	recovered := file("/d/a", "2,a1", 1)
+	assert.True(t, isSameRestoredEntry(restored["/d/a"], recovered))
+	overwritten := file("/d/a", "1,a2", 2)
+	overwritten.Chunks[0].Size = 4
+	assert.False(t, isSameRestoredEntry(current["/d/a"], overwritten))
+
+	rebased := RebaseTree(restored, "/d", "/restored")
+	assert.Equal(t, util.FullPath("/restored/a"), rebased["/restored/a"].FullPath)
+	assert.Equal(t, util.FullPath("/d/a"), restored["/d/a"].FullPath)
+
+}
+
+func TestMetadataAtWithPeerFilers(t *testing.T) {
+
+	f := &Filer{MetaAggregator: &MetaAggregator{filers: []string{"localhost:8888", "localhost:8889"}}}
+	_, _, err := f.MetadataAt(context.Background(), "/d", time.Now())
+	assert.Error(t, err)
+
+}
@@ -0,0 +1,219 @@
+package filer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// The S3 object lock is kept in the entry extended attributes, and enforced here
+// so that locked objects can not be deleted or overwritten through FUSE, WebDAV,
+// or the filer HTTP and gRPC APIs either.
+const (
+	ObjectLockModeKey            = "Seaweed-X-Amz-Object-Lock-Mode"
+	ObjectLockRetainUntilDateKey = "Seaweed-X-Amz-Object-Lock-Retain-Until-Date"
+	ObjectLockLegalHoldKey       = "Seaweed-X-Amz-Object-Lock-Legal-Hold"
+
+	// kept in the bucket entry extended attributes, if the bucket has object lock enabled
+	BucketObjectLockKey = "s3-object-lock"
+
+	ObjectLockGovernance = "GOVERNANCE"
+	ObjectLockCompliance = "COMPLIANCE"
+	LegalHoldOn          = "ON"
+	LegalHoldOff         = "OFF"
+
+	// the gRPC metadata to delete objects or shorten the retention under GOVERNANCE mode,
+	// with a jwt signed by the filer signing key, so only the S3 gateways sharing the key can bypass the retention.
+	// The metadata key is also the purpose claim of the jwt, so a jwt can not be used for the other privilege.
+	BypassGovernanceRetentionKey = "seaweed-bypass-governance-retention"
+	// the gRPC metadata to turn the legal hold off, for the S3 PutObjectLegalHold, also with a jwt
+	ChangeLegalHoldKey = "seaweed-change-legal-hold"
+)
+
+var ErrObjectLocked = errors.New("object is locked")
+
+type objectLockContextKey int
+
+const (
+	bypassGovernanceRetentionContextKey objectLockContextKey = iota
+	movingEntryContextKey
+	changeLegalHoldContextKey
+)
+
+// WithBypassGovernanceRetention allows to delete objects under GOVERNANCE retention, or to shorten the retention.
+func WithBypassGovernanceRetention(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassGovernanceRetentionContextKey, true)
+}
+
+// WithChangingLegalHold allows to turn the legal hold off.
+func WithChangingLegalHold(ctx context.Context) context.Context {
+	return context.WithValue(ctx, changeLegalHoldContextKey, true)
+}
+
+func isChangingLegalHold(ctx context.Context) bool {
+	changing, _ := ctx.Value(changeLegalHoldContextKey).(bool)
+	return changing
+}
+
+// WithMovingEntry marks the changes which keep the object data,
+// e.g. removing the old entry after a rename, or moving the chunks to another storage class.
+func WithMovingEntry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, movingEntryContextKey, true)
+}
+
+func isMovingEntry(ctx context.Context) bool {
+	moving, _ := ctx.Value(movingEntryContextKey).(bool)
+	return moving
+}
+
+func isBypassingGovernanceRetention(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassGovernanceRetentionContextKey).(bool)
+	return bypass
+}
+
+// ObjectRetention returns the retention mode and date, or an empty mode if the object has no retention.
+func ObjectRetention(extended map[string][]byte) (mode string, retainUntil time.Time) {
+	mode = string(extended[ObjectLockModeKey])
+	if mode == "" {
+		return "", time.Time{}
+	}
+	retainUntil, err := time.Parse(time.RFC3339, string(extended[ObjectLockRetainUntilDateKey]))
+	if err != nil {
+		return "", time.Time{}
+	}
+	return mode, retainUntil
+}
+
+func IsLegalHold(extended map[string][]byte) bool {
+	return string(extended[ObjectLockLegalHoldKey]) == LegalHoldOn
+}
+
+// checkDeleteObjectLock refuses to delete a file under legal hold or with an unexpired retention.
+func checkDeleteObjectLock(ctx context.Context, entry *Entry, now time.Time) error {
+	if entry.IsDirectory() || len(entry.Extended) == 0 || isMovingEntry(ctx) {
+		return nil
+	}
+	if IsLegalHold(entry.Extended) {
+		return fmt.Errorf("%s: %w under legal hold", entry.FullPath, ErrObjectLocked)
+	}
+	mode, retainUntil := ObjectRetention(entry.Extended)
+	if mode == "" || !now.Before(retainUntil) {
+		return nil
+	}
+	if mode == ObjectLockGovernance && isBypassingGovernanceRetention(ctx) {
+		return nil
+	}
+	return fmt.Errorf("%s: %w in %s mode until %s", entry.FullPath, ErrObjectLocked, mode, retainUntil.Format(time.RFC3339))
+}
+
+// checkUpdateObjectLock refuses to change the content of a locked file, or to weaken its retention.
+// The retention can be extended, or changed from GOVERNANCE to COMPLIANCE mode,
+// and the legal hold can be turned on, but only turned off by the S3 PutObjectLegalHold.
+func checkUpdateObjectLock(ctx context.Context, oldEntry, entry *Entry, now time.Time) error {
+	if oldEntry == nil || oldEntry.IsDirectory() || len(oldEntry.Extended) == 0 || isMovingEntry(ctx) {
+		return nil
+	}
+
+	mode, retainUntil := ObjectRetention(oldEntry.Extended)
+	retained := mode != "" && now.Before(retainUntil)
+	if !retained && !IsLegalHold(oldEntry.Extended) {
+		return nil
+	}
+
+	if !sameObjectData(oldEntry, entry) {
+		return fmt.Errorf("%s: %w, the content can not be changed", oldEntry.FullPath, ErrObjectLocked)
+	}
+	if IsLegalHold(oldEntry.Extended) && !IsLegalHold(entry.Extended) && !isChangingLegalHold(ctx) {
+		return fmt.Errorf("%s: %w under legal hold, which can only be turned off by the S3 PutObjectLegalHold", oldEntry.FullPath, ErrObjectLocked)
+	}
+	if !retained {
+		return nil
+	}
+
+	newMode, newRetainUntil := ObjectRetention(entry.Extended)
+	weakened := newRetainUntil.Before(retainUntil) ||
+		(newMode != ObjectLockGovernance && newMode != ObjectLockCompliance) ||
+		(mode == ObjectLockCompliance && newMode != ObjectLockCompliance)
+	if !weakened || mode == ObjectLockGovernance && isBypassingGovernanceRetention(ctx) {
+		return nil
+	}
+	return fmt.Errorf("%s: %w in %s mode until %s, the retention can not be shortened", oldEntry.FullPath, ErrObjectLocked, mode, retainUntil.Format(time.RFC3339))
+}
+
+// sameObjectData compares the data of two versions of one entry, ignoring the chunk order.
+func sameObjectData(a, b *Entry) bool {
+	if a.FileSize != b.FileSize || !bytes.Equal(a.Content, b.Content) || !bytes.Equal(a.HardLinkId, b.HardLinkId) {
+		return false
+	}
+	if len(a.Chunks) != len(b.Chunks) {
+		return false
+	}
+	chunks := make(map[string]bool, len(a.Chunks))
+	for _, chunk := range a.Chunks {
+		chunks[fmt.Sprintf("%s,%d,%d", chunk.GetFileIdString(), chunk.Offset, chunk.Size)] = true
+	}
+	for _, chunk := range b.Chunks {
+		if !chunks[fmt.Sprintf("%s,%d,%d", chunk.GetFileIdString(), chunk.Offset, chunk.Size)] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkFolderObjectLock goes through the folder before deleting it recursively,
+// so that a locked object does not leave the folder half deleted.
+// Only buckets with object lock enabled, and the folders containing them, are checked.
+func (f *Filer) checkFolderObjectLock(ctx context.Context, entry *Entry, now time.Time) error {
+
+	if isMovingEntry(ctx) || !f.mayHaveLockedObjects(ctx, entry.FullPath) {
+		return nil
+	}
+
+	lastFileName := ""
+	for {
+		entries, hasMore, err := f.ListDirectoryEntries(ctx, entry.FullPath, lastFileName, false, PaginationSize, "", "", "")
+		if err != nil {
+			return fmt.Errorf("list folder %s: %v", entry.FullPath, err)
+		}
+		for _, sub := range entries {
+			lastFileName = sub.Name()
+			if sub.IsDirectory() {
+				err = f.checkFolderObjectLock(ctx, sub, now)
+			} else {
+				err = checkDeleteObjectLock(ctx, sub, now)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if !hasMore {
+			return nil
+		}
+	}
+}
+
+func (f *Filer) mayHaveLockedObjects(ctx context.Context, p util.FullPath) bool {
+	bucketsPath := f.DirBucketsPath
+	if bucketsPath == "" {
+		return false
+	}
+	if !strings.HasPrefix(string(p)+"/", bucketsPath+"/") {
+		// one of the parent folders of all buckets
+		return strings.HasPrefix(bucketsPath+"/", string(p)+"/") || p == "/"
+	}
+	if string(p) == bucketsPath {
+		return true
+	}
+	bucket := strings.SplitN(strings.TrimPrefix(string(p), bucketsPath+"/"), "/", 2)[0]
+	bucketEntry, err := f.FindEntry(ctx, util.NewFullPath(bucketsPath, bucket))
+	if err != nil {
+		return true
+	}
+	_, found := bucketEntry.Extended[BucketObjectLockKey]
+	return found
+}
@@ -0,0 +1,91 @@
+package filer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func lockedEntry(mode string, retainUntil time.Time, legalHold string) *Entry {
+	entry := &Entry{
+		FullPath: "/buckets/archive/report.pdf",
+		Attr:     Attr{FileSize: 3},
+		Chunks:   []*filer_pb.FileChunk{{FileId: "3,01637037d6", Size: 3}},
+		Extended: map[string][]byte{},
+	}
+	if mode != "" {
+		entry.Extended[ObjectLockModeKey] = []byte(mode)
+		entry.Extended[ObjectLockRetainUntilDateKey] = []byte(retainUntil.UTC().Format(time.RFC3339))
+	}
+	if legalHold != "" {
+		entry.Extended[ObjectLockLegalHoldKey] = []byte(legalHold)
+	}
+	return entry
+}
+
+func TestCheckDeleteObjectLock(t *testing.T) {
+
+	now := time.Now()
+	ctx := context.Background()
+	bypass := WithBypassGovernanceRetention(ctx)
+	grpcBypass := metadata.NewIncomingContext(ctx, metadata.Pairs(BypassGovernanceRetentionKey, "true"))
+
+	governance := lockedEntry(ObjectLockGovernance, now.Add(time.Hour), "")
+	assert.True(t, errors.Is(checkDeleteObjectLock(ctx, governance, now), ErrObjectLocked))
+	assert.Nil(t, checkDeleteObjectLock(bypass, governance, now))
+	// the gRPC metadata is only honored after the filer server verifies it
+	assert.NotNil(t, checkDeleteObjectLock(grpcBypass, governance, now))
+	assert.Nil(t, checkDeleteObjectLock(ctx, governance, now.Add(2*time.Hour)))
+
+	compliance := lockedEntry(ObjectLockCompliance, now.Add(time.Hour), "")
+	assert.NotNil(t, checkDeleteObjectLock(bypass, compliance, now))
+
+	legalHold := lockedEntry("", now, LegalHoldOn)
+	assert.NotNil(t, checkDeleteObjectLock(bypass, legalHold, now))
+	assert.Nil(t, checkDeleteObjectLock(ctx, lockedEntry("", now, LegalHoldOff), now))
+
+	assert.Nil(t, checkDeleteObjectLock(WithMovingEntry(ctx), compliance, now))
+
+}
+
+func TestCheckUpdateObjectLock(t *testing.T) {
+
+	now := time.Now()
+	ctx := context.Background()
+	retainUntil := now.Add(time.Hour)
+
+	// the content can not be changed
+	oldEntry := lockedEntry(ObjectLockCompliance, retainUntil, "")
+	newEntry := lockedEntry(ObjectLockCompliance, retainUntil, "")
+	newEntry.Chunks = []*filer_pb.FileChunk{{FileId: "4,02637037d6", Size: 3}}
+	assert.NotNil(t, checkUpdateObjectLock(ctx, oldEntry, newEntry, now))
+	assert.Nil(t, checkUpdateObjectLock(WithMovingEntry(ctx), oldEntry, newEntry, now))
+
+	// the retention can be extended, but not shortened
+	assert.Nil(t, checkUpdateObjectLock(ctx, oldEntry, lockedEntry(ObjectLockCompliance, retainUntil.Add(time.Hour), LegalHoldOn), now))
+	assert.NotNil(t, checkUpdateObjectLock(ctx, oldEntry, lockedEntry(ObjectLockCompliance, now.Add(time.Minute), ""), now))
+	assert.NotNil(t, checkUpdateObjectLock(WithBypassGovernanceRetention(ctx), oldEntry, lockedEntry(ObjectLockGovernance, retainUntil, ""), now))
+	assert.NotNil(t, checkUpdateObjectLock(ctx, oldEntry, lockedEntry("", now, ""), now))
+
+	// GOVERNANCE mode can be strengthened, or shortened with the bypass
+	oldEntry = lockedEntry(ObjectLockGovernance, retainUntil, "")
+	assert.Nil(t, checkUpdateObjectLock(ctx, oldEntry, lockedEntry(ObjectLockCompliance, retainUntil, ""), now))
+	assert.NotNil(t, checkUpdateObjectLock(ctx, oldEntry, lockedEntry("", now, ""), now))
+	assert.Nil(t, checkUpdateObjectLock(WithBypassGovernanceRetention(ctx), oldEntry, lockedEntry("", now, ""), now))
+
+	// the legal hold can only be turned off with the S3 authorization, and the content stays unchanged while it is on
+	oldEntry = lockedEntry("", now, LegalHoldOn)
+	assert.NotNil(t, checkUpdateObjectLock(ctx, oldEntry, lockedEntry("", now, LegalHoldOff), now))
+	assert.NotNil(t, checkUpdateObjectLock(ctx, oldEntry, lockedEntry("", now, ""), now))
+	assert.NotNil(t, checkUpdateObjectLock(WithBypassGovernanceRetention(ctx), oldEntry, lockedEntry("", now, LegalHoldOff), now))
+	assert.Nil(t, checkUpdateObjectLock(WithChangingLegalHold(ctx), oldEntry, lockedEntry("", now, LegalHoldOff), now))
+	newEntry = lockedEntry("", now, LegalHoldOn)
+	newEntry.Content = []byte("new")
+	assert.NotNil(t, checkUpdateObjectLock(ctx, oldEntry, newEntry, now))
+
+}
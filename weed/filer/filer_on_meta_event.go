@@ -13,6 +13,8 @@ import (
 func (f *Filer) onMetadataChangeEvent(event *filer_pb.SubscribeMetadataResponse) {
 	f.maybeReloadFilerConfiguration(event)
 	f.onBucketEvents(event)
+	f.onSnapshotEvents(event)
+	f.quota.onMetadataChangeEvent(event)
 }
 
 func (f *Filer) onBucketEvents(event *filer_pb.SubscribeMetadataResponse) {
@@ -67,6 +69,7 @@ func (f *Filer) reloadFilerConfiguration(entry *filer_pb.Entry) {
 		return
 	}
 	f.FilerConf = fc
+	f.quota.syncQuotaLocations(fc)
 }
 
 func (f *Filer) LoadFilerConf() {
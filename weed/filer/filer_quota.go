@@ -0,0 +1,343 @@
+package filer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// DirectoryUsage is the total file size and the number of files under a location prefix
+type DirectoryUsage struct {
+	Bytes   int64
+	Objects int64
+	// the changes before the scan started are counted by the scan
+	sinceNs int64
+	// while scanning, the changes of the files not passed by the scan yet are counted by the scan
+	isScanning  bool
+	scannedPath util.FullPath
+	isBucket    bool
+	hasQuota    bool
+}
+
+// QuotaTracker keeps the usage of each bucket and each location prefix with a quota.
+// The usage is counted by scanning the filer store once, and then updated by the metadata change events
+// from all the filers. During the scan, the events only count the changes of the files already passed by the scan.
+// A change is only counted twice, or missed, if its event arrives after the scan passes the file.
+type QuotaTracker struct {
+	sync.RWMutex
+	filer   *Filer
+	started bool
+	// keyed by the location prefix, e.g., /buckets/bucket1/ for a bucket
+	usages map[string]*DirectoryUsage
+	// the location prefixes with quotas, which are matched for each change
+	quotaLocations []string
+}
+
+func newQuotaTracker(f *Filer) *QuotaTracker {
+	return &QuotaTracker{
+		filer:  f,
+		usages: make(map[string]*DirectoryUsage),
+	}
+}
+
+func (f *Filer) bucketLocation(bucket string) string {
+	return f.DirBucketsPath + "/" + bucket + "/"
+}
+
+// StartQuotaTracking counts the usage of all the buckets and all the location prefixes with quotas
+func (f *Filer) StartQuotaTracking() {
+	var buckets []string
+	if f.buckets != nil {
+		f.buckets.RLock()
+		for name := range f.buckets.buckets {
+			buckets = append(buckets, f.bucketLocation(string(name)))
+		}
+		f.buckets.RUnlock()
+	}
+
+	qt := f.quota
+	qt.Lock()
+	qt.started = true
+	qt.Unlock()
+
+	qt.track(buckets, true)
+	qt.syncQuotaLocations(f.FilerConf)
+}
+
+// DirectoryUsage returns the usage of a bucket or a location prefix with a quota
+func (f *Filer) DirectoryUsage(location string) (usage DirectoryUsage, found bool) {
+	f.quota.RLock()
+	defer f.quota.RUnlock()
+	if u, ok := f.quota.usages[location]; ok {
+		return *u, true
+	}
+	return
+}
+
+// CheckQuota returns ErrQuotaExceeded if adding the bytes and the objects under the path goes over any quota
+func (f *Filer) CheckQuota(path string, bytes, objects int64) error {
+	for _, rule := range f.FilerConf.MatchQuotaRules(path) {
+		usage, found := f.DirectoryUsage(rule.LocationPrefix)
+		if !found {
+			continue
+		}
+		if rule.QuotaBytes > 0 && bytes > 0 && usage.Bytes+bytes > int64(rule.QuotaBytes) {
+			return fmt.Errorf("%w: %s uses %d of %d bytes", ErrQuotaExceeded, rule.LocationPrefix, usage.Bytes, rule.QuotaBytes)
+		}
+		if rule.QuotaObjects > 0 && objects > 0 && usage.Objects+objects > int64(rule.QuotaObjects) {
+			return fmt.Errorf("%w: %s has %d of %d objects", ErrQuotaExceeded, rule.LocationPrefix, usage.Objects, rule.QuotaObjects)
+		}
+	}
+	return nil
+}
+
+// track starts to count the usage of the new locations
+func (qt *QuotaTracker) track(locations []string, isBucket bool) {
+	var toScan []string
+	now := time.Now().UnixNano()
+	qt.Lock()
+	for _, location := range locations {
+		usage, found := qt.usages[location]
+		if !found {
+			usage = &DirectoryUsage{sinceNs: now, isScanning: true}
+			qt.usages[location] = usage
+			toScan = append(toScan, location)
+		}
+		if isBucket {
+			usage.isBucket = true
+		} else {
+			usage.hasQuota = true
+		}
+	}
+	qt.Unlock()
+
+	if len(toScan) > 0 {
+		go qt.scan(toScan)
+	}
+}
+
+// syncQuotaLocations follows the location prefixes with quotas after the filer configuration is changed
+func (qt *QuotaTracker) syncQuotaLocations(fc *FilerConf) {
+	qt.RLock()
+	started := qt.started
+	qt.RUnlock()
+	if !started {
+		return
+	}
+
+	var quotaLocations []string
+	for _, rule := range fc.QuotaRules() {
+		quotaLocations = append(quotaLocations, rule.LocationPrefix)
+	}
+	qt.track(quotaLocations, false)
+
+	isQuotaLocation := make(map[string]bool)
+	for _, location := range quotaLocations {
+		isQuotaLocation[location] = true
+	}
+	qt.Lock()
+	for location, usage := range qt.usages {
+		if usage.hasQuota && !isQuotaLocation[location] {
+			usage.hasQuota = false
+			if !usage.isBucket {
+				delete(qt.usages, location)
+			}
+		}
+	}
+	qt.quotaLocations = quotaLocations
+	qt.Unlock()
+}
+
+func (qt *QuotaTracker) scan(locations []string) {
+	for _, location := range locations {
+		qt.RLock()
+		usage := qt.usages[location]
+		qt.RUnlock()
+		if usage == nil {
+			continue
+		}
+		err := qt.filer.walkUsage(location, func(entry *Entry) {
+			qt.Lock()
+			defer qt.Unlock()
+			if !entry.IsDirectory() {
+				usage.Bytes += int64(entry.Size())
+				usage.Objects++
+			}
+			usage.scannedPath = entry.FullPath
+		})
+		qt.Lock()
+		usage.isScanning = false
+		bytes, objects := usage.Bytes, usage.Objects
+		qt.Unlock()
+		if err != nil {
+			glog.Errorf("count usage of %s: %v", location, err)
+			continue
+		}
+		glog.V(1).Infof("usage of %s: %d bytes, %d objects", location, bytes, objects)
+	}
+}
+
+// walkUsage visits the files under the location prefix, in the order of isScannedBefore
+func (f *Filer) walkUsage(location string, fn func(entry *Entry)) error {
+	dir, prefix := util.FullPath(location), ""
+	if !strings.HasSuffix(location, "/") {
+		var d string
+		d, prefix = dir.DirAndName()
+		dir = util.FullPath(d)
+	}
+	return f.walkEntries(context.Background(), dir, prefix, fn)
+}
+
+// walkEntries visits all the files under the directory, with the name prefix for the top level entries,
+// and each sub directory after all its files, in the order of the names
+func (f *Filer) walkEntries(ctx context.Context, dir util.FullPath, prefix string, fn func(entry *Entry)) error {
+	if strings.HasPrefix(string(dir), SystemLogDir) {
+		return nil
+	}
+	lastFileName := ""
+	for {
+		var subDir *Entry
+		count := 0
+		_, err := f.StreamListDirectoryEntries(ctx, dir, lastFileName, false, PaginationSize, prefix, "", "", func(entry *Entry) bool {
+			count++
+			lastFileName = entry.Name()
+			if entry.IsDirectory() {
+				// the entries listed after the sub directory are listed again after it is walked
+				subDir = entry
+				return false
+			}
+			fn(entry)
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("list %s: %v", dir, err)
+		}
+		if subDir != nil {
+			if err = f.walkEntries(ctx, subDir.FullPath, "", fn); err != nil {
+				return err
+			}
+			fn(subDir)
+			continue
+		}
+		if count < PaginationSize {
+			return nil
+		}
+	}
+}
+
+// isScannedBefore is true if the scan has passed the path, after visiting the scanned path,
+// which is the last file visited, or the last directory with all its files visited
+func isScannedBefore(p, scannedPath util.FullPath) bool {
+	if scannedPath == "" {
+		return false
+	}
+	if strings.HasPrefix(string(p), string(scannedPath)+"/") {
+		return true
+	}
+	names, scannedNames := p.Split(), scannedPath.Split()
+	for i := 0; i < len(names) && i < len(scannedNames); i++ {
+		if names[i] != scannedNames[i] {
+			return names[i] < scannedNames[i]
+		}
+	}
+	return len(names) <= len(scannedNames)
+}
+
+func (qt *QuotaTracker) onMetadataChangeEvent(event *filer_pb.SubscribeMetadataResponse) {
+	message := event.EventNotification
+
+	if message.OldEntry != nil {
+		oldPath := util.NewFullPath(event.Directory, message.OldEntry.Name)
+		if !message.OldEntry.IsDirectory {
+			qt.add(string(oldPath), -int64(FileSize(message.OldEntry)), -1, event.TsNs)
+		} else if message.NewEntry == nil && event.Directory == qt.filer.DirBucketsPath {
+			qt.onBucketDeletion(message.OldEntry.Name)
+		}
+	}
+
+	if message.NewEntry != nil {
+		newParent := message.NewParentPath
+		if newParent == "" {
+			newParent = event.Directory
+		}
+		if !message.NewEntry.IsDirectory {
+			qt.add(string(util.NewFullPath(newParent, message.NewEntry.Name)), int64(FileSize(message.NewEntry)), 1, event.TsNs)
+		} else if message.OldEntry == nil && newParent == qt.filer.DirBucketsPath {
+			qt.onBucketCreation(message.NewEntry.Name)
+		}
+	}
+}
+
+func (qt *QuotaTracker) add(path string, bytes, objects int64, tsNs int64) {
+	qt.Lock()
+	defer qt.Unlock()
+
+	apply := func(location string) {
+		usage, found := qt.usages[location]
+		if !found || tsNs < usage.sinceNs {
+			return
+		}
+		if !usage.isScanning || isScannedBefore(util.FullPath(path), usage.scannedPath) {
+			usage.Bytes += bytes
+			usage.Objects += objects
+		}
+	}
+
+	var bucketLocation string
+	if strings.HasPrefix(path, qt.filer.DirBucketsPath+"/") {
+		bucketAndObject := path[len(qt.filer.DirBucketsPath)+1:]
+		if t := strings.Index(bucketAndObject, "/"); t > 0 {
+			bucketLocation = qt.filer.bucketLocation(bucketAndObject[:t])
+			apply(bucketLocation)
+		}
+	}
+	for _, location := range qt.quotaLocations {
+		if location != bucketLocation && strings.HasPrefix(path, location) {
+			apply(location)
+		}
+	}
+}
+
+// onBucketCreation tracks the new empty bucket without scanning
+func (qt *QuotaTracker) onBucketCreation(bucket string) {
+	qt.Lock()
+	defer qt.Unlock()
+	if !qt.started {
+		return
+	}
+	location := qt.filer.bucketLocation(bucket)
+	if usage, found := qt.usages[location]; found {
+		usage.isBucket = true
+		return
+	}
+	qt.usages[location] = &DirectoryUsage{isBucket: true}
+}
+
+// onBucketDeletion drops the usage under the bucket, since the bucket files are deleted without events
+func (qt *QuotaTracker) onBucketDeletion(bucket string) {
+	qt.Lock()
+	defer qt.Unlock()
+	bucketLocation := qt.filer.bucketLocation(bucket)
+	for location, usage := range qt.usages {
+		if location != bucketLocation && !strings.HasPrefix(location, bucketLocation) {
+			continue
+		}
+		if location == bucketLocation {
+			if !usage.hasQuota {
+				delete(qt.usages, location)
+				continue
+			}
+			usage.isBucket = false
+		}
+		usage.Bytes, usage.Objects = 0, 0
+	}
+}
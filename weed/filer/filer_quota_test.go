@@ -0,0 +1,131 @@
+package filer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestFileEvent(dir string, oldEntry, newEntry *filer_pb.Entry, newParentPath string) *filer_pb.SubscribeMetadataResponse {
+	return &filer_pb.SubscribeMetadataResponse{
+		Directory: dir,
+		EventNotification: &filer_pb.EventNotification{
+			OldEntry:      oldEntry,
+			NewEntry:      newEntry,
+			NewParentPath: newParentPath,
+		},
+		TsNs: 2,
+	}
+}
+
+func newTestFile(name string, size uint64) *filer_pb.Entry {
+	return &filer_pb.Entry{Name: name, Attributes: &filer_pb.FuseAttributes{FileSize: size}}
+}
+
+func TestQuotaTracking(t *testing.T) {
+	fc := NewFilerConf()
+	fc.doLoadConf(&filer_pb.FilerConf{Locations: []*filer_pb.FilerConf_PathConf{
+		{LocationPrefix: "/buckets/b1/", QuotaBytes: 1000},
+		{LocationPrefix: "/data/", QuotaObjects: 2},
+		{LocationPrefix: "/buckets/", Replication: "001"},
+	}})
+	f := &Filer{DirBucketsPath: "/buckets", FilerConf: fc}
+	f.quota = newQuotaTracker(f)
+
+	// as if the scans have finished
+	qt := f.quota
+	qt.started = true
+	qt.usages["/buckets/b1/"] = &DirectoryUsage{isBucket: true, hasQuota: true, sinceNs: 1}
+	qt.usages["/data/"] = &DirectoryUsage{hasQuota: true, sinceNs: 1}
+	qt.quotaLocations = []string{"/buckets/b1/", "/data/"}
+
+	qt.onMetadataChangeEvent(newTestFileEvent("/buckets/b1/dir", nil, newTestFile("a", 600), ""))
+	qt.onMetadataChangeEvent(newTestFileEvent("/buckets/b1/dir", newTestFile("a", 600), newTestFile("a", 700), ""))
+	qt.onMetadataChangeEvent(newTestFileEvent("/buckets/b1", nil, newTestFile("b", 100), ""))
+	usage, found := f.DirectoryUsage("/buckets/b1/")
+	assert.True(t, found)
+	assert.Equal(t, int64(800), usage.Bytes)
+	assert.Equal(t, int64(2), usage.Objects)
+
+	assert.NoError(t, f.CheckQuota("/buckets/b1/c", 200, 1))
+	assert.True(t, errors.Is(f.CheckQuota("/buckets/b1/c", 201, 1), ErrQuotaExceeded))
+	assert.NoError(t, f.CheckQuota("/buckets/b1/c", -100, 0))
+	assert.NoError(t, f.CheckQuota("/other/c", 10000, 1))
+
+	// moving a file out of the bucket
+	qt.onMetadataChangeEvent(newTestFileEvent("/buckets/b1/dir", newTestFile("a", 700), newTestFile("a", 700), "/data"))
+	usage, _ = f.DirectoryUsage("/buckets/b1/")
+	assert.Equal(t, int64(100), usage.Bytes)
+	usage, _ = f.DirectoryUsage("/data/")
+	assert.Equal(t, int64(1), usage.Objects)
+	assert.NoError(t, f.CheckQuota("/data/x", 1, 1))
+	qt.onMetadataChangeEvent(newTestFileEvent("/data", nil, newTestFile("b", 1), ""))
+	assert.True(t, errors.Is(f.CheckQuota("/data/x", 1, 1), ErrQuotaExceeded))
+
+	// the events before the scan are already counted
+	qt.add("/data/c", 1, 1, 0)
+	usage, _ = f.DirectoryUsage("/data/")
+	assert.Equal(t, int64(2), usage.Objects)
+
+	// a new bucket starts from zero, and a deleted bucket keeps only the quota location
+	qt.onMetadataChangeEvent(newTestFileEvent("/buckets", nil, &filer_pb.Entry{Name: "b2", IsDirectory: true}, ""))
+	_, found = f.DirectoryUsage("/buckets/b2/")
+	assert.True(t, found)
+	qt.onMetadataChangeEvent(newTestFileEvent("/buckets", &filer_pb.Entry{Name: "b1", IsDirectory: true}, nil, ""))
+	usage, found = f.DirectoryUsage("/buckets/b1/")
+	assert.True(t, found)
+	assert.Equal(t, int64(0), usage.Bytes)
+	assert.False(t, usage.isBucket)
+}
+
+func TestIsScannedBefore(t *testing.T) {
+	assert.False(t, isScannedBefore("/data/a", ""))
+	assert.True(t, isScannedBefore("/data/a", "/data/a"))
+	assert.True(t, isScannedBefore("/data/a", "/data/b"))
+	assert.False(t, isScannedBefore("/data/c", "/data/b"))
+	// the sub directories are scanned in the order of the names, with all their files
+	assert.True(t, isScannedBefore("/data/a/z", "/data/b"))
+	assert.False(t, isScannedBefore("/data/b/a", "/data/b/0"))
+	assert.True(t, isScannedBefore("/data/b/z", "/data/b"))
+	assert.False(t, isScannedBefore("/data/b0", "/data/b/a"))
+	assert.True(t, isScannedBefore("/data", "/data/b/a"))
+}
+
+func TestQuotaScanning(t *testing.T) {
+	ctx := context.Background()
+	f := newTrashTestFiler(t)
+	f.DirBucketsPath = "/buckets"
+	for p, size := range map[util.FullPath]uint64{"/data/a/f1": 10, "/data/a/g/f2": 5, "/data/b": 20, "/other/c": 100} {
+		assert.NoError(t, f.CreateEntry(ctx, &Entry{FullPath: p, Attr: Attr{Mode: 0644, FileSize: size}}, false, false, nil))
+	}
+
+	qt := f.quota
+	qt.usages["/data/"] = &DirectoryUsage{hasQuota: true, isScanning: true}
+	qt.quotaLocations = []string{"/data/"}
+
+	// the scan has passed /data/a/g, and counts the files after it
+	qt.usages["/data/"].scannedPath = "/data/a/g"
+	qt.add("/data/a/g/f3", 1, 1, 1)
+	qt.add("/data/a/f0", 2, 1, 1)
+	qt.add("/data/a/h", 4, 1, 1)
+	qt.add("/data/c", 8, 1, 1)
+	usage, _ := f.DirectoryUsage("/data/")
+	assert.Equal(t, int64(3), usage.Bytes)
+	assert.Equal(t, int64(2), usage.Objects)
+
+	qt.usages["/data/"] = &DirectoryUsage{hasQuota: true, isScanning: true}
+	qt.scan([]string{"/data/"})
+	usage, _ = f.DirectoryUsage("/data/")
+	assert.Equal(t, int64(35), usage.Bytes)
+	assert.Equal(t, int64(3), usage.Objects)
+	assert.False(t, usage.isScanning)
+	assert.Equal(t, util.FullPath("/data/b"), usage.scannedPath)
+
+	qt.add("/data/c", 8, 1, 1)
+	usage, _ = f.DirectoryUsage("/data/")
+	assert.Equal(t, int64(43), usage.Bytes)
+}
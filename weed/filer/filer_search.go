@@ -41,6 +41,10 @@ func (f *Filer) StreamListDirectoryEntries(ctx context.Context, p util.FullPath,
 		p = p[0 : len(p)-1]
 	}
 
+	if snapshotLastFileName, isSnapshot, snapshotErr := f.listSnapshotEntries(ctx, p, startFileName, inclusive, limit, prefix, namePattern, namePatternExclude, eachEntryFunc); isSnapshot {
+		return snapshotLastFileName, snapshotErr
+	}
+
 	prefixInNamePattern, restNamePattern := splitPattern(namePattern)
 	if prefixInNamePattern != "" {
 		prefix = prefixInNamePattern
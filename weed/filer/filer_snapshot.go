@@ -0,0 +1,674 @@
+package filer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// A snapshot is a read-only, point-in-time copy of the metadata of a directory tree, sharing the chunks with the live tree.
+// Each snapshot is kept as one folder under DirectorySnapshots, and these folders are the snapshot catalog.
+// The snapshots of a directory are read by the virtual path <directory>/.snapshots/<name>.
+//
+// The chunks referenced by the snapshots are counted in the filer store. The deletion of a counted chunk
+// is postponed until the last snapshot referencing it is deleted.
+
+const (
+	DirectorySnapshots = "/.snapshots"
+	SnapshotsDirName   = ".snapshots"
+
+	SnapshotDirectory = "Seaweed-Snapshot-Directory"
+	SnapshotName      = "Seaweed-Snapshot-Name"
+	// set until all entries are copied into the snapshot
+	SnapshotCreating = "Seaweed-Snapshot-Creating"
+
+	snapshotChunkKeyPrefix = "snapshot.chunk."
+	snapshotLockName       = "snapshot"
+)
+
+var (
+	ErrSnapshotReadOnly    = errors.New("snapshots are read-only")
+	ErrInvalidSnapshotName = errors.New("invalid snapshot name")
+	ErrSnapshotsExist      = errors.New("the collection is used by snapshots")
+
+	snapshotNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]{0,127}$`)
+)
+
+type Snapshot struct {
+	Directory  util.FullPath
+	Name       string
+	CreatedAt  time.Time
+	IsCreating bool
+}
+
+type snapshotCatalog struct {
+	sync.RWMutex
+	// the created snapshots, keyed by the directory and the snapshot name
+	snapshots map[util.FullPath]map[string]*Snapshot
+}
+
+// ParseSnapshot returns the snapshot of a folder under DirectorySnapshots, or nil
+func ParseSnapshot(extended map[string][]byte, createdAt time.Time) *Snapshot {
+	if extended == nil || len(extended[SnapshotDirectory]) == 0 || len(extended[SnapshotName]) == 0 {
+		return nil
+	}
+	_, isCreating := extended[SnapshotCreating]
+	return &Snapshot{
+		Directory:  util.FullPath(extended[SnapshotDirectory]),
+		Name:       string(extended[SnapshotName]),
+		CreatedAt:  createdAt,
+		IsCreating: isCreating,
+	}
+}
+
+func snapshotStoragePath(dir util.FullPath, name string) util.FullPath {
+	return util.NewFullPath(DirectorySnapshots, url.PathEscape(string(dir))+"@"+name)
+}
+
+func trimDirectorySlash(dir util.FullPath) util.FullPath {
+	if len(dir) > 1 {
+		return util.FullPath(strings.TrimSuffix(string(dir), "/"))
+	}
+	return dir
+}
+
+func isInSnapshotStorage(p util.FullPath) bool {
+	return p == DirectorySnapshots || strings.HasPrefix(string(p), DirectorySnapshots+"/")
+}
+
+func (f *Filer) LoadSnapshots() {
+
+	f.snapshots.Lock()
+	defer f.snapshots.Unlock()
+
+	f.snapshots.snapshots = make(map[util.FullPath]map[string]*Snapshot)
+	_, err := f.Store.ListDirectoryEntries(context.Background(), DirectorySnapshots, "", false, int64(1<<31-1), func(entry *Entry) bool {
+		if snapshot := ParseSnapshot(entry.Extended, entry.Crtime); snapshot != nil && !snapshot.IsCreating {
+			f.snapshots.add(snapshot)
+		}
+		return true
+	})
+	if err != nil {
+		glog.Errorf("load snapshots: %v", err)
+	}
+
+}
+
+func (c *snapshotCatalog) add(snapshot *Snapshot) {
+	if c.snapshots[snapshot.Directory] == nil {
+		c.snapshots[snapshot.Directory] = make(map[string]*Snapshot)
+	}
+	c.snapshots[snapshot.Directory][snapshot.Name] = snapshot
+}
+
+func (c *snapshotCatalog) remove(dir util.FullPath, name string) {
+	delete(c.snapshots[dir], name)
+	if len(c.snapshots[dir]) == 0 {
+		delete(c.snapshots, dir)
+	}
+}
+
+func (f *Filer) onSnapshotEvents(event *filer_pb.SubscribeMetadataResponse) {
+	if event.Directory != DirectorySnapshots {
+		return
+	}
+	message := event.EventNotification
+
+	f.snapshots.Lock()
+	defer f.snapshots.Unlock()
+
+	if message.OldEntry != nil {
+		if snapshot := ParseSnapshot(message.OldEntry.Extended, time.Time{}); snapshot != nil {
+			f.snapshots.remove(snapshot.Directory, snapshot.Name)
+		}
+	}
+	if message.NewEntry != nil {
+		if snapshot := ParseSnapshot(message.NewEntry.Extended, time.Unix(message.NewEntry.Attributes.GetCrtime(), 0)); snapshot != nil && !snapshot.IsCreating {
+			f.snapshots.add(snapshot)
+		}
+	}
+}
+
+// ListSnapshots returns the snapshots of the directory, or of all directories if the directory is empty
+func (f *Filer) ListSnapshots(dir util.FullPath) (snapshots []*Snapshot) {
+	f.snapshots.RLock()
+	defer f.snapshots.RUnlock()
+
+	for d, named := range f.snapshots.snapshots {
+		if dir != "" && d != dir {
+			continue
+		}
+		for _, snapshot := range named {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		if snapshots[i].Directory != snapshots[j].Directory {
+			return snapshots[i].Directory < snapshots[j].Directory
+		}
+		return snapshots[i].Name < snapshots[j].Name
+	})
+	return
+}
+
+// resolveSnapshotPath splits a path under <directory>/.snapshots of a directory with snapshots.
+// The name is empty for the path <directory>/.snapshots itself.
+func (f *Filer) resolveSnapshotPath(p util.FullPath) (dir util.FullPath, name string, rest []string, isSnapshot bool) {
+
+	if !strings.Contains(string(p), "/"+SnapshotsDirName) {
+		return
+	}
+
+	f.snapshots.RLock()
+	defer f.snapshots.RUnlock()
+
+	parts := p.Split()
+	for i, part := range parts {
+		if part != SnapshotsDirName {
+			continue
+		}
+		dir = util.FullPath("/" + strings.Join(parts[:i], "/"))
+		if _, found := f.snapshots.snapshots[dir]; !found {
+			continue
+		}
+		if i+1 < len(parts) {
+			name, rest = parts[i+1], parts[i+2:]
+		}
+		return dir, name, rest, true
+	}
+	return "", "", nil, false
+}
+
+// IsSnapshotPath tells whether the path is read-only, inside the snapshots or their storage
+func (f *Filer) IsSnapshotPath(p util.FullPath) bool {
+	if isInSnapshotStorage(p) {
+		return true
+	}
+	_, _, _, isSnapshot := f.resolveSnapshotPath(p)
+	return isSnapshot
+}
+
+func (f *Filer) checkSnapshotWrite(p util.FullPath) error {
+	if f.IsSnapshotPath(p) {
+		return fmt.Errorf("%w: %s", ErrSnapshotReadOnly, p)
+	}
+	return nil
+}
+
+// findSnapshotEntry finds the entries by the virtual snapshot paths
+func (f *Filer) findSnapshotEntry(ctx context.Context, p util.FullPath) (entry *Entry, isSnapshot bool, err error) {
+
+	dir, name, rest, isSnapshot := f.resolveSnapshotPath(p)
+	if !isSnapshot {
+		return nil, false, nil
+	}
+
+	if name == "" {
+		now := time.Now()
+		return &Entry{
+			FullPath: p,
+			Attr: Attr{
+				Mtime:  now,
+				Crtime: now,
+				Mode:   os.ModeDir | 0555,
+				Uid:    OS_UID,
+				Gid:    OS_GID,
+			},
+		}, true, nil
+	}
+
+	entry, err = f.Store.FindEntry(ctx, util.JoinPath(append([]string{string(snapshotStoragePath(dir, name))}, rest...)...))
+	if err != nil {
+		return nil, true, err
+	}
+	entry.FullPath = p
+	return entry, true, nil
+}
+
+// listSnapshotEntries lists the entries by the virtual snapshot paths
+func (f *Filer) listSnapshotEntries(ctx context.Context, p util.FullPath, startFileName string, inclusive bool, limit int64, prefix string, namePattern string, namePatternExclude string, eachEntryFunc ListEachEntryFunc) (lastFileName string, isSnapshot bool, err error) {
+
+	dir, name, rest, isSnapshot := f.resolveSnapshotPath(p)
+	if !isSnapshot {
+		return "", false, nil
+	}
+
+	if name != "" {
+		storagePath := util.JoinPath(append([]string{string(snapshotStoragePath(dir, name))}, rest...)...)
+		lastFileName, err = f.StreamListDirectoryEntries(ctx, storagePath, startFileName, inclusive, limit, prefix, namePattern, namePatternExclude, func(entry *Entry) bool {
+			entry.FullPath = p.Child(entry.Name())
+			return eachEntryFunc(entry)
+		})
+		return lastFileName, true, err
+	}
+
+	// the snapshot names of the directory
+	for _, snapshot := range f.ListSnapshots(dir) {
+		if limit <= 0 {
+			break
+		}
+		if snapshot.Name < startFileName || (snapshot.Name == startFileName && !inclusive) || !strings.HasPrefix(snapshot.Name, prefix) {
+			continue
+		}
+		if namePattern != "" {
+			if matched, _ := filepath.Match(namePattern, snapshot.Name); !matched {
+				continue
+			}
+		}
+		if namePatternExclude != "" {
+			if matched, _ := filepath.Match(namePatternExclude, snapshot.Name); matched {
+				continue
+			}
+		}
+		lastFileName = snapshot.Name
+		limit--
+		if !eachEntryFunc(&Entry{
+			FullPath: p.Child(snapshot.Name),
+			Attr: Attr{
+				Mtime:  snapshot.CreatedAt,
+				Crtime: snapshot.CreatedAt,
+				Mode:   os.ModeDir | 0555,
+				Uid:    OS_UID,
+				Gid:    OS_GID,
+			},
+		}) {
+			break
+		}
+	}
+	return lastFileName, true, nil
+}
+
+// HasSnapshotsUsingCollection tells whether the collection of the bucket is referenced by any snapshot
+func (f *Filer) HasSnapshotsUsingCollection(bucketPath util.FullPath) bool {
+	f.snapshots.RLock()
+	defer f.snapshots.RUnlock()
+
+	for dir := range f.snapshots.snapshots {
+		if dir == bucketPath || strings.HasPrefix(string(dir), string(bucketPath)+"/") || strings.HasPrefix(string(bucketPath), string(dir)+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateSnapshot copies the metadata of the directory tree into a new snapshot
+func (f *Filer) CreateSnapshot(ctx context.Context, dir util.FullPath, name string) (*Snapshot, error) {
+
+	dir = trimDirectorySlash(dir)
+
+	if !snapshotNameRegexp.MatchString(name) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidSnapshotName, name)
+	}
+	if dir == "/" || f.IsSnapshotPath(dir) {
+		return nil, fmt.Errorf("can not create snapshots of %s", dir)
+	}
+	dirEntry, err := f.FindEntry(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	if !dirEntry.IsDirectory() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+	if _, err = f.Store.FindEntry(ctx, dir.Child(SnapshotsDirName)); err == nil {
+		return nil, fmt.Errorf("%w: entry %s already exists", ErrEntryExists, dir.Child(SnapshotsDirName))
+	}
+
+	storagePath := snapshotStoragePath(dir, name)
+	if _, err = f.Store.FindEntry(ctx, storagePath); err == nil {
+		return nil, fmt.Errorf("%w: snapshot %s of %s already exists", ErrEntryExists, name, dir)
+	} else if err != filer_pb.ErrNotFound {
+		return nil, err
+	}
+
+	now := time.Now()
+	root := &Entry{
+		FullPath: storagePath,
+		Attr:     dirEntry.Attr,
+		Extended: map[string][]byte{
+			SnapshotDirectory: []byte(dir),
+			SnapshotName:      []byte(name),
+			SnapshotCreating:  []byte(strconv.FormatInt(now.Unix(), 10)),
+		},
+	}
+	root.Mtime, root.Crtime = now, now
+	if err = f.ensureParentDirecotryEntry(ctx, root, storagePath.Split(), 1, false); err != nil {
+		return nil, err
+	}
+	if err = f.Store.InsertEntry(ctx, root); err != nil {
+		return nil, fmt.Errorf("insert snapshot %s: %v", storagePath, err)
+	}
+
+	glog.V(0).Infof("create snapshot %s of %s", name, dir)
+	if err = f.copySnapshotTree(ctx, dir, storagePath); err != nil {
+		if deleteErr := f.deleteSnapshotStorage(ctx, root); deleteErr != nil {
+			glog.Errorf("delete incomplete snapshot %s: %v", storagePath, deleteErr)
+		}
+		return nil, fmt.Errorf("copy %s to snapshot %s: %v", dir, name, err)
+	}
+
+	delete(root.Extended, SnapshotCreating)
+	if err = f.Store.UpdateEntry(ctx, root); err != nil {
+		return nil, fmt.Errorf("update snapshot %s: %v", storagePath, err)
+	}
+	f.NotifyUpdateEvent(ctx, nil, root, false, false, nil)
+
+	snapshot := ParseSnapshot(root.Extended, now)
+	f.snapshots.Lock()
+	f.snapshots.add(snapshot)
+	f.snapshots.Unlock()
+
+	return snapshot, nil
+}
+
+func (f *Filer) copySnapshotTree(ctx context.Context, dir, snapshotDir util.FullPath) error {
+
+	lastFileName := ""
+	for {
+		entries, hasMore, err := f.ListDirectoryEntries(ctx, dir, lastFileName, false, PaginationSize, "", "", "")
+		if err != nil {
+			return fmt.Errorf("list folder %s: %v", dir, err)
+		}
+		for _, entry := range entries {
+			lastFileName = entry.Name()
+
+			snapshotEntry, err := f.copySnapshotEntry(ctx, entry, snapshotDir.Child(entry.Name()))
+			if err != nil {
+				return err
+			}
+			if snapshotEntry != nil && snapshotEntry.IsDirectory() {
+				if err = f.copySnapshotTree(ctx, entry.FullPath, snapshotEntry.FullPath); err != nil {
+					return err
+				}
+			}
+		}
+		if !hasMore || len(entries) == 0 {
+			return nil
+		}
+	}
+
+}
+
+// copySnapshotEntry copies the entry into the snapshot, and returns nil if the entry is already deleted.
+// The entry can be updated or deleted after being listed, with its chunks deleted before being retained here.
+// So the entry is read again after its chunks are retained, and copied only if the chunks are not changed.
+func (f *Filer) copySnapshotEntry(ctx context.Context, entry *Entry, p util.FullPath) (*Entry, error) {
+
+	for {
+		if err := f.retainSnapshotChunks(ctx, entry.Chunks); err != nil {
+			return nil, err
+		}
+		current, err := f.FindEntry(ctx, entry.FullPath)
+		if err == nil && isSameChunks(entry.Chunks, current.Chunks) {
+			entry = current
+			break
+		}
+		f.doDeleteFileIds(f.releaseSnapshotChunks(ctx, entry.Chunks))
+		if err == filer_pb.ErrNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("find %s: %v", entry.FullPath, err)
+		}
+		entry = current
+	}
+
+	// the hard links are copied as the content read through them
+	snapshotEntry := &Entry{
+		FullPath: p,
+		Attr:     entry.Attr,
+		Chunks:   entry.Chunks,
+		Extended: entry.Extended,
+		Content:  entry.Content,
+	}
+	if err := f.Store.InsertEntry(ctx, snapshotEntry); err != nil {
+		f.doDeleteFileIds(f.releaseSnapshotChunks(ctx, entry.Chunks))
+		return nil, fmt.Errorf("insert %s: %v", snapshotEntry.FullPath, err)
+	}
+	return snapshotEntry, nil
+}
+
+func isSameChunks(a, b []*filer_pb.FileChunk) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].GetFileIdString() != b[i].GetFileIdString() {
+			return false
+		}
+	}
+	return true
+}
+
+// DeleteSnapshot deletes the snapshot, and the chunks only referenced by the snapshot
+func (f *Filer) DeleteSnapshot(ctx context.Context, dir util.FullPath, name string) error {
+
+	dir = trimDirectorySlash(dir)
+
+	storagePath := snapshotStoragePath(dir, name)
+	root, err := f.Store.FindEntry(ctx, storagePath)
+	if err != nil {
+		return err
+	}
+
+	f.snapshots.Lock()
+	f.snapshots.remove(dir, name)
+	f.snapshots.Unlock()
+
+	glog.V(0).Infof("delete snapshot %s of %s", name, dir)
+	return f.deleteSnapshotStorage(ctx, root)
+}
+
+func (f *Filer) deleteSnapshotStorage(ctx context.Context, root *Entry) error {
+
+	var toDelete []string
+	err := f.visitSnapshotTree(ctx, root.FullPath, func(entry *Entry) {
+		toDelete = append(toDelete, f.releaseSnapshotChunks(ctx, entry.Chunks)...)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = f.Store.DeleteFolderChildren(ctx, root.FullPath); err != nil {
+		return fmt.Errorf("delete snapshot %s: %v", root.FullPath, err)
+	}
+	if err = f.Store.DeleteOneEntry(ctx, root); err != nil {
+		return fmt.Errorf("delete snapshot %s: %v", root.FullPath, err)
+	}
+	if _, isCreating := root.Extended[SnapshotCreating]; !isCreating {
+		f.NotifyUpdateEvent(ctx, root, nil, false, false, nil)
+	}
+
+	f.doDeleteFileIds(toDelete)
+	return nil
+}
+
+func (f *Filer) visitSnapshotTree(ctx context.Context, dir util.FullPath, fn func(entry *Entry)) error {
+	lastFileName := ""
+	for {
+		var entries []*Entry
+		_, err := f.Store.ListDirectoryEntries(ctx, dir, lastFileName, false, PaginationSize, func(entry *Entry) bool {
+			entries = append(entries, entry)
+			return true
+		})
+		if err != nil {
+			return fmt.Errorf("list folder %s: %v", dir, err)
+		}
+		for _, entry := range entries {
+			lastFileName = entry.Name()
+			fn(entry)
+			if entry.IsDirectory() {
+				if err = f.visitSnapshotTree(ctx, entry.FullPath, fn); err != nil {
+					return err
+				}
+			}
+		}
+		if len(entries) < PaginationSize {
+			return nil
+		}
+	}
+}
+
+// the reference count of a chunk, and whether the chunk is deleted from the live tree
+func (f *Filer) readSnapshotChunkRef(ctx context.Context, fileId string) (count int64, isDeleted bool, err error) {
+	value, err := f.Store.KvGet(ctx, []byte(snapshotChunkKeyPrefix+fileId))
+	if err == ErrKvNotFound {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	parts := strings.SplitN(string(value), " ", 2)
+	count, err = strconv.ParseInt(parts[0], 10, 64)
+	return count, len(parts) == 2, err
+}
+
+func (f *Filer) writeSnapshotChunkRef(ctx context.Context, fileId string, count int64, isDeleted bool) error {
+	key := []byte(snapshotChunkKeyPrefix + fileId)
+	if count <= 0 {
+		return f.Store.KvDelete(ctx, key)
+	}
+	value := strconv.FormatInt(count, 10)
+	if isDeleted {
+		value += " deleted"
+	}
+	return f.Store.KvPut(ctx, key, []byte(value))
+}
+
+func (f *Filer) snapshotFileIds(chunks []*filer_pb.FileChunk) ([]string, error) {
+	dataChunks, manifestChunks, err := ResolveChunkManifest(f.MasterClient.LookupFileId, chunks)
+	if err != nil {
+		return nil, err
+	}
+	var fileIds []string
+	for _, chunk := range dataChunks {
+		fileIds = append(fileIds, chunk.GetFileIdString())
+	}
+	for _, chunk := range manifestChunks {
+		fileIds = append(fileIds, chunk.GetFileIdString())
+	}
+	return fileIds, nil
+}
+
+func (f *Filer) retainSnapshotChunks(ctx context.Context, chunks []*filer_pb.FileChunk) error {
+	if len(chunks) == 0 {
+		return nil
+	}
+	fileIds, err := f.snapshotFileIds(chunks)
+	if err != nil {
+		return err
+	}
+
+	unlock, err := f.lockStore(&f.snapshotChunkLock, snapshotLockName)
+	if err != nil {
+		return err
+	}
+	for i, fileId := range fileIds {
+		count, isDeleted, err := f.readSnapshotChunkRef(ctx, fileId)
+		if err == nil {
+			err = f.writeSnapshotChunkRef(ctx, fileId, count+1, isDeleted)
+		}
+		if err != nil {
+			toDelete := f.doReleaseSnapshotFileIds(ctx, fileIds[:i])
+			unlock()
+			f.doDeleteFileIds(toDelete)
+			return fmt.Errorf("retain chunk %s: %v", fileId, err)
+		}
+	}
+	unlock()
+	return nil
+}
+
+// releaseSnapshotChunks returns the file ids no longer referenced by any snapshot or the live tree
+func (f *Filer) releaseSnapshotChunks(ctx context.Context, chunks []*filer_pb.FileChunk) (toDelete []string) {
+	if len(chunks) == 0 {
+		return nil
+	}
+	fileIds, err := f.snapshotFileIds(chunks)
+	if err != nil {
+		glog.Errorf("release snapshot chunks: %v", err)
+		return nil
+	}
+
+	unlock, err := f.lockStore(&f.snapshotChunkLock, snapshotLockName)
+	if err != nil {
+		// keep the chunks, which is safer than losing the data of the snapshots
+		glog.Errorf("release snapshot chunks: %v", err)
+		return nil
+	}
+	defer unlock()
+
+	return f.doReleaseSnapshotFileIds(ctx, fileIds)
+}
+
+func (f *Filer) doReleaseSnapshotFileIds(ctx context.Context, fileIds []string) (toDelete []string) {
+	for _, fileId := range fileIds {
+		count, isDeleted, err := f.readSnapshotChunkRef(ctx, fileId)
+		if err == nil {
+			err = f.writeSnapshotChunkRef(ctx, fileId, count-1, isDeleted)
+		}
+		if err != nil {
+			glog.Errorf("release snapshot chunk %s: %v", fileId, err)
+			continue
+		}
+		if count <= 1 && isDeleted {
+			toDelete = append(toDelete, fileId)
+		}
+	}
+	return
+}
+
+// skipSnapshotChunks returns the file ids to delete now, and marks the ones referenced by snapshots
+// to be deleted with the last snapshot referencing them.
+func (f *Filer) skipSnapshotChunks(fileIds []string) []string {
+
+	ctx := context.Background()
+	hasSnapshots := false
+	if _, err := f.Store.ListDirectoryEntries(ctx, DirectorySnapshots, "", false, 1, func(entry *Entry) bool {
+		hasSnapshots = true
+		return false
+	}); err != nil {
+		glog.Errorf("list snapshots: %v", err)
+		return nil
+	}
+	if !hasSnapshots {
+		return fileIds
+	}
+
+	unlock, err := f.lockStore(&f.snapshotChunkLock, snapshotLockName)
+	if err != nil {
+		glog.Errorf("skip snapshot chunks: %v", err)
+		return nil
+	}
+	defer unlock()
+
+	var toDelete []string
+	for _, fileId := range fileIds {
+		count, _, err := f.readSnapshotChunkRef(ctx, fileId)
+		if err == nil && count > 0 {
+			err = f.writeSnapshotChunkRef(ctx, fileId, count, true)
+			if err == nil {
+				continue
+			}
+		}
+		if err != nil {
+			// keep the chunk, which is safer than losing the data of the snapshots
+			glog.Errorf("check snapshot chunk %s: %v", fileId, err)
+			continue
+		}
+		toDelete = append(toDelete, fileId)
+	}
+	return toDelete
+}
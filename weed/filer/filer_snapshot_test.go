@@ -0,0 +1,163 @@
+package filer
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/chrislusf/seaweedfs/weed/wdclient"
+	"github.com/stretchr/testify/assert"
+)
+
+// the entries are kept by the full path, and only listed to find the snapshots
+type testSnapshotStore struct {
+	*testKvStore
+	entries map[util.FullPath]*Entry
+}
+
+func (store *testSnapshotStore) InsertEntry(ctx context.Context, entry *Entry) error {
+	store.entries[entry.FullPath] = entry
+	return nil
+}
+
+func (store *testSnapshotStore) FindEntry(ctx context.Context, p util.FullPath) (*Entry, error) {
+	entry, found := store.entries[p]
+	if !found {
+		return nil, filer_pb.ErrNotFound
+	}
+	return entry, nil
+}
+
+func (store *testSnapshotStore) ListDirectoryEntries(ctx context.Context, dirPath util.FullPath, startFileName string, includeStartFile bool, limit int64, eachEntryFunc ListEachEntryFunc) (string, error) {
+	for p, entry := range store.entries {
+		if dir, _ := p.DirAndName(); util.FullPath(dir) == dirPath && !eachEntryFunc(entry) {
+			break
+		}
+	}
+	return "", nil
+}
+
+func newSnapshotTestFiler() (*Filer, *testSnapshotStore) {
+	store := &testSnapshotStore{
+		testKvStore: &testKvStore{kv: make(map[string][]byte)},
+		entries:     make(map[util.FullPath]*Entry),
+	}
+	return &Filer{Store: store, MasterClient: &wdclient.MasterClient{}}, store
+}
+
+func TestResolveSnapshotPath(t *testing.T) {
+
+	f := &Filer{snapshots: &snapshotCatalog{snapshots: make(map[util.FullPath]map[string]*Snapshot)}}
+	f.snapshots.add(&Snapshot{Directory: "/buckets/warehouse", Name: "s1"})
+
+	dir, name, rest, isSnapshot := f.resolveSnapshotPath("/buckets/warehouse/.snapshots/s1/a/big.bin")
+	assert.True(t, isSnapshot)
+	assert.Equal(t, util.FullPath("/buckets/warehouse"), dir)
+	assert.Equal(t, "s1", name)
+	assert.Equal(t, []string{"a", "big.bin"}, rest)
+
+	_, name, _, isSnapshot = f.resolveSnapshotPath("/buckets/warehouse/.snapshots")
+	assert.True(t, isSnapshot)
+	assert.Equal(t, "", name)
+
+	assert.False(t, f.IsSnapshotPath("/buckets/other/.snapshots/s1"))
+	assert.False(t, f.IsSnapshotPath("/buckets/warehouse/a/big.bin"))
+	assert.True(t, f.IsSnapshotPath("/.snapshots/%2Fbuckets%2Fwarehouse@s1"))
+
+	assert.True(t, f.HasSnapshotsUsingCollection("/buckets/warehouse"))
+	assert.False(t, f.HasSnapshotsUsingCollection("/buckets/ware"))
+
+	f.snapshots.remove("/buckets/warehouse", "s1")
+	assert.False(t, f.IsSnapshotPath("/buckets/warehouse/.snapshots/s1"))
+
+}
+
+func TestParseSnapshot(t *testing.T) {
+
+	createdAt := time.Unix(1634567890, 0)
+	extended := map[string][]byte{
+		SnapshotDirectory: []byte("/buckets/warehouse"),
+		SnapshotName:      []byte("s1"),
+	}
+	snapshot := ParseSnapshot(extended, createdAt)
+	assert.Equal(t, &Snapshot{Directory: "/buckets/warehouse", Name: "s1", CreatedAt: createdAt}, snapshot)
+	assert.Equal(t, util.FullPath("/.snapshots/%2Fbuckets%2Fwarehouse@s1"), snapshotStoragePath(snapshot.Directory, snapshot.Name))
+
+	extended[SnapshotCreating] = []byte("true")
+	assert.True(t, ParseSnapshot(extended, createdAt).IsCreating)
+
+	assert.Nil(t, ParseSnapshot(map[string][]byte{SnapshotName: []byte("s1")}, createdAt))
+
+	assert.True(t, snapshotNameRegexp.MatchString("20211018-150405"))
+	assert.False(t, snapshotNameRegexp.MatchString(".hidden"))
+	assert.False(t, snapshotNameRegexp.MatchString("a/b"))
+
+}
+
+func TestSnapshotChunkReferences(t *testing.T) {
+
+	ctx := context.Background()
+	f, store := newSnapshotTestFiler()
+	chunks := []*filer_pb.FileChunk{{FileId: "3,01637037d6"}, {FileId: "3,02637037d7"}}
+	assertRef := func(fileId string, count int64, isDeleted bool) {
+		actualCount, actualIsDeleted, err := f.readSnapshotChunkRef(ctx, fileId)
+		assert.NoError(t, err)
+		assert.Equal(t, count, actualCount, "reference count of %s", fileId)
+		assert.Equal(t, isDeleted, actualIsDeleted, "deletion of %s", fileId)
+	}
+
+	// no snapshots at all
+	assert.Equal(t, []string{"3,01637037d6"}, f.skipSnapshotChunks([]string{"3,01637037d6"}))
+
+	store.InsertEntry(ctx, &Entry{FullPath: snapshotStoragePath("/buckets/warehouse", "s1"), Attr: Attr{Mode: os.ModeDir}})
+	assert.NoError(t, f.retainSnapshotChunks(ctx, chunks))
+	assert.NoError(t, f.retainSnapshotChunks(ctx, chunks[:1]))
+	assertRef("3,01637037d6", 2, false)
+	assertRef("3,02637037d7", 1, false)
+
+	// the chunks deleted from the live tree are kept for the snapshots
+	assert.Equal(t, []string{"3,03637037d8"}, f.skipSnapshotChunks([]string{"3,01637037d6", "3,03637037d8"}))
+	assertRef("3,01637037d6", 2, true)
+
+	// and deleted with the last snapshot referencing them
+	assert.Empty(t, f.releaseSnapshotChunks(ctx, chunks))
+	assertRef("3,01637037d6", 1, true)
+	assertRef("3,02637037d7", 0, false)
+	assert.Equal(t, []string{"3,01637037d6"}, f.releaseSnapshotChunks(ctx, chunks[:1]))
+	assertRef("3,01637037d6", 0, false)
+	assert.Empty(t, store.kv)
+
+}
+
+func TestCopySnapshotEntry(t *testing.T) {
+
+	ctx := context.Background()
+	f, store := newSnapshotTestFiler()
+	listed := &Entry{FullPath: "/buckets/warehouse/a.bin", Chunks: []*filer_pb.FileChunk{{FileId: "3,01637037d6"}}}
+	overwritten := &Entry{FullPath: "/buckets/warehouse/a.bin", Chunks: []*filer_pb.FileChunk{{FileId: "3,02637037d7"}}}
+	snapshotDir := snapshotStoragePath("/buckets/warehouse", "s1")
+
+	// overwritten after being listed, so the new content is copied
+	store.InsertEntry(ctx, overwritten)
+	snapshotEntry, err := f.copySnapshotEntry(ctx, listed, snapshotDir.Child("a.bin"))
+	assert.NoError(t, err)
+	if assert.NotNil(t, snapshotEntry) {
+		assert.Equal(t, overwritten.Chunks, snapshotEntry.Chunks)
+	}
+	assert.Equal(t, snapshotEntry, store.entries[snapshotDir.Child("a.bin")])
+	count, _, _ := f.readSnapshotChunkRef(ctx, "3,01637037d6")
+	assert.Equal(t, int64(0), count)
+	count, _, _ = f.readSnapshotChunkRef(ctx, "3,02637037d7")
+	assert.Equal(t, int64(1), count)
+
+	// deleted after being listed
+	snapshotEntry, err = f.copySnapshotEntry(ctx, &Entry{FullPath: "/buckets/warehouse/b.bin", Chunks: listed.Chunks}, snapshotDir.Child("b.bin"))
+	assert.NoError(t, err)
+	assert.Nil(t, snapshotEntry)
+	count, _, _ = f.readSnapshotChunkRef(ctx, "3,01637037d6")
+	assert.Equal(t, int64(0), count)
+
+}
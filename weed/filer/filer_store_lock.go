@@ -0,0 +1,186 @@
+package filer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// The counters kept in the filer store are read, changed and written back. Within one filer the changes are
+// serialized by a mutex. With peer filers, which can share the filer store, the changes are also serialized by
+// a lock leased from the master, the same way as the exclusive lock of "weed shell". The lock is named after
+// the filer store signature, so the filers with separate stores do not wait for each other.
+// The exclusive creates are serialized the same way, by a lock for each path.
+
+const (
+	storeLockRenewInterval  = 4 * time.Second
+	storeLockRetryInterval  = 50 * time.Millisecond
+	storeLockTimeout        = time.Minute
+	exclusiveCreateLockName = "create"
+)
+
+// lockStore waits for the lock of the name, and returns the function to release it
+func (f *Filer) lockStore(local *sync.Mutex, name string) (unlock func(), err error) {
+
+	local.Lock()
+	if !f.hasPeerFilers() {
+		return local.Unlock, nil
+	}
+
+	release, err := f.leaseStoreLock(name, storeLockTimeout)
+	if err != nil {
+		local.Unlock()
+		return nil, err
+	}
+	return func() {
+		release()
+		local.Unlock()
+	}, nil
+}
+
+// pathLocks are the local locks of the paths, kept only while held or waited for
+type pathLocks struct {
+	sync.Mutex
+	locks map[string]*pathLock
+}
+
+type pathLock struct {
+	sync.Mutex
+	users int
+}
+
+func (locks *pathLocks) acquire(key string) *pathLock {
+	locks.Lock()
+	defer locks.Unlock()
+	if locks.locks == nil {
+		locks.locks = make(map[string]*pathLock)
+	}
+	l, found := locks.locks[key]
+	if !found {
+		l = &pathLock{}
+		locks.locks[key] = l
+	}
+	l.users++
+	return l
+}
+
+func (locks *pathLocks) release(key string) {
+	locks.Lock()
+	defer locks.Unlock()
+	if l := locks.locks[key]; l != nil {
+		if l.users--; l.users <= 0 {
+			delete(locks.locks, key)
+		}
+	}
+}
+
+// lockStorePath waits for the lock of the name for one path, shared by the peer filers, and returns the function to release it
+func (f *Filer) lockStorePath(name string, p util.FullPath) (unlock func(), err error) {
+
+	key := name + "." + string(p)
+	l := f.pathLocks.acquire(key)
+	unlockStore, err := f.lockStore(&l.Mutex, key)
+	if err != nil {
+		f.pathLocks.release(key)
+		return nil, err
+	}
+	return func() {
+		unlockStore()
+		f.pathLocks.release(key)
+	}, nil
+}
+
+// TryLockStore takes the lock of the name for a long running task only if neither this filer nor a peer filer
+// sharing the filer store holds it, and returns the function to release it
+func (f *Filer) TryLockStore(running *int32, name string) (unlock func(), isLocked bool) {
+
+	if !atomic.CompareAndSwapInt32(running, 0, 1) {
+		return nil, false
+	}
+	if !f.hasPeerFilers() {
+		return func() { atomic.StoreInt32(running, 0) }, true
+	}
+
+	release, err := f.leaseStoreLock(name, 0)
+	if err != nil {
+		glog.V(1).Infof("try lock %s: %v", name, err)
+		atomic.StoreInt32(running, 0)
+		return nil, false
+	}
+	return func() {
+		release()
+		atomic.StoreInt32(running, 0)
+	}, true
+}
+
+func (f *Filer) hasPeerFilers() bool {
+	return f.MetaAggregator != nil && len(f.MetaAggregator.filers) > 1
+}
+
+// leaseStoreLock leases the lock from the master, retrying until the timeout, and keeps renewing it until released
+func (f *Filer) leaseStoreLock(name string, timeout time.Duration) (release func(), err error) {
+
+	lockName := fmt.Sprintf("filer.%d.%s", f.Signature, name)
+	var token, lockTsNs int64
+	lease := func(ctx context.Context) error {
+		return f.MasterClient.WithClient(func(client master_pb.SeaweedClient) error {
+			resp, err := client.LeaseAdminToken(ctx, &master_pb.LeaseAdminTokenRequest{
+				PreviousToken:    token,
+				PreviousLockTime: lockTsNs,
+				LockName:         lockName,
+				ClientName:       fmt.Sprintf("filer %d", f.Signature),
+			})
+			if err == nil {
+				token, lockTsNs = resp.Token, resp.LockTsNs
+			}
+			return err
+		})
+	}
+
+	ctx := context.Background()
+	deadline := time.Now().Add(timeout)
+	for err = lease(ctx); err != nil; err = lease(ctx) {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("lock %s: %v", lockName, err)
+		}
+		time.Sleep(storeLockRetryInterval)
+	}
+
+	// renew the lease until released
+	done := make(chan struct{})
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(storeLockRenewInterval):
+				if err := lease(ctx); err != nil {
+					glog.Errorf("renew lock %s: %v", lockName, err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-renewed
+		if err := f.MasterClient.WithClient(func(client master_pb.SeaweedClient) error {
+			_, err := client.ReleaseAdminToken(ctx, &master_pb.ReleaseAdminTokenRequest{
+				PreviousToken:    token,
+				PreviousLockTime: lockTsNs,
+				LockName:         lockName,
+			})
+			return err
+		}); err != nil {
+			glog.V(0).Infof("release lock %s: %v", lockName, err)
+		}
+	}, nil
+}
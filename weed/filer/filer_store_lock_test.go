@@ -0,0 +1,43 @@
+package filer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockStorePath(t *testing.T) {
+
+	f := &Filer{}
+
+	unlock, err := f.lockStorePath(exclusiveCreateLockName, "/a/b")
+	assert.NoError(t, err)
+
+	// the other paths are not blocked
+	unlockOther, err := f.lockStorePath(exclusiveCreateLockName, "/a/c")
+	assert.NoError(t, err)
+	unlockOther()
+
+	locked := make(chan struct{})
+	go func() {
+		unlockAgain, err := f.lockStorePath(exclusiveCreateLockName, "/a/b")
+		assert.NoError(t, err)
+		close(locked)
+		unlockAgain()
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("the same path is locked twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	<-locked
+
+	// the locks are only kept while used
+	f.pathLocks.Lock()
+	defer f.pathLocks.Unlock()
+	assert.Empty(t, f.pathLocks.locks)
+
+}
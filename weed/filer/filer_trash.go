@@ -0,0 +1,214 @@
+package filer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// The entries deleted under the locations with a trash retention are moved into the trash directory,
+// each under a unique name, and marked with the original path, the deletion time and the expiration time.
+// The data is only deleted when the trashed entry expires, or is deleted from the trash directory.
+// The buckets are still deleted with their collections, including the trashed objects of the buckets,
+// which are removed from the trash directory with the bucket.
+// The trashed entries are only read and restored by the admins, through the filer grpc.
+
+const (
+	DirectoryTrash = "/.trash"
+
+	TrashOriginalPath = "Seaweed-Trash-Original-Path"
+	TrashDeletedAt    = "Seaweed-Trash-Deleted-At"
+	TrashExpiresAt    = "Seaweed-Trash-Expires-At"
+
+	// TrashAdminKey is the grpc metadata key, and the jwt purpose, of the admins reading the trash
+	TrashAdminKey = "seaweed-trash-admin"
+)
+
+// trashRetention returns the trash retention of the location to delete from, or 0 if the deletion is not trashed
+func (f *Filer) trashRetention(p util.FullPath) time.Duration {
+	if IsInTrash(p) {
+		return 0
+	}
+	days := f.FilerConf.MatchStorageRule(string(p)).TrashRetentionDays
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// IsInTrash is true for the trash directory and the trashed entries
+func IsInTrash(p util.FullPath) bool {
+	return p == DirectoryTrash || strings.HasPrefix(string(p), DirectoryTrash+"/")
+}
+
+// ParseTrashInfo returns the trash attributes of a trashed entry
+func ParseTrashInfo(extended map[string][]byte) (originalPath string, deletedAt, expiresAt time.Time, found bool) {
+	if extended == nil || len(extended[TrashOriginalPath]) == 0 {
+		return
+	}
+	deletedAtSeconds, err := strconv.ParseInt(string(extended[TrashDeletedAt]), 10, 64)
+	if err != nil {
+		return
+	}
+	expiresAtSeconds, err := strconv.ParseInt(string(extended[TrashExpiresAt]), 10, 64)
+	if err != nil {
+		return
+	}
+	return string(extended[TrashOriginalPath]), time.Unix(deletedAtSeconds, 0), time.Unix(expiresAtSeconds, 0), true
+}
+
+func (f *Filer) moveToTrash(ctx context.Context, entry *Entry, isRecursive bool, retention time.Duration, now time.Time) error {
+
+	if entry.IsDirectory() && !isRecursive {
+		entries, _, err := f.ListDirectoryEntries(ctx, entry.FullPath, "", false, 1, "", "", "")
+		if err != nil {
+			return fmt.Errorf("list folder %s: %v", entry.FullPath, err)
+		}
+		if len(entries) > 0 {
+			return fmt.Errorf("%s: %s", MsgFailDelNonEmptyFolder, entry.FullPath)
+		}
+	}
+
+	extended := make(map[string][]byte)
+	for k, v := range entry.Extended {
+		extended[k] = v
+	}
+	extended[TrashOriginalPath] = []byte(entry.FullPath)
+	extended[TrashDeletedAt] = []byte(strconv.FormatInt(now.Unix(), 10))
+	extended[TrashExpiresAt] = []byte(strconv.FormatInt(now.Add(retention).Unix(), 10))
+
+	trashPath := util.NewFullPath(DirectoryTrash, fmt.Sprintf("%d-%s", now.UnixNano(), entry.Name()))
+	glog.V(3).Infof("move deleted %s to %s", entry.FullPath, trashPath)
+
+	ctx, err := f.BeginTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	if err = f.moveEntry(ctx, entry, trashPath, extended); err != nil {
+		f.RollbackTransaction(ctx)
+		return fmt.Errorf("move %s to %s: %v", entry.FullPath, trashPath, err)
+	}
+	if err = f.CommitTransaction(ctx); err != nil {
+		f.RollbackTransaction(ctx)
+		return fmt.Errorf("move %s to %s commit: %v", entry.FullPath, trashPath, err)
+	}
+	return nil
+}
+
+// deleteTrashOfBucket removes the trashed entries of the deleted bucket, whose data is deleted with the collection
+func (f *Filer) deleteTrashOfBucket(ctx context.Context, bucketPath util.FullPath) error {
+
+	var trashedPaths []util.FullPath
+	lastFileName := ""
+	for {
+		entries, hasMore, err := f.ListDirectoryEntries(ctx, DirectoryTrash, lastFileName, false, PaginationSize, "", "", "")
+		if err != nil {
+			return fmt.Errorf("list %s: %v", DirectoryTrash, err)
+		}
+		for _, entry := range entries {
+			lastFileName = entry.Name()
+			originalPath, _, _, found := ParseTrashInfo(entry.Extended)
+			if found && strings.HasPrefix(originalPath, string(bucketPath)+"/") {
+				trashedPaths = append(trashedPaths, entry.FullPath)
+			}
+		}
+		if !hasMore || len(entries) == 0 {
+			break
+		}
+	}
+
+	for _, p := range trashedPaths {
+		glog.V(3).Infof("delete trashed %s of bucket %s", p, bucketPath)
+		if err := f.DeleteEntryMetaAndData(ctx, p, true, true, false, false, nil); err != nil && err != filer_pb.ErrNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreEntry moves the trashed entry back to its original path, or to the target path if not empty
+func (f *Filer) RestoreEntry(ctx context.Context, name string, targetPath util.FullPath) (util.FullPath, error) {
+
+	if name == "" || strings.Contains(name, "/") {
+		return "", fmt.Errorf("invalid trashed entry name %q", name)
+	}
+	entry, err := f.FindEntry(ctx, util.NewFullPath(DirectoryTrash, name))
+	if err != nil {
+		return "", err
+	}
+	originalPath, _, _, found := ParseTrashInfo(entry.Extended)
+	if !found {
+		return "", fmt.Errorf("%s is not a trashed entry", entry.FullPath)
+	}
+	if targetPath == "" {
+		targetPath = util.FullPath(originalPath)
+	}
+	if IsInTrash(targetPath) {
+		return "", fmt.Errorf("can not restore into %s", DirectoryTrash)
+	}
+
+	// the data stays in the collection of the original path
+	if err = f.CanRename(util.FullPath(originalPath), targetPath); err != nil {
+		return "", err
+	}
+	if _, err = f.FindEntry(ctx, targetPath); err == nil {
+		return "", fmt.Errorf("%w: entry %s already exists", ErrEntryExists, targetPath)
+	} else if err != filer_pb.ErrNotFound {
+		return "", err
+	}
+
+	extended := make(map[string][]byte)
+	for k, v := range entry.Extended {
+		if k != TrashOriginalPath && k != TrashDeletedAt && k != TrashExpiresAt {
+			extended[k] = v
+		}
+	}
+
+	glog.V(1).Infof("restore %s to %s", entry.FullPath, targetPath)
+	return targetPath, f.moveEntry(ctx, entry, targetPath, extended)
+}
+
+// moveEntry moves the entry, and the children of a directory, to the new path without touching the data
+func (f *Filer) moveEntry(ctx context.Context, entry *Entry, newPath util.FullPath, extended map[string][]byte) error {
+
+	newEntry := &Entry{
+		FullPath:        newPath,
+		Attr:            entry.Attr,
+		Chunks:          entry.Chunks,
+		Extended:        extended,
+		HardLinkId:      entry.HardLinkId,
+		HardLinkCounter: entry.HardLinkCounter,
+		Content:         entry.Content,
+	}
+	if len(entry.HardLinkId) != 0 {
+		// the new link is counted before the old link is removed
+		newEntry.HardLinkCounter++
+	}
+	if err := f.CreateEntry(ctx, newEntry, true, false, nil); err != nil {
+		return err
+	}
+
+	if entry.IsDirectory() {
+		lastFileName := ""
+		for {
+			entries, hasMore, err := f.ListDirectoryEntries(ctx, entry.FullPath, lastFileName, false, PaginationSize, "", "", "")
+			if err != nil {
+				return fmt.Errorf("list folder %s: %v", entry.FullPath, err)
+			}
+			for _, sub := range entries {
+				lastFileName = sub.Name()
+				if err = f.moveEntry(ctx, sub, newPath.Child(sub.Name()), sub.Extended); err != nil {
+					return err
+				}
+			}
+			if !hasMore || len(entries) == 0 {
+				break
+			}
+		}
+	}
+
+	return f.DeleteEntryMetaAndData(WithMovingEntry(ctx), entry.FullPath, false, false, false, false, nil)
+}
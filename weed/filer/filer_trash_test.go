@@ -0,0 +1,286 @@
+package filer
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func TestParseTrashInfo(t *testing.T) {
+
+	extended := map[string][]byte{
+		TrashOriginalPath: []byte("/home/chris/report.pdf"),
+		TrashDeletedAt:    []byte("1634567890"),
+		TrashExpiresAt:    []byte("1635172690"),
+	}
+	originalPath, deletedAt, expiresAt, found := ParseTrashInfo(extended)
+	assert.True(t, found)
+	assert.Equal(t, "/home/chris/report.pdf", originalPath)
+	assert.Equal(t, time.Unix(1634567890, 0), deletedAt)
+	assert.Equal(t, 7*24*time.Hour, expiresAt.Sub(deletedAt))
+
+	extended[TrashExpiresAt] = []byte("never")
+	_, _, _, found = ParseTrashInfo(extended)
+	assert.False(t, found)
+
+	_, _, _, found = ParseTrashInfo(nil)
+	assert.False(t, found)
+
+	assert.True(t, IsInTrash("/.trash"))
+	assert.True(t, IsInTrash("/.trash/1634567890123456789-report.pdf"))
+	assert.False(t, IsInTrash("/.trashed"))
+
+}
+
+// testMemStore keeps the entries encoded in memory, like the filer stores do
+type testMemStore struct {
+	entries map[util.FullPath][]byte
+	kv      map[string][]byte
+}
+
+func newTestMemStore() *testMemStore {
+	return &testMemStore{entries: make(map[util.FullPath][]byte), kv: make(map[string][]byte)}
+}
+
+func (store *testMemStore) GetName() string {
+	return "memory"
+}
+
+func (store *testMemStore) Initialize(configuration util.Configuration, prefix string) error {
+	return nil
+}
+
+func (store *testMemStore) InsertEntry(ctx context.Context, entry *Entry) error {
+	value, err := entry.EncodeAttributesAndChunks()
+	if err != nil {
+		return err
+	}
+	store.entries[entry.FullPath] = value
+	return nil
+}
+
+func (store *testMemStore) UpdateEntry(ctx context.Context, entry *Entry) error {
+	return store.InsertEntry(ctx, entry)
+}
+
+func (store *testMemStore) FindEntry(ctx context.Context, p util.FullPath) (*Entry, error) {
+	value, found := store.entries[p]
+	if !found {
+		return nil, filer_pb.ErrNotFound
+	}
+	entry := &Entry{FullPath: p}
+	if err := entry.DecodeAttributesAndChunks(value); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (store *testMemStore) DeleteEntry(ctx context.Context, p util.FullPath) error {
+	delete(store.entries, p)
+	return nil
+}
+
+func (store *testMemStore) DeleteFolderChildren(ctx context.Context, p util.FullPath) error {
+	for child := range store.entries {
+		if strings.HasPrefix(string(child), string(p)+"/") {
+			delete(store.entries, child)
+		}
+	}
+	return nil
+}
+
+func (store *testMemStore) ListDirectoryEntries(ctx context.Context, dirPath util.FullPath, startFileName string, includeStartFile bool, limit int64, eachEntryFunc ListEachEntryFunc) (string, error) {
+	return store.ListDirectoryPrefixedEntries(ctx, dirPath, startFileName, includeStartFile, limit, "", eachEntryFunc)
+}
+
+func (store *testMemStore) ListDirectoryPrefixedEntries(ctx context.Context, dirPath util.FullPath, startFileName string, includeStartFile bool, limit int64, prefix string, eachEntryFunc ListEachEntryFunc) (lastFileName string, err error) {
+	var names []string
+	for p := range store.entries {
+		if dir, name := p.DirAndName(); util.FullPath(dir) == dirPath && strings.HasPrefix(name, prefix) &&
+			(name > startFileName || includeStartFile && name == startFileName) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if limit <= 0 {
+			break
+		}
+		limit--
+		entry, err := store.FindEntry(ctx, dirPath.Child(name))
+		if err != nil {
+			return lastFileName, err
+		}
+		lastFileName = name
+		if !eachEntryFunc(entry) {
+			break
+		}
+	}
+	return lastFileName, nil
+}
+
+func (store *testMemStore) BeginTransaction(ctx context.Context) (context.Context, error) {
+	return ctx, nil
+}
+
+func (store *testMemStore) CommitTransaction(ctx context.Context) error {
+	return nil
+}
+
+func (store *testMemStore) RollbackTransaction(ctx context.Context) error {
+	return nil
+}
+
+func (store *testMemStore) KvPut(ctx context.Context, key []byte, value []byte) error {
+	store.kv[string(key)] = value
+	return nil
+}
+
+func (store *testMemStore) KvGet(ctx context.Context, key []byte) ([]byte, error) {
+	value, found := store.kv[string(key)]
+	if !found {
+		return nil, ErrKvNotFound
+	}
+	return value, nil
+}
+
+func (store *testMemStore) KvDelete(ctx context.Context, key []byte) error {
+	delete(store.kv, string(key))
+	return nil
+}
+
+func (store *testMemStore) Shutdown() {
+}
+
+func newTrashTestFiler(t *testing.T) *Filer {
+	f := NewFiler(nil, nil, "", 0, "", "", "", nil)
+	f.SetStore(newTestMemStore())
+	assert.NoError(t, f.FilerConf.AddLocationConf(&filer_pb.FilerConf_PathConf{LocationPrefix: "/home/", TrashRetentionDays: 7}))
+	return f
+}
+
+func TestMoveToTrash(t *testing.T) {
+
+	ctx := context.Background()
+	f := newTrashTestFiler(t)
+
+	file := func(p util.FullPath) *Entry {
+		return &Entry{FullPath: p, Attr: Attr{Mode: 0644, Mtime: time.Now(), Crtime: time.Now()}, Content: []byte(p)}
+	}
+	trashed := func() (entries []*Entry) {
+		entries, _, err := f.ListDirectoryEntries(ctx, DirectoryTrash, "", false, 100, "", "", "")
+		assert.NoError(t, err)
+		return entries
+	}
+	exists := func(p util.FullPath) bool {
+		_, err := f.FindEntry(ctx, p)
+		return err == nil
+	}
+
+	assert.NoError(t, f.CreateEntry(ctx, file("/home/a/f1"), false, false, nil))
+	assert.NoError(t, f.DeleteEntryMetaAndData(ctx, "/home/a/f1", false, false, true, false, nil))
+	assert.False(t, exists("/home/a/f1"))
+	if entries := trashed(); assert.Equal(t, 1, len(entries)) {
+		originalPath, deletedAt, expiresAt, found := ParseTrashInfo(entries[0].Extended)
+		assert.True(t, found)
+		assert.Equal(t, "/home/a/f1", originalPath)
+		assert.Equal(t, 7*24*time.Hour, expiresAt.Sub(deletedAt))
+		assert.Equal(t, []byte("/home/a/f1"), entries[0].Content)
+	}
+
+	// a non-empty directory is only trashed by a recursive delete
+	assert.NoError(t, f.CreateEntry(ctx, file("/home/d/x/f2"), false, false, nil))
+	err := f.DeleteEntryMetaAndData(ctx, "/home/d", false, false, true, false, nil)
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), MsgFailDelNonEmptyFolder), err)
+	assert.True(t, exists("/home/d/x/f2"))
+	assert.Equal(t, 1, len(trashed()))
+
+	assert.NoError(t, f.DeleteEntryMetaAndData(ctx, "/home/d", true, false, true, false, nil))
+	assert.False(t, exists("/home/d"))
+	assert.False(t, exists("/home/d/x/f2"))
+	entries := trashed()
+	if assert.Equal(t, 2, len(entries)) {
+		assert.True(t, exists(entries[1].FullPath.Child("x").Child("f2")))
+	}
+
+	// the deletions inside the trash are not trashed again
+	assert.NoError(t, f.DeleteEntryMetaAndData(ctx, entries[1].FullPath, true, false, true, false, nil))
+	assert.Equal(t, 1, len(trashed()))
+
+	// the trashed entries of a deleted bucket are removed with the bucket
+	assert.NoError(t, f.FilerConf.AddLocationConf(&filer_pb.FilerConf_PathConf{LocationPrefix: "/buckets/", TrashRetentionDays: 1}))
+	for _, p := range []util.FullPath{"/buckets/b1/o1", "/buckets/b10/o2"} {
+		assert.NoError(t, f.CreateEntry(ctx, file(p), false, false, nil))
+		assert.NoError(t, f.DeleteEntryMetaAndData(ctx, p, false, false, true, false, nil))
+	}
+	assert.Equal(t, 3, len(trashed()))
+	assert.NoError(t, f.deleteTrashOfBucket(ctx, "/buckets/b1"))
+	entries = trashed()
+	if assert.Equal(t, 2, len(entries)) {
+		originalPath, _, _, _ := ParseTrashInfo(entries[1].Extended)
+		assert.Equal(t, "/buckets/b10/o2", originalPath)
+	}
+
+}
+
+func TestRestoreEntry(t *testing.T) {
+
+	ctx := context.Background()
+	f := newTrashTestFiler(t)
+
+	trashedName := func() string {
+		entries, _, err := f.ListDirectoryEntries(ctx, DirectoryTrash, "", false, 100, "", "", "")
+		assert.NoError(t, err)
+		if !assert.Equal(t, 1, len(entries)) {
+			t.FailNow()
+		}
+		return entries[0].Name()
+	}
+	hardLinkCounter := func(p util.FullPath) int32 {
+		entry, err := f.FindEntry(ctx, p)
+		if !assert.NoError(t, err, "find %s", p) {
+			return 0
+		}
+		return entry.HardLinkCounter
+	}
+
+	// the hard link stays counted once while it moves into the trash and back
+	hardLinkId := HardLinkId("hardlink-1")
+	for _, p := range []util.FullPath{"/home/h1", "/home/h2"} {
+		assert.NoError(t, f.CreateEntry(ctx, &Entry{FullPath: p, Attr: Attr{Mode: 0644}, HardLinkId: hardLinkId, HardLinkCounter: 2, Content: []byte("data")}, false, false, nil))
+	}
+	assert.NoError(t, f.DeleteEntryMetaAndData(ctx, "/home/h1", false, false, true, false, nil))
+	name := trashedName()
+	assert.Equal(t, int32(2), hardLinkCounter("/home/h2"))
+	assert.Equal(t, int32(2), hardLinkCounter(util.NewFullPath(DirectoryTrash, name)))
+
+	// not restored onto an existing entry
+	assert.NoError(t, f.CreateEntry(ctx, &Entry{FullPath: "/home/h1", Attr: Attr{Mode: 0644}, Content: []byte("new")}, false, false, nil))
+	_, err := f.RestoreEntry(ctx, name, "")
+	assert.True(t, errors.Is(err, ErrEntryExists), err)
+	_, err = f.RestoreEntry(ctx, name, DirectoryTrash+"/restored")
+	assert.Error(t, err)
+
+	restoredPath, err := f.RestoreEntry(ctx, name, "/home/h1.restored")
+	assert.NoError(t, err)
+	assert.Equal(t, util.FullPath("/home/h1.restored"), restoredPath)
+	restored, err := f.FindEntry(ctx, restoredPath)
+	if assert.NoError(t, err) {
+		_, _, _, found := ParseTrashInfo(restored.Extended)
+		assert.False(t, found)
+		assert.Equal(t, []byte("data"), restored.Content)
+	}
+	assert.Equal(t, int32(2), hardLinkCounter("/home/h2"))
+	_, err = f.FindEntry(ctx, util.NewFullPath(DirectoryTrash, name))
+	assert.Equal(t, filer_pb.ErrNotFound, err)
+
+}
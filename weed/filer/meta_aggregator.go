@@ -84,6 +84,10 @@ func (ma *MetaAggregator) subscribeToOneFiler(f *Filer, self string, peer string
 				glog.Errorf("failed to reply metadata change from %v: %v", peer, err)
 				return
 			}
+			oldEntry, newEntry := ReplayedEntries(event)
+			if err := f.replayDedupChunks(context.Background(), oldEntry, newEntry); err != nil {
+				glog.Errorf("count dedup chunks of metadata change from %v: %v", peer, err)
+			}
 			counter++
 			if lastPersistTime.Add(time.Minute).Before(time.Now()) {
 				if err := ma.updateOffset(f, peer, peerSignature, event.TsNs); err == nil {
@@ -102,6 +106,14 @@ func (ma *MetaAggregator) subscribeToOneFiler(f *Filer, self string, peer string
 		}
 	}
 
+	// the directory stats count the events of this filer, and of the peer filers with separate filer stores,
+	// but not the events persisted before this filer is started, which are counted already
+	isCountingDirectoryStats := peer == self || peerSignature != f.Signature
+	countSinceNs := time.Now().UnixNano()
+	if peer != self {
+		countSinceNs = 0
+	}
+
 	processEventFn := func(event *filer_pb.SubscribeMetadataResponse) error {
 		data, err := proto.Marshal(event)
 		if err != nil {
@@ -145,6 +157,9 @@ func (ma *MetaAggregator) subscribeToOneFiler(f *Filer, self string, peer string
 				lastTsNs = resp.TsNs
 
 				f.onMetadataChangeEvent(resp)
+				if isCountingDirectoryStats && resp.TsNs >= countSinceNs {
+					f.countDirectoryStats(resp)
+				}
 
 			}
 		})
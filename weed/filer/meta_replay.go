@@ -8,6 +8,22 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
+// ReplayedEntries returns the entries before and after the change
+func ReplayedEntries(resp *filer_pb.SubscribeMetadataResponse) (oldEntry, newEntry *Entry) {
+	message := resp.EventNotification
+	if message.OldEntry != nil {
+		oldEntry = FromPbEntry(resp.Directory, message.OldEntry)
+	}
+	if message.NewEntry != nil {
+		dir := resp.Directory
+		if message.NewParentPath != "" {
+			dir = message.NewParentPath
+		}
+		newEntry = FromPbEntry(dir, message.NewEntry)
+	}
+	return
+}
+
 func Replay(filerStore FilerStore, resp *filer_pb.SubscribeMetadataResponse) error {
 	message := resp.EventNotification
 	var oldPath util.FullPath
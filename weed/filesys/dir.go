@@ -85,6 +85,10 @@ func (dir *Dir) Getxattr(ctx context.Context, req *fuse.GetxattrRequest, resp *f
 
 	glog.V(4).Infof("dir Getxattr %s", dir.FullPath())
 
+	if isDirectoryStatsXattr(req.Name) {
+		return dir.wfs.getDirectoryStatsXattr(dir.FullPath(), req, resp)
+	}
+
 	entry, err := dir.maybeLoadEntry()
 	if err != nil {
 		return err
@@ -147,6 +151,12 @@ func (dir *Dir) Create(ctx context.Context, req *fuse.CreateRequest,
 	exclusive := req.Flags&fuse.OpenExclusive != 0
 	isDirectory := req.Mode&os.ModeDir > 0
 
+	if !isDirectory {
+		if err := dir.wfs.checkQuota(util.NewFullPath(dir.FullPath(), req.Name), 0, 1); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	if exclusive || isDirectory {
 		_, err := dir.doCreateEntry(req.Name, req.Mode, req.Uid, req.Gid, exclusive)
 		if err != nil {
@@ -305,7 +315,8 @@ func (dir *Dir) Lookup(ctx context.Context, req *fuse.LookupRequest, resp *fuse.
 		return nil, fuse.EIO
 	}
 	localEntry, cacheErr := dir.wfs.metaCache.FindEntry(context.Background(), fullFilePath)
-	if cacheErr == filer_pb.ErrNotFound {
+	if cacheErr == filer_pb.ErrNotFound && req.Name != filer.SnapshotsDirName {
+		// the snapshots are not listed in the directory, and only found by the filer
 		return nil, fuse.ENOENT
 	}
 
@@ -173,6 +173,12 @@ func (fh *FileHandle) Write(ctx context.Context, req *fuse.WriteRequest, resp *f
 		return fuse.EIO
 	}
 
+	if growth := req.Offset + int64(len(data)) - int64(entry.Attributes.FileSize); growth > 0 {
+		if err := fh.f.wfs.checkQuota(fh.f.fullpath(), growth, 0); err != nil {
+			return err
+		}
+	}
+
 	entry.Content = nil
 	entry.Attributes.FileSize = uint64(max(req.Offset+int64(len(data)), int64(entry.Attributes.FileSize)))
 	// glog.V(4).Infof("%v write [%d,%d) %d", fh.f.fullpath(), req.Offset, req.Offset+int64(len(req.Data)), len(req.Data))
@@ -241,7 +247,7 @@ func (fh *FileHandle) doFlush(ctx context.Context, header fuse.Header) error {
 
 	if err := fh.dirtyPages.FlushData(); err != nil {
 		glog.Errorf("%v doFlush: %v", fh.f.fullpath(), err)
-		return fuse.EIO
+		return toQuotaErrno(err, fuse.EIO)
 	}
 
 	if !fh.f.dirtyMetadata {
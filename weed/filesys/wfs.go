@@ -72,7 +72,8 @@ type WFS struct {
 
 	bufPool sync.Pool
 
-	stats statsCache
+	stats  statsCache
+	quotas quotaCache
 
 	root        fs.Node
 	fsNodeCache *FsCache
@@ -241,6 +242,23 @@ func (wfs *WFS) Statfs(ctx context.Context, req *fuse.StatfsRequest, resp *fuse.
 	resp.Files = math.MaxInt64
 	resp.Ffree = math.MaxInt64 - actualFileCount
 
+	// the quota covering the mount root is reported as the file system size
+	if quota := wfs.mountRootQuota(); quota != nil {
+		resp.Blocks = quota.QuotaBytes / blockSize
+		resp.Bfree, resp.Bavail = 0, 0
+		if quotaUsedBlocks := uint64(quota.UsedBytes) / blockSize; quotaUsedBlocks < resp.Blocks {
+			resp.Bfree = resp.Blocks - quotaUsedBlocks
+			resp.Bavail = resp.Bfree
+		}
+		if quota.QuotaObjects > 0 {
+			resp.Files = quota.QuotaObjects
+			resp.Ffree = 0
+			if uint64(quota.UsedObjects) < quota.QuotaObjects {
+				resp.Ffree = quota.QuotaObjects - uint64(quota.UsedObjects)
+			}
+		}
+	}
+
 	// Report the maximum length of a name and the minimum fragment size
 	resp.Namelen = 1024
 	resp.Frsize = uint32(blockSize)
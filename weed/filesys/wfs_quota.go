@@ -0,0 +1,100 @@
+package filesys
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/seaweedfs/fuse"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// quotaCache keeps the usage of the quota locations related to the mount root
+type quotaCache struct {
+	sync.Mutex
+	usages      []*filer_pb.DirectoryUsage
+	lastChecked int64 // unix time in seconds
+}
+
+// getDirectoryUsages refreshes the usages from the filer at most every 10 seconds.
+// The writes in between are added locally, so a single mount can not go far beyond the quotas.
+func (wfs *WFS) getDirectoryUsages() []*filer_pb.DirectoryUsage {
+	wfs.quotas.Lock()
+	defer wfs.quotas.Unlock()
+
+	if wfs.quotas.lastChecked >= time.Now().Unix()-10 {
+		return wfs.quotas.usages
+	}
+	wfs.quotas.lastChecked = time.Now().Unix()
+
+	err := wfs.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.GetDirectoryUsage(context.Background(), &filer_pb.GetDirectoryUsageRequest{
+			Path: wfs.option.FilerMountRootPath,
+		})
+		if err != nil {
+			return err
+		}
+		wfs.quotas.usages = resp.Usages
+		return nil
+	})
+	if err != nil {
+		glog.V(0).Infof("read directory usage of %s: %v", wfs.option.FilerMountRootPath, err)
+	}
+	return wfs.quotas.usages
+}
+
+// checkQuota returns EDQUOT if adding the bytes and the objects to the path goes over any quota
+func (wfs *WFS) checkQuota(fullpath util.FullPath, bytes, objects int64) error {
+	usages := wfs.getDirectoryUsages()
+
+	wfs.quotas.Lock()
+	defer wfs.quotas.Unlock()
+
+	var matched []*filer_pb.DirectoryUsage
+	for _, usage := range usages {
+		if !strings.HasPrefix(string(fullpath), usage.LocationPrefix) {
+			continue
+		}
+		if usage.QuotaBytes > 0 && bytes > 0 && usage.UsedBytes+bytes > int64(usage.QuotaBytes) {
+			glog.V(1).Infof("write %s: %s uses %d of %d bytes", fullpath, usage.LocationPrefix, usage.UsedBytes, usage.QuotaBytes)
+			return fuse.Errno(syscall.EDQUOT)
+		}
+		if usage.QuotaObjects > 0 && objects > 0 && usage.UsedObjects+objects > int64(usage.QuotaObjects) {
+			glog.V(1).Infof("create %s: %s has %d of %d objects", fullpath, usage.LocationPrefix, usage.UsedObjects, usage.QuotaObjects)
+			return fuse.Errno(syscall.EDQUOT)
+		}
+		matched = append(matched, usage)
+	}
+	for _, usage := range matched {
+		usage.UsedBytes += bytes
+		usage.UsedObjects += objects
+	}
+	return nil
+}
+
+// mountRootQuota returns the quota location covering the mount root with the least free space
+func (wfs *WFS) mountRootQuota() (found *filer_pb.DirectoryUsage) {
+	mountRoot := strings.TrimSuffix(wfs.option.FilerMountRootPath, "/") + "/"
+	for _, usage := range wfs.getDirectoryUsages() {
+		if usage.QuotaBytes == 0 || !strings.HasPrefix(mountRoot, usage.LocationPrefix) {
+			continue
+		}
+		if found == nil || int64(usage.QuotaBytes)-usage.UsedBytes < int64(found.QuotaBytes)-found.UsedBytes {
+			found = usage
+		}
+	}
+	return
+}
+
+// toQuotaErrno reports the quota errors from the filer as EDQUOT
+func toQuotaErrno(err error, defaultErrno fuse.Errno) fuse.Errno {
+	if strings.Contains(err.Error(), "quota exceeded") {
+		return fuse.Errno(syscall.EDQUOT)
+	}
+	return defaultErrno
+}
@@ -2,10 +2,12 @@ package filesys
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/seaweedfs/fuse"
 
 	"github.com/chrislusf/seaweedfs/weed/filesys/meta_cache"
+	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/util"
 )
@@ -121,3 +123,54 @@ func (wfs *WFS) maybeLoadEntry(dir, name string) (entry *filer_pb.Entry, err err
 	}
 	return cachedEntry.ToProtoEntry(), cacheErr
 }
+
+// the virtual extended attributes of the directories, which are not listed
+const (
+	XattrDirectorySize  = "user.seaweedfs.dir.size"
+	XattrDirectoryFiles = "user.seaweedfs.dir.files"
+	XattrDirectoryMtime = "user.seaweedfs.dir.mtime"
+)
+
+func isDirectoryStatsXattr(name string) bool {
+	return name == XattrDirectorySize || name == XattrDirectoryFiles || name == XattrDirectoryMtime
+}
+
+// getDirectoryStatsXattr reads the recursive counters of the directory from the filer, as decimal numbers
+func (wfs *WFS) getDirectoryStatsXattr(fullpath string, req *fuse.GetxattrRequest, resp *fuse.GetxattrResponse) error {
+
+	var stats *filer_pb.DirectoryStats
+	err := wfs.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+		statsResp, err := client.GetDirectoryStats(context.Background(), &filer_pb.GetDirectoryStatsRequest{
+			Directory: fullpath,
+		})
+		if err != nil {
+			return err
+		}
+		stats = statsResp.Stats
+		return nil
+	})
+	if err != nil {
+		glog.V(0).Infof("read directory stats of %s: %v", fullpath, err)
+		return fuse.EIO
+	}
+
+	var value int64
+	switch req.Name {
+	case XattrDirectorySize:
+		value = int64(stats.GetTotalSize())
+	case XattrDirectoryFiles:
+		value = int64(stats.GetFileCount())
+	case XattrDirectoryMtime:
+		value = stats.GetLatestMtime()
+	}
+	data := []byte(strconv.FormatInt(value, 10))
+
+	if req.Position < uint32(len(data)) {
+		data = data[req.Position:]
+		if req.Size > 0 && req.Size < uint32(len(data)) {
+			data = data[:req.Size]
+		}
+		resp.Xattr = data
+	}
+	return nil
+}
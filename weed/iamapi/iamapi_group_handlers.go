@@ -0,0 +1,116 @@
+package iamapi
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+)
+
+func findGroup(s3cfg *iam_pb.S3ApiConfiguration, groupName string) (*iam_pb.Group, error) {
+	for _, group := range s3cfg.Groups {
+		if group.Name == groupName {
+			return group, nil
+		}
+	}
+	return nil, noSuchEntity("group", groupName)
+}
+
+func toGroup(group *iam_pb.Group) *iam.Group {
+	groupName := group.Name
+	arn := fmt.Sprintf("arn:aws:iam:::group/%s", group.Name)
+	return &iam.Group{GroupName: &groupName, GroupId: &groupName, Arn: &arn}
+}
+
+func (iama *IamApiServer) CreateGroup(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp CreateGroupResponse, err error) {
+	groupName := values.Get("GroupName")
+	if groupName == "" {
+		return resp, invalidInput("GroupName is required")
+	}
+	if _, err = findGroup(s3cfg, groupName); err == nil {
+		return resp, &entityError{code: iam.ErrCodeEntityAlreadyExistsException, entity: "group", name: groupName}
+	}
+	group := &iam_pb.Group{Name: groupName}
+	s3cfg.Groups = append(s3cfg.Groups, group)
+	resp.CreateGroupResult.Group = *toGroup(group)
+	return resp, nil
+}
+
+func (iama *IamApiServer) GetGroup(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp GetGroupResponse, err error) {
+	group, err := findGroup(s3cfg, values.Get("GroupName"))
+	if err != nil {
+		return resp, err
+	}
+	resp.GetGroupResult.Group = *toGroup(group)
+	for _, member := range group.Members {
+		userName := member
+		resp.GetGroupResult.Users = append(resp.GetGroupResult.Users, &iam.User{UserName: &userName})
+	}
+	return resp, nil
+}
+
+func (iama *IamApiServer) ListGroups(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp ListGroupsResponse) {
+	for _, group := range s3cfg.Groups {
+		resp.ListGroupsResult.Groups = append(resp.ListGroupsResult.Groups, toGroup(group))
+	}
+	return resp
+}
+
+func (iama *IamApiServer) ListGroupsForUser(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp ListGroupsForUserResponse, err error) {
+	ident, err := findIdentity(s3cfg, values.Get("UserName"))
+	if err != nil {
+		return resp, err
+	}
+	for _, group := range s3cfg.Groups {
+		for _, member := range group.Members {
+			if member == ident.Name {
+				resp.ListGroupsForUserResult.Groups = append(resp.ListGroupsForUserResult.Groups, toGroup(group))
+				break
+			}
+		}
+	}
+	return resp, nil
+}
+
+// DeleteGroup deletes the group, after all members are removed and all policies are detached
+func (iama *IamApiServer) DeleteGroup(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp DeleteGroupResponse, err error) {
+	groupName := values.Get("GroupName")
+	for i, group := range s3cfg.Groups {
+		if group.Name != groupName {
+			continue
+		}
+		if len(group.Members) > 0 || len(group.PolicyNames) > 0 {
+			return resp, &entityError{code: iam.ErrCodeDeleteConflictException, entity: "group", name: groupName}
+		}
+		s3cfg.Groups = append(s3cfg.Groups[:i], s3cfg.Groups[i+1:]...)
+		return resp, nil
+	}
+	return resp, noSuchEntity("group", groupName)
+}
+
+func (iama *IamApiServer) AddUserToGroup(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp AddUserToGroupResponse, err error) {
+	group, err := findGroup(s3cfg, values.Get("GroupName"))
+	if err != nil {
+		return resp, err
+	}
+	ident, err := findIdentity(s3cfg, values.Get("UserName"))
+	if err != nil {
+		return resp, err
+	}
+	group.Members = appendName(group.Members, ident.Name)
+	return resp, nil
+}
+
+func (iama *IamApiServer) RemoveUserFromGroup(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp RemoveUserFromGroupResponse, err error) {
+	userName := values.Get("UserName")
+	group, err := findGroup(s3cfg, values.Get("GroupName"))
+	if err != nil {
+		return resp, err
+	}
+	var found bool
+	if group.Members, found = removeName(group.Members, userName); !found {
+		return resp, noSuchEntity("user", userName)
+	}
+	return resp, nil
+}
@@ -3,6 +3,7 @@ package iamapi
 import (
 	"bytes"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -65,6 +66,16 @@ func writeIamErrorResponse(w http.ResponseWriter, err error, object string, valu
 		msg := fmt.Sprintf("The %s with name %s cannot be found.", object, value)
 		errorResp.Error.Message = &msg
 		writeResponse(w, http.StatusNotFound, encodeResponse(errorResp), mimeXML)
+	case iam.ErrCodeEntityAlreadyExistsException:
+		msg := fmt.Sprintf("The %s with name %s already exists.", object, value)
+		errorResp.Error.Message = &msg
+		writeResponse(w, http.StatusConflict, encodeResponse(errorResp), mimeXML)
+	case iam.ErrCodeDeleteConflictException:
+		msg := fmt.Sprintf("The %s with name %s is still in use.", object, value)
+		errorResp.Error.Message = &msg
+		writeResponse(w, http.StatusConflict, encodeResponse(errorResp), mimeXML)
+	case iam.ErrCodeInvalidInputException, iam.ErrCodeMalformedPolicyDocumentException:
+		writeResponse(w, http.StatusBadRequest, encodeResponse(errorResp), mimeXML)
 	case iam.ErrCodeServiceFailureException:
 		writeResponse(w, http.StatusInternalServerError, encodeResponse(errorResp), mimeXML)
 	default:
@@ -72,6 +83,19 @@ func writeIamErrorResponse(w http.ResponseWriter, err error, object string, valu
 	}
 }
 
+// writeEntityErrorResponse writes the errors of the group, role and managed policy actions
+func writeEntityErrorResponse(w http.ResponseWriter, err error) {
+	if e, ok := err.(*entityError); ok {
+		var msg error
+		if e.message != "" {
+			msg = errors.New(e.message)
+		}
+		writeIamErrorResponse(w, e, e.entity, e.name, msg)
+		return
+	}
+	writeIamErrorResponse(w, fmt.Errorf(iam.ErrCodeServiceFailureException), "", "", err)
+}
+
 func getRESTErrorResponse(err s3err.APIError, resource string) s3err.RESTErrorResponse {
 	return s3err.RESTErrorResponse{
 		Code:      err.Code,
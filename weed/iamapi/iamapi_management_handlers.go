@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+	"github.com/chrislusf/seaweedfs/weed/s3api/bucketpolicy"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3presign"
 	"math/rand"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -28,6 +31,7 @@ const (
 	StatementActionRead    = "Get*"
 	StatementActionList    = "List*"
 	StatementActionTagging = "Tagging*"
+	maxPresignedParts      = 10000
 )
 
 var (
@@ -72,9 +76,11 @@ func MapToIdentitiesAction(action string) string {
 }
 
 type Statement struct {
-	Effect   string   `json:"Effect"`
-	Action   []string `json:"Action"`
-	Resource []string `json:"Resource"`
+	Effect    string                         `json:"Effect"`
+	Action    []string                       `json:"Action,omitempty"`
+	NotAction []string                       `json:"NotAction,omitempty"`
+	Resource  []string                       `json:"Resource"`
+	Condition map[string]map[string][]string `json:"Condition,omitempty"`
 }
 
 type Policies struct {
@@ -113,13 +119,16 @@ func (iama *IamApiServer) ListUsers(s3cfg *iam_pb.S3ApiConfiguration, values url
 }
 
 func (iama *IamApiServer) ListAccessKeys(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp ListAccessKeysResponse) {
-	status := iam.StatusTypeActive
 	userName := values.Get("UserName")
 	for _, ident := range s3cfg.Identities {
 		if userName != "" && userName != ident.Name {
 			continue
 		}
 		for _, cred := range ident.Credentials {
+			status := iam.StatusTypeActive
+			if cred.IsDisabled {
+				status = iam.StatusTypeInactive
+			}
 			resp.ListAccessKeysResult.AccessKeyMetadata = append(resp.ListAccessKeysResult.AccessKeyMetadata,
 				&iam.AccessKeyMetadata{UserName: &ident.Name, AccessKeyId: &cred.AccessKey, Status: &status},
 			)
@@ -139,6 +148,9 @@ func (iama *IamApiServer) DeleteUser(s3cfg *iam_pb.S3ApiConfiguration, userName
 	for i, ident := range s3cfg.Identities {
 		if userName == ident.Name {
 			s3cfg.Identities = append(s3cfg.Identities[:i], s3cfg.Identities[i+1:]...)
+			for _, group := range s3cfg.Groups {
+				group.Members, _ = removeName(group.Members, userName)
+			}
 			return resp, nil
 		}
 	}
@@ -155,10 +167,34 @@ func (iama *IamApiServer) GetUser(s3cfg *iam_pb.S3ApiConfiguration, userName str
 	return resp, fmt.Errorf(iam.ErrCodeNoSuchEntityException)
 }
 
+// GetPolicyDocument parses and checks the policy, which can also use single strings for the actions and resources
 func GetPolicyDocument(policy *string) (policyDocument PolicyDocument, err error) {
-	if err = json.Unmarshal([]byte(*policy), &policyDocument); err != nil {
+	p, err := bucketpolicy.Parse([]byte(*policy))
+	if err != nil {
 		return PolicyDocument{}, err
 	}
+	if err = p.ValidateIdentityPolicy(); err != nil {
+		return PolicyDocument{}, err
+	}
+	policyDocument.Version = p.Version
+	for _, s := range p.Statement {
+		statement := &Statement{
+			Effect:    s.Effect,
+			Action:    s.Action,
+			NotAction: s.NotAction,
+			Resource:  s.Resource,
+		}
+		for operator, conditions := range s.Condition {
+			if statement.Condition == nil {
+				statement.Condition = make(map[string]map[string][]string)
+			}
+			statement.Condition[operator] = make(map[string][]string)
+			for key, values := range conditions {
+				statement.Condition[operator][key] = values
+			}
+		}
+		policyDocument.Statement = append(policyDocument.Statement, statement)
+	}
 	return policyDocument, err
 }
 
@@ -360,6 +396,93 @@ func (iama *IamApiServer) DeleteAccessKey(s3cfg *iam_pb.S3ApiConfiguration, valu
 	return resp
 }
 
+func (iama *IamApiServer) CreatePresignedUrl(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp CreatePresignedUrlResponse, err error) {
+	userName := values.Get("UserName")
+	accessKeyId := values.Get("AccessKeyId")
+	var cred *iam_pb.Credential
+	for _, ident := range s3cfg.Identities {
+		if userName != ident.Name {
+			continue
+		}
+		for _, c := range ident.Credentials {
+			if accessKeyId == "" || c.AccessKey == accessKeyId {
+				cred = c
+				break
+			}
+		}
+		break
+	}
+	if cred == nil {
+		return resp, fmt.Errorf(iam.ErrCodeNoSuchEntityException)
+	}
+
+	expiresIn := int64(3600)
+	if v := values.Get("ExpiresIn"); v != "" {
+		if expiresIn, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return resp, fmt.Errorf("parse ExpiresIn %s: %v", v, err)
+		}
+	}
+	now := time.Now()
+	opts := s3presign.Options{
+		Endpoint:  values.Get("Endpoint"),
+		Region:    values.Get("Region"),
+		AccessKey: cred.AccessKey,
+		SecretKey: cred.SecretKey,
+		Method:    values.Get("Method"),
+		Bucket:    values.Get("Bucket"),
+		Object:    values.Get("Key"),
+		Expires:   time.Duration(expiresIn) * time.Second,
+		Query:     make(url.Values),
+		Headers:   make(http.Header),
+		Date:      now,
+	}
+	for _, header := range []string{"Content-Type", "Content-Length", "Content-MD5"} {
+		if v := values.Get(strings.Replace(header, "-", "", -1)); v != "" {
+			opts.Headers.Set(header, v)
+		}
+	}
+	if values.Get("Uploads") == "true" {
+		opts.Query.Set("uploads", "")
+	}
+	if uploadId := values.Get("UploadId"); uploadId != "" {
+		opts.Query.Set("uploadId", uploadId)
+	}
+
+	// a single url, or one for each part from PartNumber to PartNumber+PartCount-1
+	partNumber, _ := strconv.Atoi(values.Get("PartNumber"))
+	partCount, _ := strconv.Atoi(values.Get("PartCount"))
+	if partCount > 0 && partNumber == 0 {
+		partNumber = 1
+	}
+	if partNumber > 0 && partCount == 0 {
+		partCount = 1
+	}
+	if partNumber > 0 && opts.Query.Get("uploadId") == "" {
+		return resp, fmt.Errorf("uploading parts needs UploadId")
+	}
+	if partNumber < 0 || partCount < 0 || partNumber+partCount-1 > maxPresignedParts {
+		return resp, fmt.Errorf("part numbers should be between 1 and %d", maxPresignedParts)
+	}
+
+	if partNumber == 0 {
+		presignedUrl, err := s3presign.PresignV4(opts)
+		if err != nil {
+			return resp, err
+		}
+		resp.CreatePresignedUrlResult.Urls = append(resp.CreatePresignedUrlResult.Urls, &PresignedUrl{Url: presignedUrl})
+	}
+	for n := partNumber; n > 0 && n < partNumber+partCount; n++ {
+		opts.Query.Set("partNumber", strconv.Itoa(n))
+		presignedUrl, err := s3presign.PresignV4(opts)
+		if err != nil {
+			return resp, err
+		}
+		resp.CreatePresignedUrlResult.Urls = append(resp.CreatePresignedUrlResult.Urls, &PresignedUrl{PartNumber: n, Url: presignedUrl})
+	}
+	resp.CreatePresignedUrlResult.Expiration = now.Add(opts.Expires).UTC()
+	return resp, nil
+}
+
 func (iama *IamApiServer) DoActions(w http.ResponseWriter, r *http.Request) {
 	if err := r.ParseForm(); err != nil {
 		writeErrorResponse(w, s3err.ErrInvalidRequest, r.URL)
@@ -428,10 +551,85 @@ func (iama *IamApiServer) DoActions(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		changed = false
+	case "CreatePresignedUrl":
+		response, err = iama.CreatePresignedUrl(s3cfg, values)
+		if err != nil {
+			if err.Error() == iam.ErrCodeNoSuchEntityException {
+				writeIamErrorResponse(w, err, "user", values.Get("UserName"), nil)
+				return
+			}
+			glog.Errorf("CreatePresignedUrl:  %+v", err)
+			writeErrorResponse(w, s3err.ErrInvalidRequest, r.URL)
+			return
+		}
+		changed = false
 	case "DeleteUserPolicy":
 		if response, err = iama.DeleteUserPolicy(s3cfg, values); err != nil {
 			writeIamErrorResponse(w, err, "user", values.Get("UserName"), nil)
+			return
 		}
+	case "UpdateAccessKey":
+		response, err = iama.UpdateAccessKey(s3cfg, values)
+	case "GetPolicy":
+		response, err = iama.GetPolicy(s3cfg, values)
+		changed = false
+	case "GetPolicyVersion":
+		response, err = iama.GetPolicyVersion(s3cfg, values)
+		changed = false
+	case "ListPolicies":
+		response, err = iama.ListPolicies(s3cfg, values)
+		changed = false
+	case "DeletePolicy":
+		response, err = iama.DeletePolicy(s3cfg, values)
+		changed = false
+	case "AttachUserPolicy":
+		response, err = iama.AttachUserPolicy(s3cfg, values)
+	case "DetachUserPolicy":
+		response, err = iama.DetachUserPolicy(s3cfg, values)
+	case "ListAttachedUserPolicies":
+		response, err = iama.ListAttachedUserPolicies(s3cfg, values)
+		changed = false
+	case "AttachGroupPolicy":
+		response, err = iama.AttachGroupPolicy(s3cfg, values)
+	case "DetachGroupPolicy":
+		response, err = iama.DetachGroupPolicy(s3cfg, values)
+	case "ListAttachedGroupPolicies":
+		response, err = iama.ListAttachedGroupPolicies(s3cfg, values)
+		changed = false
+	case "AttachRolePolicy":
+		response, err = iama.AttachRolePolicy(s3cfg, values)
+	case "DetachRolePolicy":
+		response, err = iama.DetachRolePolicy(s3cfg, values)
+	case "ListAttachedRolePolicies":
+		response, err = iama.ListAttachedRolePolicies(s3cfg, values)
+		changed = false
+	case "CreateGroup":
+		response, err = iama.CreateGroup(s3cfg, values)
+	case "GetGroup":
+		response, err = iama.GetGroup(s3cfg, values)
+		changed = false
+	case "ListGroups":
+		response = iama.ListGroups(s3cfg, values)
+		changed = false
+	case "ListGroupsForUser":
+		response, err = iama.ListGroupsForUser(s3cfg, values)
+		changed = false
+	case "DeleteGroup":
+		response, err = iama.DeleteGroup(s3cfg, values)
+	case "AddUserToGroup":
+		response, err = iama.AddUserToGroup(s3cfg, values)
+	case "RemoveUserFromGroup":
+		response, err = iama.RemoveUserFromGroup(s3cfg, values)
+	case "CreateRole":
+		response, err = iama.CreateRole(s3cfg, values)
+	case "GetRole":
+		response, err = iama.GetRole(s3cfg, values)
+		changed = false
+	case "ListRoles":
+		response = iama.ListRoles(s3cfg, values)
+		changed = false
+	case "DeleteRole":
+		response, err = iama.DeleteRole(s3cfg, values)
 	default:
 		errNotImplemented := s3err.GetAPIError(s3err.ErrNotImplemented)
 		errorResponse := ErrorResponse{}
@@ -440,6 +638,10 @@ func (iama *IamApiServer) DoActions(w http.ResponseWriter, r *http.Request) {
 		writeResponse(w, errNotImplemented.HTTPStatusCode, encodeResponse(errorResponse), mimeXML)
 		return
 	}
+	if err != nil {
+		writeEntityErrorResponse(w, err)
+		return
+	}
 	if changed {
 		s3cfgLock.Lock()
 		err := iama.s3ApiConfig.PutS3ApiConfiguration(s3cfg)
@@ -0,0 +1,332 @@
+package iamapi
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+)
+
+const policyVersionId = "v1"
+
+// entityError tells which user, group, role or managed policy is missing or still in use, or what input is invalid
+type entityError struct {
+	code    string
+	entity  string
+	name    string
+	message string
+}
+
+func (e *entityError) Error() string {
+	return e.code
+}
+
+func noSuchEntity(entity, name string) error {
+	return &entityError{code: iam.ErrCodeNoSuchEntityException, entity: entity, name: name}
+}
+
+func invalidInput(format string, args ...interface{}) error {
+	return &entityError{code: iam.ErrCodeInvalidInputException, message: fmt.Sprintf(format, args...)}
+}
+
+func policyArn(policyName string) string {
+	return fmt.Sprintf("arn:aws:iam:::policy/%s", policyName)
+}
+
+// policyNameFromArn parses "arn:aws:iam::<account>:policy/<path>/<name>"
+func policyNameFromArn(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+func appendName(names []string, name string) []string {
+	for _, n := range names {
+		if n == name {
+			return names
+		}
+	}
+	return append(names, name)
+}
+
+func removeName(names []string, name string) ([]string, bool) {
+	for i, n := range names {
+		if n == name {
+			return append(names[:i], names[i+1:]...), true
+		}
+	}
+	return names, false
+}
+
+func attachedPolicies(policyNames []string) (attached []*iam.AttachedPolicy) {
+	for _, name := range policyNames {
+		policyName, arn := name, policyArn(name)
+		attached = append(attached, &iam.AttachedPolicy{PolicyName: &policyName, PolicyArn: &arn})
+	}
+	return
+}
+
+// policyAttachmentCount counts the users, groups and roles with the managed policy
+func policyAttachmentCount(s3cfg *iam_pb.S3ApiConfiguration, policyName string) (count int64) {
+	has := func(policyNames []string) {
+		for _, name := range policyNames {
+			if name == policyName {
+				count++
+				return
+			}
+		}
+	}
+	for _, ident := range s3cfg.Identities {
+		has(ident.PolicyNames)
+	}
+	for _, group := range s3cfg.Groups {
+		has(group.PolicyNames)
+	}
+	for _, role := range s3cfg.Roles {
+		has(role.PolicyNames)
+	}
+	return
+}
+
+func (iama *IamApiServer) lookupPolicy(policyName string) (policyDocument PolicyDocument, err error) {
+	policies := Policies{}
+	policyLock.RLock()
+	defer policyLock.RUnlock()
+	if err = iama.s3ApiConfig.GetPolicies(&policies); err != nil {
+		return policyDocument, err
+	}
+	policyDocument, found := policies.Policies[policyName]
+	if !found {
+		return policyDocument, noSuchEntity("policy", policyName)
+	}
+	return policyDocument, nil
+}
+
+func toPolicy(s3cfg *iam_pb.S3ApiConfiguration, policyName string, policyDocument PolicyDocument) *iam.Policy {
+	document := policyDocument.String()
+	policyId := Hash(&document)
+	arn := policyArn(policyName)
+	attachmentCount := policyAttachmentCount(s3cfg, policyName)
+	versionId := policyVersionId
+	isAttachable := true
+	return &iam.Policy{
+		PolicyName:       &policyName,
+		PolicyId:         &policyId,
+		Arn:              &arn,
+		AttachmentCount:  &attachmentCount,
+		DefaultVersionId: &versionId,
+		IsAttachable:     &isAttachable,
+	}
+}
+
+func (iama *IamApiServer) GetPolicy(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp GetPolicyResponse, err error) {
+	policyName := policyNameFromArn(values.Get("PolicyArn"))
+	policyDocument, err := iama.lookupPolicy(policyName)
+	if err != nil {
+		return resp, err
+	}
+	resp.GetPolicyResult.Policy = *toPolicy(s3cfg, policyName, policyDocument)
+	return resp, nil
+}
+
+// GetPolicyVersion returns the document of the managed policy, which only has the default version
+func (iama *IamApiServer) GetPolicyVersion(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp GetPolicyVersionResponse, err error) {
+	policyName := policyNameFromArn(values.Get("PolicyArn"))
+	versionId := values.Get("VersionId")
+	policyDocument, err := iama.lookupPolicy(policyName)
+	if err != nil {
+		return resp, err
+	}
+	if versionId != policyVersionId {
+		return resp, noSuchEntity("policy version", versionId)
+	}
+	document := policyDocument.String()
+	isDefaultVersion := true
+	resp.GetPolicyVersionResult.PolicyVersion.Document = &document
+	resp.GetPolicyVersionResult.PolicyVersion.VersionId = &versionId
+	resp.GetPolicyVersionResult.PolicyVersion.IsDefaultVersion = &isDefaultVersion
+	return resp, nil
+}
+
+func (iama *IamApiServer) ListPolicies(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp ListPoliciesResponse, err error) {
+	onlyAttached := values.Get("OnlyAttached") == "true"
+	policies := Policies{}
+	policyLock.RLock()
+	err = iama.s3ApiConfig.GetPolicies(&policies)
+	policyLock.RUnlock()
+	if err != nil {
+		return resp, err
+	}
+	var policyNames []string
+	for policyName := range policies.Policies {
+		policyNames = append(policyNames, policyName)
+	}
+	sort.Strings(policyNames)
+	for _, policyName := range policyNames {
+		policy := toPolicy(s3cfg, policyName, policies.Policies[policyName])
+		if onlyAttached && *policy.AttachmentCount == 0 {
+			continue
+		}
+		resp.ListPoliciesResult.Policies = append(resp.ListPoliciesResult.Policies, policy)
+	}
+	return resp, nil
+}
+
+// DeletePolicy deletes the managed policy, after it is detached from all users, groups and roles
+func (iama *IamApiServer) DeletePolicy(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp DeletePolicyResponse, err error) {
+	policyName := policyNameFromArn(values.Get("PolicyArn"))
+	if policyAttachmentCount(s3cfg, policyName) > 0 {
+		return resp, &entityError{code: iam.ErrCodeDeleteConflictException, entity: "policy", name: policyName}
+	}
+	policies := Policies{}
+	policyLock.Lock()
+	defer policyLock.Unlock()
+	if err = iama.s3ApiConfig.GetPolicies(&policies); err != nil {
+		return resp, err
+	}
+	if _, found := policies.Policies[policyName]; !found {
+		return resp, noSuchEntity("policy", policyName)
+	}
+	delete(policies.Policies, policyName)
+	if err = iama.s3ApiConfig.PutPolicies(&policies); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
+
+func findIdentity(s3cfg *iam_pb.S3ApiConfiguration, userName string) (*iam_pb.Identity, error) {
+	for _, ident := range s3cfg.Identities {
+		if ident.Name == userName {
+			return ident, nil
+		}
+	}
+	return nil, noSuchEntity("user", userName)
+}
+
+func (iama *IamApiServer) AttachUserPolicy(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp AttachUserPolicyResponse, err error) {
+	policyName := policyNameFromArn(values.Get("PolicyArn"))
+	ident, err := findIdentity(s3cfg, values.Get("UserName"))
+	if err != nil {
+		return resp, err
+	}
+	if _, err = iama.lookupPolicy(policyName); err != nil {
+		return resp, err
+	}
+	ident.PolicyNames = appendName(ident.PolicyNames, policyName)
+	return resp, nil
+}
+
+func (iama *IamApiServer) DetachUserPolicy(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp DetachUserPolicyResponse, err error) {
+	policyName := policyNameFromArn(values.Get("PolicyArn"))
+	ident, err := findIdentity(s3cfg, values.Get("UserName"))
+	if err != nil {
+		return resp, err
+	}
+	var found bool
+	if ident.PolicyNames, found = removeName(ident.PolicyNames, policyName); !found {
+		return resp, noSuchEntity("attached policy", policyName)
+	}
+	return resp, nil
+}
+
+func (iama *IamApiServer) ListAttachedUserPolicies(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp ListAttachedUserPoliciesResponse, err error) {
+	ident, err := findIdentity(s3cfg, values.Get("UserName"))
+	if err != nil {
+		return resp, err
+	}
+	resp.ListAttachedUserPoliciesResult.AttachedPolicies = attachedPolicies(ident.PolicyNames)
+	return resp, nil
+}
+
+func (iama *IamApiServer) AttachGroupPolicy(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp AttachGroupPolicyResponse, err error) {
+	policyName := policyNameFromArn(values.Get("PolicyArn"))
+	group, err := findGroup(s3cfg, values.Get("GroupName"))
+	if err != nil {
+		return resp, err
+	}
+	if _, err = iama.lookupPolicy(policyName); err != nil {
+		return resp, err
+	}
+	group.PolicyNames = appendName(group.PolicyNames, policyName)
+	return resp, nil
+}
+
+func (iama *IamApiServer) DetachGroupPolicy(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp DetachGroupPolicyResponse, err error) {
+	policyName := policyNameFromArn(values.Get("PolicyArn"))
+	group, err := findGroup(s3cfg, values.Get("GroupName"))
+	if err != nil {
+		return resp, err
+	}
+	var found bool
+	if group.PolicyNames, found = removeName(group.PolicyNames, policyName); !found {
+		return resp, noSuchEntity("attached policy", policyName)
+	}
+	return resp, nil
+}
+
+func (iama *IamApiServer) ListAttachedGroupPolicies(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp ListAttachedGroupPoliciesResponse, err error) {
+	group, err := findGroup(s3cfg, values.Get("GroupName"))
+	if err != nil {
+		return resp, err
+	}
+	resp.ListAttachedGroupPoliciesResult.AttachedPolicies = attachedPolicies(group.PolicyNames)
+	return resp, nil
+}
+
+func (iama *IamApiServer) AttachRolePolicy(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp AttachRolePolicyResponse, err error) {
+	policyName := policyNameFromArn(values.Get("PolicyArn"))
+	role, err := findRole(s3cfg, values.Get("RoleName"))
+	if err != nil {
+		return resp, err
+	}
+	if _, err = iama.lookupPolicy(policyName); err != nil {
+		return resp, err
+	}
+	role.PolicyNames = appendName(role.PolicyNames, policyName)
+	return resp, nil
+}
+
+func (iama *IamApiServer) DetachRolePolicy(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp DetachRolePolicyResponse, err error) {
+	policyName := policyNameFromArn(values.Get("PolicyArn"))
+	role, err := findRole(s3cfg, values.Get("RoleName"))
+	if err != nil {
+		return resp, err
+	}
+	var found bool
+	if role.PolicyNames, found = removeName(role.PolicyNames, policyName); !found {
+		return resp, noSuchEntity("attached policy", policyName)
+	}
+	return resp, nil
+}
+
+func (iama *IamApiServer) ListAttachedRolePolicies(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp ListAttachedRolePoliciesResponse, err error) {
+	role, err := findRole(s3cfg, values.Get("RoleName"))
+	if err != nil {
+		return resp, err
+	}
+	resp.ListAttachedRolePoliciesResult.AttachedPolicies = attachedPolicies(role.PolicyNames)
+	return resp, nil
+}
+
+// UpdateAccessKey activates or deactivates the access key, the inactive keys are rejected by the s3 gateways
+func (iama *IamApiServer) UpdateAccessKey(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp UpdateAccessKeyResponse, err error) {
+	userName := values.Get("UserName")
+	accessKeyId := values.Get("AccessKeyId")
+	status := values.Get("Status")
+	if status != iam.StatusTypeActive && status != iam.StatusTypeInactive {
+		return resp, invalidInput("Status should be %s or %s", iam.StatusTypeActive, iam.StatusTypeInactive)
+	}
+	for _, ident := range s3cfg.Identities {
+		if userName != "" && userName != ident.Name {
+			continue
+		}
+		for _, cred := range ident.Credentials {
+			if cred.AccessKey == accessKeyId {
+				cred.IsDisabled = status == iam.StatusTypeInactive
+				return resp, nil
+			}
+		}
+	}
+	return resp, noSuchEntity("access key", accessKeyId)
+}
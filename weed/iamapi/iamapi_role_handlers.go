@@ -0,0 +1,206 @@
+package iamapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+	"github.com/chrislusf/seaweedfs/weed/s3api/bucketpolicy"
+)
+
+const (
+	minRoleSessionDuration = 3600
+	maxRoleSessionDuration = 43200
+)
+
+// TrustPolicyDocument is the AssumeRolePolicyDocument of a role. It allows the users in the AWS principals
+// to call AssumeRole, and the tokens of the web identity providers in the Federated principals,
+// optionally limited by the "sub" conditions, to call AssumeRoleWithWebIdentity.
+type TrustPolicyDocument struct {
+	Version   string            `json:"Version"`
+	Statement []*TrustStatement `json:"Statement"`
+}
+
+type TrustStatement struct {
+	Effect    string                                       `json:"Effect"`
+	Principal TrustPrincipal                               `json:"Principal"`
+	Action    bucketpolicy.StringSet                       `json:"Action"`
+	Condition map[string]map[string]bucketpolicy.StringSet `json:"Condition,omitempty"`
+}
+
+type TrustPrincipal struct {
+	AWS       bucketpolicy.StringSet `json:"AWS,omitempty"`
+	Federated bucketpolicy.StringSet `json:"Federated,omitempty"`
+}
+
+func (p *TrustPrincipal) UnmarshalJSON(data []byte) error {
+	var wildcard string
+	if err := json.Unmarshal(data, &wildcard); err == nil {
+		if wildcard != "*" {
+			return fmt.Errorf("invalid principal %s", wildcard)
+		}
+		p.AWS = bucketpolicy.StringSet{"*"}
+		return nil
+	}
+	type principal TrustPrincipal
+	return json.Unmarshal(data, (*principal)(p))
+}
+
+// parseTrustPolicy sets the trusted identities, providers and subjects of the role
+func parseTrustPolicy(document string, role *iam_pb.Role) error {
+	trust := &TrustPolicyDocument{}
+	if err := json.Unmarshal([]byte(document), trust); err != nil {
+		return invalidInput("AssumeRolePolicyDocument: %v", err)
+	}
+	var identities, providers, subjects []string
+	for _, statement := range trust.Statement {
+		if statement.Effect != "Allow" {
+			return invalidInput("AssumeRolePolicyDocument: only Allow statements are supported")
+		}
+		for _, principal := range statement.Principal.AWS {
+			// "arn:aws:iam::<account>:user/<name>", or any user of the account
+			if i := strings.LastIndex(principal, ":user/"); i >= 0 {
+				principal = principal[i+len(":user/"):]
+			} else if strings.HasSuffix(principal, ":root") {
+				principal = "*"
+			}
+			identities = appendName(identities, principal)
+		}
+		for _, principal := range statement.Principal.Federated {
+			// "arn:aws:iam::<account>:oidc-provider/<name>"
+			if i := strings.Index(principal, ":oidc-provider/"); i >= 0 {
+				principal = principal[i+len(":oidc-provider/"):]
+			}
+			providers = appendName(providers, principal)
+		}
+		for operator, conditions := range statement.Condition {
+			for key, values := range conditions {
+				if (operator != "StringEquals" && operator != "StringLike") || (key != "sub" && !strings.HasSuffix(key, ":sub")) {
+					return invalidInput("AssumeRolePolicyDocument: condition %s %s is not supported", operator, key)
+				}
+				for _, value := range values {
+					subjects = appendName(subjects, value)
+				}
+			}
+		}
+	}
+	if len(identities) == 0 && len(providers) == 0 {
+		return invalidInput("AssumeRolePolicyDocument: no principal is trusted")
+	}
+	role.TrustedIdentities, role.TrustedProviders, role.TrustedSubjects = identities, providers, subjects
+	return nil
+}
+
+func trustPolicyDocument(role *iam_pb.Role) string {
+	trust := TrustPolicyDocument{Version: policyDocumentVersion}
+	if len(role.TrustedIdentities) > 0 {
+		statement := &TrustStatement{Effect: "Allow", Action: bucketpolicy.StringSet{"sts:AssumeRole"}}
+		for _, identity := range role.TrustedIdentities {
+			if identity != "*" {
+				identity = fmt.Sprintf("arn:aws:iam:::user/%s", identity)
+			}
+			statement.Principal.AWS = append(statement.Principal.AWS, identity)
+		}
+		trust.Statement = append(trust.Statement, statement)
+	}
+	if len(role.TrustedProviders) > 0 {
+		statement := &TrustStatement{Effect: "Allow", Action: bucketpolicy.StringSet{"sts:AssumeRoleWithWebIdentity"}}
+		for _, provider := range role.TrustedProviders {
+			statement.Principal.Federated = append(statement.Principal.Federated, fmt.Sprintf("arn:aws:iam:::oidc-provider/%s", provider))
+		}
+		if len(role.TrustedSubjects) > 0 {
+			statement.Condition = map[string]map[string]bucketpolicy.StringSet{
+				"StringLike": {"sub": role.TrustedSubjects},
+			}
+		}
+		trust.Statement = append(trust.Statement, statement)
+	}
+	b, _ := json.Marshal(trust)
+	return string(b)
+}
+
+func findRole(s3cfg *iam_pb.S3ApiConfiguration, roleName string) (*iam_pb.Role, error) {
+	for _, role := range s3cfg.Roles {
+		if role.Name == roleName {
+			return role, nil
+		}
+	}
+	return nil, noSuchEntity("role", roleName)
+}
+
+func toRole(role *iam_pb.Role) *iam.Role {
+	roleName := role.Name
+	arn := fmt.Sprintf("arn:aws:iam:::role/%s", role.Name)
+	document := trustPolicyDocument(role)
+	maxSessionDuration := role.MaxSessionDurationSeconds
+	if maxSessionDuration == 0 {
+		maxSessionDuration = minRoleSessionDuration
+	}
+	return &iam.Role{
+		RoleName:                 &roleName,
+		RoleId:                   &roleName,
+		Arn:                      &arn,
+		AssumeRolePolicyDocument: &document,
+		MaxSessionDuration:       &maxSessionDuration,
+	}
+}
+
+func (iama *IamApiServer) CreateRole(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp CreateRoleResponse, err error) {
+	roleName := values.Get("RoleName")
+	if roleName == "" {
+		return resp, invalidInput("RoleName is required")
+	}
+	if _, err = findRole(s3cfg, roleName); err == nil {
+		return resp, &entityError{code: iam.ErrCodeEntityAlreadyExistsException, entity: "role", name: roleName}
+	}
+	role := &iam_pb.Role{Name: roleName}
+	if err = parseTrustPolicy(values.Get("AssumeRolePolicyDocument"), role); err != nil {
+		return resp, err
+	}
+	if v := values.Get("MaxSessionDuration"); v != "" {
+		seconds, parseErr := strconv.ParseInt(v, 10, 64)
+		if parseErr != nil || seconds < minRoleSessionDuration || seconds > maxRoleSessionDuration {
+			return resp, invalidInput("MaxSessionDuration should be between %d and %d seconds", minRoleSessionDuration, maxRoleSessionDuration)
+		}
+		role.MaxSessionDurationSeconds = seconds
+	}
+	s3cfg.Roles = append(s3cfg.Roles, role)
+	resp.CreateRoleResult.Role = *toRole(role)
+	return resp, nil
+}
+
+func (iama *IamApiServer) GetRole(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp GetRoleResponse, err error) {
+	role, err := findRole(s3cfg, values.Get("RoleName"))
+	if err != nil {
+		return resp, err
+	}
+	resp.GetRoleResult.Role = *toRole(role)
+	return resp, nil
+}
+
+func (iama *IamApiServer) ListRoles(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp ListRolesResponse) {
+	for _, role := range s3cfg.Roles {
+		resp.ListRolesResult.Roles = append(resp.ListRolesResult.Roles, toRole(role))
+	}
+	return resp
+}
+
+// DeleteRole deletes the role after all policies are detached, which also ends its sessions
+func (iama *IamApiServer) DeleteRole(s3cfg *iam_pb.S3ApiConfiguration, values url.Values) (resp DeleteRoleResponse, err error) {
+	roleName := values.Get("RoleName")
+	for i, role := range s3cfg.Roles {
+		if role.Name != roleName {
+			continue
+		}
+		if len(role.PolicyNames) > 0 {
+			return resp, &entityError{code: iam.ErrCodeDeleteConflictException, entity: "role", name: roleName}
+		}
+		s3cfg.Roles = append(s3cfg.Roles[:i], s3cfg.Roles[i+1:]...)
+		return resp, nil
+	}
+	return resp, noSuchEntity("role", roleName)
+}
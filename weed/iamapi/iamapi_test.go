@@ -3,6 +3,7 @@ package iamapi
 import (
 	"encoding/xml"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 )
 
@@ -27,11 +29,15 @@ type iamS3ApiConfigureMock struct{}
 
 func (iam iamS3ApiConfigureMock) GetS3ApiConfiguration(s3cfg *iam_pb.S3ApiConfiguration) (err error) {
 	_ = copier.Copy(&s3cfg.Identities, &s3config.Identities)
+	_ = copier.Copy(&s3cfg.Groups, &s3config.Groups)
+	_ = copier.Copy(&s3cfg.Roles, &s3config.Roles)
 	return nil
 }
 
 func (iam iamS3ApiConfigureMock) PutS3ApiConfiguration(s3cfg *iam_pb.S3ApiConfiguration) (err error) {
 	_ = copier.Copy(&s3config.Identities, &s3cfg.Identities)
+	_ = copier.Copy(&s3config.Groups, &s3cfg.Groups)
+	_ = copier.Copy(&s3config.Roles, &s3cfg.Roles)
 	return nil
 }
 
@@ -179,3 +185,138 @@ func executeRequest(req *http.Request, v interface{}) (*httptest.ResponseRecorde
 	apiRouter.ServeHTTP(rr, req)
 	return rr, xml.Unmarshal(rr.Body.Bytes(), &v)
 }
+
+func TestCreatePresignedUrl(t *testing.T) {
+	s3cfg := &iam_pb.S3ApiConfiguration{Identities: []*iam_pb.Identity{{
+		Name:        "uploader",
+		Credentials: []*iam_pb.Credential{{AccessKey: "AK", SecretKey: "SK"}},
+	}}}
+	values := url.Values{
+		"UserName":  []string{"uploader"},
+		"Endpoint":  []string{"http://localhost:8333"},
+		"Method":    []string{"PUT"},
+		"Bucket":    []string{"videos"},
+		"Key":       []string{"a.mp4"},
+		"UploadId":  []string{"abc"},
+		"PartCount": []string{"3"},
+	}
+	resp, err := ias.CreatePresignedUrl(s3cfg, values)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, len(resp.CreatePresignedUrlResult.Urls))
+	assert.Equal(t, 3, resp.CreatePresignedUrlResult.Urls[2].PartNumber)
+	assert.Contains(t, resp.CreatePresignedUrlResult.Urls[2].Url, "partNumber=3")
+
+	values.Set("UploadId", "")
+	_, err = ias.CreatePresignedUrl(s3cfg, values)
+	assert.NotEqual(t, nil, err)
+
+	values.Set("UserName", "nobody")
+	_, err = ias.CreatePresignedUrl(s3cfg, values)
+	assert.Equal(t, iam.ErrCodeNoSuchEntityException, err.Error())
+}
+
+func TestManagedPolicies(t *testing.T) {
+	svc := iam.New(session.New())
+	execute := func(req *request.Request, out interface{}) *httptest.ResponseRecorder {
+		_ = req.Build()
+		response, _ := executeRequest(req.HTTPRequest, out)
+		return response
+	}
+
+	req, _ := svc.CreateUserRequest(&iam.CreateUserInput{UserName: aws.String("tenant1")})
+	assert.Equal(t, http.StatusOK, execute(req, CreateUserResponse{}).Code)
+
+	req, _ = svc.CreatePolicyRequest(&iam.CreatePolicyInput{
+		PolicyName:     aws.String("tenant-bucket"),
+		PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::${aws:username}/*"}]}`),
+	})
+	assert.Equal(t, http.StatusOK, execute(req, CreatePolicyResponse{}).Code)
+	policyArn := aws.String("arn:aws:iam:::policy/tenant-bucket")
+
+	req, _ = svc.CreateGroupRequest(&iam.CreateGroupInput{GroupName: aws.String("tenants")})
+	assert.Equal(t, http.StatusOK, execute(req, CreateGroupResponse{}).Code)
+	req, _ = svc.CreateGroupRequest(&iam.CreateGroupInput{GroupName: aws.String("tenants")})
+	assert.Equal(t, http.StatusConflict, execute(req, ErrorResponse{}).Code)
+
+	req, _ = svc.AddUserToGroupRequest(&iam.AddUserToGroupInput{GroupName: aws.String("tenants"), UserName: aws.String("tenant1")})
+	assert.Equal(t, http.StatusOK, execute(req, AddUserToGroupResponse{}).Code)
+	req, _ = svc.AddUserToGroupRequest(&iam.AddUserToGroupInput{GroupName: aws.String("tenants"), UserName: aws.String("nobody")})
+	assert.Equal(t, http.StatusNotFound, execute(req, ErrorResponse{}).Code)
+
+	req, _ = svc.AttachGroupPolicyRequest(&iam.AttachGroupPolicyInput{GroupName: aws.String("tenants"), PolicyArn: policyArn})
+	assert.Equal(t, http.StatusOK, execute(req, AttachGroupPolicyResponse{}).Code)
+	assert.Equal(t, []string{"tenant1"}, s3config.Groups[0].Members)
+	assert.Equal(t, []string{"tenant-bucket"}, s3config.Groups[0].PolicyNames)
+
+	listPolicies := ListPoliciesResponse{}
+	req, _ = svc.ListPoliciesRequest(&iam.ListPoliciesInput{})
+	_ = req.Build()
+	_, err := executeRequest(req.HTTPRequest, &listPolicies)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, 0, len(listPolicies.ListPoliciesResult.Policies))
+	for _, policy := range listPolicies.ListPoliciesResult.Policies {
+		if *policy.PolicyName == "tenant-bucket" {
+			assert.Equal(t, int64(1), *policy.AttachmentCount)
+		}
+	}
+
+	// attached policies can not be deleted
+	req, _ = svc.DeletePolicyRequest(&iam.DeletePolicyInput{PolicyArn: policyArn})
+	assert.Equal(t, http.StatusConflict, execute(req, ErrorResponse{}).Code)
+	req, _ = svc.DetachGroupPolicyRequest(&iam.DetachGroupPolicyInput{GroupName: aws.String("tenants"), PolicyArn: policyArn})
+	assert.Equal(t, http.StatusOK, execute(req, DetachGroupPolicyResponse{}).Code)
+	req, _ = svc.DeletePolicyRequest(&iam.DeletePolicyInput{PolicyArn: policyArn})
+	assert.Equal(t, http.StatusOK, execute(req, DeletePolicyResponse{}).Code)
+	req, _ = svc.GetPolicyRequest(&iam.GetPolicyInput{PolicyArn: policyArn})
+	assert.Equal(t, http.StatusNotFound, execute(req, ErrorResponse{}).Code)
+
+	req, _ = svc.DeleteUserRequest(&iam.DeleteUserInput{UserName: aws.String("tenant1")})
+	assert.Equal(t, http.StatusOK, execute(req, DeleteUserResponse{}).Code)
+	assert.Equal(t, 0, len(s3config.Groups[0].Members))
+	req, _ = svc.DeleteGroupRequest(&iam.DeleteGroupInput{GroupName: aws.String("tenants")})
+	assert.Equal(t, http.StatusOK, execute(req, DeleteGroupResponse{}).Code)
+}
+
+func TestCreateRole(t *testing.T) {
+	svc := iam.New(session.New())
+	req, _ := svc.CreateRoleRequest(&iam.CreateRoleInput{
+		RoleName: aws.String("deployer"),
+		AssumeRolePolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":[
+			{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::000000000000:user/ci"},"Action":"sts:AssumeRole"},
+			{"Effect":"Allow","Principal":{"Federated":"arn:aws:iam::000000000000:oidc-provider/github"},"Action":"sts:AssumeRoleWithWebIdentity",
+			 "Condition":{"StringLike":{"token.actions.githubusercontent.com:sub":"repo:org/*"}}}]}`),
+		MaxSessionDuration: aws.Int64(7200),
+	})
+	_ = req.Build()
+	out := CreateRoleResponse{}
+	response, err := executeRequest(req.HTTPRequest, out)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	role := s3config.Roles[len(s3config.Roles)-1]
+	assert.Equal(t, []string{"ci"}, role.TrustedIdentities)
+	assert.Equal(t, []string{"github"}, role.TrustedProviders)
+	assert.Equal(t, []string{"repo:org/*"}, role.TrustedSubjects)
+	assert.Equal(t, int64(7200), role.MaxSessionDurationSeconds)
+
+	req, _ = svc.DeleteRoleRequest(&iam.DeleteRoleInput{RoleName: aws.String("deployer")})
+	_ = req.Build()
+	response, _ = executeRequest(req.HTTPRequest, DeleteRoleResponse{})
+	assert.Equal(t, http.StatusOK, response.Code)
+}
+
+func TestUpdateAccessKey(t *testing.T) {
+	s3cfg := &iam_pb.S3ApiConfiguration{Identities: []*iam_pb.Identity{{
+		Name:        "tenant1",
+		Credentials: []*iam_pb.Credential{{AccessKey: "AK", SecretKey: "SK"}},
+	}}}
+	values := url.Values{"UserName": []string{"tenant1"}, "AccessKeyId": []string{"AK"}, "Status": []string{"Inactive"}}
+	_, err := ias.UpdateAccessKey(s3cfg, values)
+	assert.Equal(t, nil, err)
+	assert.True(t, s3cfg.Identities[0].Credentials[0].IsDisabled)
+	assert.Equal(t, iam.StatusTypeInactive, *ias.ListAccessKeys(s3cfg, values).ListAccessKeysResult.AccessKeyMetadata[0].Status)
+
+	values.Set("Status", "Unknown")
+	_, err = ias.UpdateAccessKey(s3cfg, values)
+	assert.Equal(t, iam.ErrCodeInvalidInputException, err.Error())
+}
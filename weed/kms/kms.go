@@ -0,0 +1,54 @@
+package kms
+
+import (
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// KeyManagementService keeps the master key of the S3 server side encryption.
+// Each chunk is encrypted with its own data key, and the data key is only saved
+// in the filer metadata after being encrypted by the master key.
+type KeyManagementService interface {
+	// GetName gets the name to locate the configuration in security.toml file
+	GetName() string
+	// Initialize initializes the key management service
+	Initialize(configuration util.Configuration, prefix string) error
+	// Encrypt encrypts a data key with the master key
+	Encrypt(dataKey []byte) (encryptedDataKey []byte, err error)
+	// Decrypt decrypts a data key encrypted by Encrypt
+	Decrypt(encryptedDataKey []byte) (dataKey []byte, err error)
+}
+
+var (
+	KeyManagementServices []KeyManagementService
+)
+
+// LoadConfiguration returns the enabled key management service, or nil if none is enabled.
+func LoadConfiguration(config *util.ViperProxy, prefix string) KeyManagementService {
+
+	if config == nil {
+		return nil
+	}
+
+	enabled := ""
+	for _, service := range KeyManagementServices {
+		if config.GetBool(prefix + service.GetName() + ".enabled") {
+			if enabled != "" {
+				glog.Fatalf("Key management service is enabled for both %s and %s", enabled, service.GetName())
+			}
+			enabled = service.GetName()
+		}
+	}
+
+	for _, service := range KeyManagementServices {
+		if service.GetName() == enabled {
+			if err := service.Initialize(config, prefix+service.GetName()+"."); err != nil {
+				glog.Fatalf("Failed to initialize key management service %s: %+v", service.GetName(), err)
+			}
+			glog.V(0).Infof("Configure key management service %s", service.GetName())
+			return service
+		}
+	}
+
+	return nil
+}
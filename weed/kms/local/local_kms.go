@@ -0,0 +1,56 @@
+package local
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/chrislusf/seaweedfs/weed/kms"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	kms.KeyManagementServices = append(kms.KeyManagementServices, &LocalKms{})
+}
+
+// LocalKms reads the master key from a local file.
+// It is a stand-in for an external key management service, which keeps the master key away from the filer.
+type LocalKms struct {
+	masterKey util.CipherKey
+}
+
+func (k *LocalKms) GetName() string {
+	return "local"
+}
+
+func (k *LocalKms) Initialize(configuration util.Configuration, prefix string) (err error) {
+	return k.initialize(configuration.GetString(prefix + "key_file"))
+}
+
+func (k *LocalKms) initialize(keyFile string) error {
+	if keyFile == "" {
+		return fmt.Errorf("missing key_file")
+	}
+	data, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return fmt.Errorf("read key file %s: %v", keyFile, err)
+	}
+	masterKey, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(data)))
+	if err != nil {
+		return fmt.Errorf("decode key file %s: %v", keyFile, err)
+	}
+	if len(masterKey) != 32 {
+		return fmt.Errorf("key file %s should have a base64 encoded 256 bit key, but has %d bytes", keyFile, len(masterKey))
+	}
+	k.masterKey = masterKey
+	return nil
+}
+
+func (k *LocalKms) Encrypt(dataKey []byte) ([]byte, error) {
+	return util.Encrypt(dataKey, k.masterKey)
+}
+
+func (k *LocalKms) Decrypt(encryptedDataKey []byte) ([]byte, error) {
+	return util.Decrypt(encryptedDataKey, k.masterKey)
+}
@@ -0,0 +1,44 @@
+package local
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalKms(t *testing.T) {
+
+	dir, err := ioutil.TempDir("", "kms")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	keyFile := filepath.Join(dir, "master.key")
+	assert.Nil(t, ioutil.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(util.GenCipherKey())+"\n"), 0600))
+
+	k := &LocalKms{}
+	assert.Nil(t, k.initialize(keyFile))
+
+	dataKey := util.GenCipherKey()
+	encrypted, err := k.Encrypt(dataKey)
+	assert.Nil(t, err)
+	assert.NotEqual(t, []byte(dataKey), encrypted)
+
+	decrypted, err := k.Decrypt(encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(dataKey), decrypted)
+
+	other := &LocalKms{}
+	assert.Nil(t, ioutil.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(util.GenCipherKey())), 0600))
+	assert.Nil(t, other.initialize(keyFile))
+	_, err = other.Decrypt(encrypted)
+	assert.NotNil(t, err)
+
+	assert.Nil(t, ioutil.WriteFile(keyFile, []byte("c2hvcnQ="), 0600))
+	assert.NotNil(t, other.initialize(keyFile))
+
+}
@@ -0,0 +1,155 @@
+package csv
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/query/sql"
+)
+
+// Options follows the CSV input and output serialization of S3 select
+type Options struct {
+	FieldDelimiter       string // Default: ,
+	QuoteCharacter       string // Default: "
+	QuoteEscapeCharacter string // Default: "
+	Comments             string // Default: #, for the input only
+	QuoteFields          string // ALWAYS | ASNEEDED, for the output only
+}
+
+func (o Options) fieldDelimiter() string {
+	if o.FieldDelimiter == "" {
+		return ","
+	}
+	return o.FieldDelimiter
+}
+
+func (o Options) quote() string {
+	if o.QuoteCharacter == "" {
+		return `"`
+	}
+	return o.QuoteCharacter
+}
+
+func (o Options) quoteEscape() string {
+	if o.QuoteEscapeCharacter == "" {
+		return o.quote()
+	}
+	return o.QuoteEscapeCharacter
+}
+
+// IsComment checks whether the line should be skipped
+func (o Options) IsComment(line string) bool {
+	comments := o.Comments
+	if comments == "" {
+		comments = "#"
+	}
+	return strings.HasPrefix(line, comments)
+}
+
+// SplitFields splits one line into fields, removing the quotes
+func (o Options) SplitFields(line string) (fields []string) {
+	delimiter, quote, escape := o.fieldDelimiter(), o.quote(), o.quoteEscape()
+	var field strings.Builder
+	inQuotes := false
+	for i := 0; i < len(line); {
+		switch {
+		case inQuotes && strings.HasPrefix(line[i:], escape) && strings.HasPrefix(line[i+len(escape):], quote):
+			field.WriteString(quote)
+			i += len(escape) + len(quote)
+		case strings.HasPrefix(line[i:], quote):
+			inQuotes = !inQuotes
+			i += len(quote)
+		case !inQuotes && strings.HasPrefix(line[i:], delimiter):
+			fields = append(fields, field.String())
+			field.Reset()
+			i += len(delimiter)
+		default:
+			field.WriteByte(line[i])
+			i++
+		}
+	}
+	return append(fields, field.String())
+}
+
+// QuotesBalanced checks whether the line ends outside of quotes,
+// otherwise the record continues after a quoted record delimiter
+func (o Options) QuotesBalanced(line string) bool {
+	quote, escape := o.quote(), o.quoteEscape()
+	inQuotes := false
+	for i := 0; i < len(line); {
+		switch {
+		case inQuotes && escape != quote && strings.HasPrefix(line[i:], escape) && strings.HasPrefix(line[i+len(escape):], quote):
+			i += len(escape) + len(quote)
+		case strings.HasPrefix(line[i:], quote):
+			inQuotes = !inQuotes
+			i += len(quote)
+		default:
+			i++
+		}
+	}
+	return !inQuotes
+}
+
+// AppendRecord writes the values as one CSV line, without the record delimiter
+func (o Options) AppendRecord(buf []byte, values []sql.Value) []byte {
+	delimiter, quote, escape := o.fieldDelimiter(), o.quote(), o.quoteEscape()
+	for i, value := range values {
+		if i > 0 {
+			buf = append(buf, delimiter...)
+		}
+		s := value.String()
+		if o.QuoteFields == "ALWAYS" || strings.Contains(s, delimiter) || strings.Contains(s, quote) || strings.ContainsAny(s, "\r\n") {
+			buf = append(buf, quote...)
+			buf = append(buf, strings.ReplaceAll(s, quote, escape+quote)...)
+			buf = append(buf, quote...)
+		} else {
+			buf = append(buf, s...)
+		}
+	}
+	return buf
+}
+
+// Record is one CSV line, with the column names from the header line if the header is used
+type Record struct {
+	names  []string
+	fields []string
+}
+
+func NewRecord(names []string, fields []string) *Record {
+	return &Record{names: names, fields: fields}
+}
+
+func (r *Record) Get(path []string) (sql.Value, bool) {
+	if len(path) != 1 {
+		return sql.NullValue(), false
+	}
+	name := path[0]
+	for i, n := range r.names {
+		if n == name && i < len(r.fields) {
+			return sql.StringValue(r.fields[i]), true
+		}
+	}
+	for i, n := range r.names {
+		if strings.EqualFold(n, name) && i < len(r.fields) {
+			return sql.StringValue(r.fields[i]), true
+		}
+	}
+	if strings.HasPrefix(name, "_") {
+		if index, err := strconv.Atoi(name[1:]); err == nil && index > 0 && index <= len(r.fields) {
+			return sql.StringValue(r.fields[index-1]), true
+		}
+	}
+	return sql.NullValue(), false
+}
+
+func (r *Record) Fields() (names []string, values []sql.Value) {
+	for i, field := range r.fields {
+		if i < len(r.names) {
+			names = append(names, r.names[i])
+		} else {
+			names = append(names, "_"+strconv.Itoa(i+1))
+		}
+		values = append(values, sql.StringValue(field))
+	}
+	return
+}
@@ -0,0 +1,91 @@
+package json
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/query/sql"
+	"github.com/tidwall/gjson"
+)
+
+// Record is one JSON object, for the SQL select statements
+type Record struct {
+	raw string
+}
+
+func NewRecord(raw string) *Record {
+	return &Record{raw: raw}
+}
+
+func (r *Record) Get(path []string) (sql.Value, bool) {
+	var parts []string
+	for _, p := range path {
+		parts = append(parts, escapePath(p))
+	}
+	result := gjson.Get(r.raw, strings.Join(parts, "."))
+	if !result.Exists() {
+		return sql.NullValue(), false
+	}
+	return toValue(result), true
+}
+
+func (r *Record) Fields() (names []string, values []sql.Value) {
+	gjson.Parse(r.raw).ForEach(func(key, value gjson.Result) bool {
+		names = append(names, key.String())
+		values = append(values, toValue(value))
+		return true
+	})
+	return
+}
+
+func toValue(result gjson.Result) sql.Value {
+	switch result.Type {
+	case gjson.String:
+		return sql.StringValue(result.Str)
+	case gjson.Number:
+		return sql.NumberValue(result.Num)
+	case gjson.True:
+		return sql.BoolValue(true)
+	case gjson.False:
+		return sql.BoolValue(false)
+	case gjson.JSON:
+		return sql.RawValue(result.Raw)
+	}
+	return sql.NullValue()
+}
+
+// escapePath escapes the gjson path characters in a field name
+func escapePath(name string) string {
+	var sb strings.Builder
+	for _, c := range name {
+		switch c {
+		case '.', '*', '?', '|', '#', '@', '\\':
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// AppendRecord writes the values as one JSON object, without the record delimiter
+func AppendRecord(buf []byte, names []string, values []sql.Value) []byte {
+	buf = append(buf, '{')
+	for i, value := range values {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		name, _ := json.Marshal(names[i])
+		buf = append(buf, name...)
+		buf = append(buf, ':')
+		switch value.Type {
+		case sql.Null:
+			buf = append(buf, "null"...)
+		case sql.String:
+			s, _ := json.Marshal(value.Str)
+			buf = append(buf, s...)
+		default:
+			buf = append(buf, value.String()...)
+		}
+	}
+	return append(buf, '}')
+}
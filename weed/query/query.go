@@ -0,0 +1,216 @@
+package query
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/query/csv"
+	query_json "github.com/chrislusf/seaweedfs/weed/query/json"
+	"github.com/chrislusf/seaweedfs/weed/query/sql"
+)
+
+// the size of the matched records sent in one stripe
+const stripeSize = 1024 * 1024
+
+// Input reads the records of the CSV or JSON input serialization.
+// It is shared by the volume servers filtering the records of each chunk,
+// and the S3 gateway joining the partial records across the chunks.
+type Input struct {
+	csv             *csv.Options
+	jsonDocument    bool
+	quotedDelimiter bool
+	recordDelimiter []byte
+	// the first line is the header, which is IGNORE or USE
+	hasHeader   bool
+	useHeader   bool
+	headerRead  bool
+	columnNames []string
+}
+
+func NewInput(serialization *volume_server_pb.QueryRequest_InputSerialization) (*Input, error) {
+	if serialization == nil {
+		return nil, fmt.Errorf("missing input serialization")
+	}
+	in := &Input{recordDelimiter: []byte("\n")}
+	if csvInput := serialization.CsvInput; csvInput != nil {
+		in.csv = &csv.Options{
+			FieldDelimiter:       csvInput.FieldDelimiter,
+			QuoteCharacter:       csvInput.QuoteCharactoer,
+			QuoteEscapeCharacter: csvInput.QuoteEscapeCharacter,
+			Comments:             csvInput.Comments,
+		}
+		if csvInput.RecordDelimiter != "" {
+			in.recordDelimiter = []byte(csvInput.RecordDelimiter)
+		}
+		in.quotedDelimiter = csvInput.AllowQuotedRecordDelimiter
+		switch strings.ToUpper(csvInput.FileHeaderInfo) {
+		case "", "NONE":
+		case "USE":
+			in.hasHeader, in.useHeader = true, true
+		case "IGNORE":
+			in.hasHeader = true
+		default:
+			return nil, fmt.Errorf("invalid FileHeaderInfo %s", csvInput.FileHeaderInfo)
+		}
+		if len(csvInput.ColumnNames) > 0 {
+			in.columnNames, in.headerRead = csvInput.ColumnNames, true
+		}
+		return in, nil
+	}
+	if jsonInput := serialization.JsonInput; jsonInput != nil {
+		switch strings.ToUpper(jsonInput.Type) {
+		case "", "LINES":
+		case "DOCUMENT":
+			in.jsonDocument = true
+		default:
+			return nil, fmt.Errorf("invalid JSON type %s", jsonInput.Type)
+		}
+		return in, nil
+	}
+	return nil, fmt.Errorf("only CSV and JSON inputs are supported")
+}
+
+// Splittable checks whether the records can be found by the record delimiter alone,
+// which is needed to filter the chunks separately
+func (in *Input) Splittable() bool {
+	return !in.jsonDocument && !in.quotedDelimiter
+}
+
+// NeedsHeader checks whether the first line of the data is the CSV header
+func (in *Input) NeedsHeader() bool {
+	return in.hasHeader && !in.headerRead
+}
+
+// ReadHeader reads the CSV header line, without the record delimiter
+func (in *Input) ReadHeader(line []byte) {
+	in.headerRead = true
+	if in.useHeader {
+		in.columnNames = in.csv.SplitFields(strings.TrimSuffix(string(line), "\r"))
+	}
+}
+
+func (in *Input) ColumnNames() []string {
+	return in.columnNames
+}
+
+func (in *Input) RecordDelimiter() []byte {
+	return in.recordDelimiter
+}
+
+// ParseRecord parses one record without the record delimiter, or returns nil for empty and comment lines
+func (in *Input) ParseRecord(raw []byte) sql.Record {
+	line := string(raw)
+	if in.csv == nil {
+		if strings.TrimSpace(line) == "" {
+			return nil
+		}
+		return query_json.NewRecord(line)
+	}
+	if string(in.recordDelimiter) == "\n" {
+		line = strings.TrimSuffix(line, "\r")
+	}
+	if line == "" || in.csv.IsComment(line) {
+		return nil
+	}
+	return csv.NewRecord(in.columnNames, in.csv.SplitFields(line))
+}
+
+// FilterRecords returns the records in the data matching the where clause, in stripes.
+// The first stripe has the head, which is the rest of the partial record if partialHead is set,
+// or the CSV header line at the beginning of the file. The last stripe has the tail after the last record delimiter.
+func (in *Input) FilterRecords(data []byte, partialHead bool, where sql.Expr, fn func(stripe *volume_server_pb.QueriedStripe) error) error {
+
+	stripe := &volume_server_pb.QueriedStripe{}
+	delimiter := in.recordDelimiter
+
+	if partialHead || in.NeedsHeader() {
+		i := bytes.Index(data, delimiter)
+		if i < 0 {
+			stripe.Tail = data
+			return fn(stripe)
+		}
+		stripe.Head, data = data[:i+len(delimiter)], data[i+len(delimiter):]
+		if !partialHead {
+			in.ReadHeader(stripe.Head[:i])
+		}
+	}
+
+	var tail []byte
+	if last := bytes.LastIndex(data, delimiter); last < 0 {
+		tail, data = data, nil
+	} else {
+		tail, data = data[last+len(delimiter):], data[:last+len(delimiter)]
+	}
+
+	for len(data) > 0 {
+		i := bytes.Index(data, delimiter)
+		if record := in.ParseRecord(data[:i]); record != nil && (where == nil || where.Eval(record).IsTrue()) {
+			stripe.Records = append(stripe.Records, data[:i+len(delimiter)]...)
+			if len(stripe.Records) >= stripeSize {
+				if err := fn(stripe); err != nil {
+					return err
+				}
+				stripe = &volume_server_pb.QueriedStripe{}
+			}
+		}
+		data = data[i+len(delimiter):]
+	}
+
+	stripe.Tail = tail
+	return fn(stripe)
+}
+
+// ReadRecords reads all records, skipping the CSV header line, and allowing quoted record delimiters in CSV.
+// Each record is passed without the record delimiter.
+func (in *Input) ReadRecords(reader io.Reader, fn func(raw []byte) error) error {
+
+	if in.jsonDocument {
+		decoder := json.NewDecoder(reader)
+		for {
+			var raw json.RawMessage
+			if err := decoder.Decode(&raw); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return fmt.Errorf("read json document: %v", err)
+			}
+			if err := fn(raw); err != nil {
+				return err
+			}
+		}
+	}
+
+	delimiter := in.recordDelimiter
+	br := bufio.NewReaderSize(reader, 64*1024)
+	var record []byte
+	for {
+		line, err := br.ReadBytes(delimiter[len(delimiter)-1])
+		record = append(record, line...)
+		if err == nil {
+			if !bytes.HasSuffix(record, delimiter) {
+				continue
+			}
+			if in.quotedDelimiter && !in.csv.QuotesBalanced(string(record[:len(record)-len(delimiter)])) {
+				continue
+			}
+			record = record[:len(record)-len(delimiter)]
+		} else if err != io.EOF {
+			return err
+		}
+		if len(record) > 0 || err == nil {
+			if in.NeedsHeader() {
+				in.ReadHeader(record)
+			} else if fnErr := fn(record); fnErr != nil {
+				return fnErr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		record = nil
+	}
+}
@@ -0,0 +1,100 @@
+package query
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/query/sql"
+	"github.com/stretchr/testify/assert"
+)
+
+func filter(t *testing.T, in *Input, data string, partialHead bool, where string) (head, records, tail string) {
+	expr, err := sql.ParseWhere(where)
+	assert.NoError(t, err)
+	err = in.FilterRecords([]byte(data), partialHead, expr, func(stripe *volume_server_pb.QueriedStripe) error {
+		head += string(stripe.Head)
+		records += string(stripe.Records)
+		tail += string(stripe.Tail)
+		return nil
+	})
+	assert.NoError(t, err)
+	return
+}
+
+func TestFilterCsvRecords(t *testing.T) {
+	serialization := &volume_server_pb.QueryRequest_InputSerialization{
+		CsvInput: &volume_server_pb.QueryRequest_InputSerialization_CSVInput{
+			FileHeaderInfo: "USE",
+		},
+	}
+
+	// the beginning of the file has the header line
+	in, err := NewInput(serialization)
+	assert.NoError(t, err)
+	head, records, tail := filter(t, in, "name,age\njack,31\n# comment\njill,20\nbob,4", false, "age > 30")
+	assert.Equal(t, "name,age\n", head)
+	assert.Equal(t, "jack,31\n", records)
+	assert.Equal(t, "bob,4", tail)
+	assert.Equal(t, []string{"name", "age"}, in.ColumnNames())
+
+	// the following chunks use the column names from the first chunk
+	serialization.CsvInput.ColumnNames = in.ColumnNames()
+	in, err = NewInput(serialization)
+	assert.NoError(t, err)
+	head, records, tail = filter(t, in, "5\nann,50\nzoe,10\n", true, "age > 30")
+	assert.Equal(t, "5\n", head)
+	assert.Equal(t, "ann,50\n", records)
+	assert.Equal(t, "", tail)
+
+	// a chunk inside one long record
+	head, records, tail = filter(t, in, "no delimiter", true, "")
+	assert.Equal(t, "", head)
+	assert.Equal(t, "", records)
+	assert.Equal(t, "no delimiter", tail)
+}
+
+func TestFilterJsonRecords(t *testing.T) {
+	in, err := NewInput(&volume_server_pb.QueryRequest_InputSerialization{
+		JsonInput: &volume_server_pb.QueryRequest_InputSerialization_JSONInput{Type: "LINES"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, in.Splittable())
+	_, records, _ := filter(t, in, "{\"a\":{\"b\":1}}\n\n{\"a\":{\"b\":2}}\n", false, "a.b = 2")
+	assert.Equal(t, "{\"a\":{\"b\":2}}\n", records)
+}
+
+func TestReadRecords(t *testing.T) {
+	in, err := NewInput(&volume_server_pb.QueryRequest_InputSerialization{
+		CsvInput: &volume_server_pb.QueryRequest_InputSerialization_CSVInput{
+			FileHeaderInfo:             "IGNORE",
+			RecordDelimiter:            "\r\n",
+			AllowQuotedRecordDelimiter: true,
+		},
+	})
+	assert.NoError(t, err)
+	assert.False(t, in.Splittable())
+
+	var records []string
+	err = in.ReadRecords(strings.NewReader("a,b\r\n1,\"x\r\ny\"\r\n2,z"), func(raw []byte) error {
+		records = append(records, string(raw))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1,\"x\r\ny\"", "2,z"}, records)
+
+	in, err = NewInput(&volume_server_pb.QueryRequest_InputSerialization{
+		JsonInput: &volume_server_pb.QueryRequest_InputSerialization_JSONInput{Type: "DOCUMENT"},
+	})
+	assert.NoError(t, err)
+	records = nil
+	err = in.ReadRecords(strings.NewReader("{\"a\":\n1}\n{\"a\":2}"), func(raw []byte) error {
+		records = append(records, string(raw))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(records))
+
+	_, err = NewInput(&volume_server_pb.QueryRequest_InputSerialization{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package sql
+
+// Aggregation computes the aggregate functions of a statement over the matched records
+type Aggregation struct {
+	projections []*Projection
+	counts      []int64
+	sums        []float64
+	extremes    []Value
+}
+
+func (s *Statement) NewAggregation() *Aggregation {
+	n := len(s.Projections)
+	return &Aggregation{
+		projections: s.Projections,
+		counts:      make([]int64, n),
+		sums:        make([]float64, n),
+		extremes:    make([]Value, n),
+	}
+}
+
+func (a *Aggregation) Add(record Record) {
+	for i, projection := range a.projections {
+		if projection.Expr == nil {
+			// COUNT(*)
+			a.counts[i]++
+			continue
+		}
+		v := projection.Expr.Eval(record)
+		if v.IsNull() {
+			continue
+		}
+		switch projection.Aggregate {
+		case "COUNT":
+			a.counts[i]++
+		case "SUM", "AVG":
+			if n, ok := v.toNumber(); ok {
+				a.sums[i] += n
+				a.counts[i]++
+			}
+		case "MIN", "MAX":
+			if a.counts[i] == 0 {
+				a.extremes[i] = v
+				a.counts[i]++
+				continue
+			}
+			c, ok := Compare(v, a.extremes[i])
+			if ok && (c < 0 && projection.Aggregate == "MIN" || c > 0 && projection.Aggregate == "MAX") {
+				a.extremes[i] = v
+			}
+			a.counts[i]++
+		}
+	}
+}
+
+// Result returns the values of the aggregate functions, with NULL for SUM, AVG, MIN, and MAX over no values
+func (a *Aggregation) Result() (values []Value) {
+	for i, projection := range a.projections {
+		switch {
+		case projection.Aggregate == "COUNT":
+			values = append(values, NumberValue(float64(a.counts[i])))
+		case a.counts[i] == 0:
+			values = append(values, NullValue())
+		case projection.Aggregate == "SUM":
+			values = append(values, NumberValue(a.sums[i]))
+		case projection.Aggregate == "AVG":
+			values = append(values, NumberValue(a.sums[i]/float64(a.counts[i])))
+		default:
+			values = append(values, a.extremes[i])
+		}
+	}
+	return
+}
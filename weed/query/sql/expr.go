@@ -0,0 +1,287 @@
+package sql
+
+import (
+	"strings"
+)
+
+// Record is one CSV row or JSON object
+type Record interface {
+	// Get returns the value of a column, or of a nested JSON field, with false if it does not exist.
+	// CSV columns can also be referenced by position, as _1, _2, etc.
+	Get(path []string) (Value, bool)
+	// Fields returns all columns of the record, for SELECT *
+	Fields() (names []string, values []Value)
+}
+
+type Expr interface {
+	Eval(record Record) Value
+	// String formats the expression as SQL, without the table alias
+	String() string
+}
+
+type Literal struct {
+	Value Value
+}
+
+type Column struct {
+	Path []string
+}
+
+type Comparison struct {
+	Op          string
+	Left, Right Expr
+}
+
+type Like struct {
+	Not           bool
+	Left, Pattern Expr
+	Escape        string
+}
+
+type IsNull struct {
+	Not  bool
+	Expr Expr
+}
+
+type Between struct {
+	Not             bool
+	Expr, Low, High Expr
+}
+
+type In struct {
+	Not  bool
+	Expr Expr
+	List []Expr
+}
+
+type Logical struct {
+	// AND, OR
+	Op          string
+	Left, Right Expr
+}
+
+type Not struct {
+	Expr Expr
+}
+
+type CastExpr struct {
+	Expr Expr
+	Type string
+}
+
+func (e *Literal) Eval(record Record) Value {
+	return e.Value
+}
+
+func (e *Literal) String() string {
+	switch e.Value.Type {
+	case Null:
+		return "NULL"
+	case String, Raw:
+		return quoteString(e.Value.Str)
+	case Bool:
+		return strings.ToUpper(e.Value.String())
+	}
+	return e.Value.String()
+}
+
+func (e *Column) Eval(record Record) Value {
+	if v, found := record.Get(e.Path); found {
+		return v
+	}
+	return NullValue()
+}
+
+func (e *Column) String() string {
+	var parts []string
+	for _, p := range e.Path {
+		parts = append(parts, quoteIdentifier(p))
+	}
+	return strings.Join(parts, ".")
+}
+
+// Name is the output column name of the projection
+func (e *Column) Name() string {
+	return e.Path[len(e.Path)-1]
+}
+
+func (e *Comparison) Eval(record Record) Value {
+	c, ok := Compare(e.Left.Eval(record), e.Right.Eval(record))
+	if !ok {
+		return NullValue()
+	}
+	switch e.Op {
+	case "=":
+		return BoolValue(c == 0)
+	case "!=", "<>":
+		return BoolValue(c != 0)
+	case "<":
+		return BoolValue(c < 0)
+	case "<=":
+		return BoolValue(c <= 0)
+	case ">":
+		return BoolValue(c > 0)
+	case ">=":
+		return BoolValue(c >= 0)
+	}
+	return NullValue()
+}
+
+func (e *Comparison) String() string {
+	return "(" + e.Left.String() + " " + e.Op + " " + e.Right.String() + ")"
+}
+
+func (e *Like) Eval(record Record) Value {
+	v, pattern := e.Left.Eval(record), e.Pattern.Eval(record)
+	if v.IsNull() || pattern.IsNull() {
+		return NullValue()
+	}
+	return BoolValue(likeMatch(v.String(), pattern.String(), e.Escape) != e.Not)
+}
+
+func (e *Like) String() string {
+	s := "(" + e.Left.String()
+	if e.Not {
+		s += " NOT"
+	}
+	s += " LIKE " + e.Pattern.String()
+	if e.Escape != "" {
+		s += " ESCAPE " + quoteString(e.Escape)
+	}
+	return s + ")"
+}
+
+func (e *IsNull) Eval(record Record) Value {
+	return BoolValue(e.Expr.Eval(record).IsNull() != e.Not)
+}
+
+func (e *IsNull) String() string {
+	if e.Not {
+		return "(" + e.Expr.String() + " IS NOT NULL)"
+	}
+	return "(" + e.Expr.String() + " IS NULL)"
+}
+
+func (e *Between) Eval(record Record) Value {
+	v := e.Expr.Eval(record)
+	low, lowOk := Compare(v, e.Low.Eval(record))
+	high, highOk := Compare(v, e.High.Eval(record))
+	if !lowOk || !highOk {
+		return NullValue()
+	}
+	return BoolValue((low >= 0 && high <= 0) != e.Not)
+}
+
+func (e *Between) String() string {
+	op := " BETWEEN "
+	if e.Not {
+		op = " NOT BETWEEN "
+	}
+	return "(" + e.Expr.String() + op + e.Low.String() + " AND " + e.High.String() + ")"
+}
+
+func (e *In) Eval(record Record) Value {
+	v := e.Expr.Eval(record)
+	if v.IsNull() {
+		return NullValue()
+	}
+	for _, item := range e.List {
+		if c, ok := Compare(v, item.Eval(record)); ok && c == 0 {
+			return BoolValue(!e.Not)
+		}
+	}
+	return BoolValue(e.Not)
+}
+
+func (e *In) String() string {
+	var items []string
+	for _, item := range e.List {
+		items = append(items, item.String())
+	}
+	op := " IN ("
+	if e.Not {
+		op = " NOT IN ("
+	}
+	return "(" + e.Expr.String() + op + strings.Join(items, ", ") + "))"
+}
+
+// Eval follows the three-valued logic, where NULL is unknown
+func (e *Logical) Eval(record Record) Value {
+	left := e.Left.Eval(record)
+	if e.Op == "AND" && left.Type == Bool && !left.Bool || e.Op == "OR" && left.IsTrue() {
+		return left
+	}
+	right := e.Right.Eval(record)
+	if e.Op == "AND" && right.Type == Bool && !right.Bool || e.Op == "OR" && right.IsTrue() {
+		return right
+	}
+	if left.Type != Bool || right.Type != Bool {
+		return NullValue()
+	}
+	return left
+}
+
+func (e *Logical) String() string {
+	return "(" + e.Left.String() + " " + e.Op + " " + e.Right.String() + ")"
+}
+
+func (e *Not) Eval(record Record) Value {
+	v := e.Expr.Eval(record)
+	if v.Type != Bool {
+		return NullValue()
+	}
+	return BoolValue(!v.Bool)
+}
+
+func (e *Not) String() string {
+	return "(NOT " + e.Expr.String() + ")"
+}
+
+func (e *CastExpr) Eval(record Record) Value {
+	return Cast(e.Expr.Eval(record), e.Type)
+}
+
+func (e *CastExpr) String() string {
+	return "CAST(" + e.Expr.String() + " AS " + e.Type + ")"
+}
+
+// likeMatch matches the SQL LIKE pattern, where % matches any characters and _ matches one character
+func likeMatch(s, pattern, escape string) bool {
+	str, pat := []rune(s), []rune(pattern)
+	var escapeRune rune = -1
+	if escape != "" {
+		escapeRune = []rune(escape)[0]
+	}
+	// the positions to backtrack to after the last %
+	si, pi, starPi, starSi := 0, 0, -1, 0
+	for si < len(str) {
+		if pi < len(pat) {
+			p, escaped := pat[pi], false
+			if p == escapeRune && pi+1 < len(pat) {
+				p, escaped = pat[pi+1], true
+			}
+			switch {
+			case p == '%' && !escaped:
+				starPi, starSi = pi, si
+				pi++
+				continue
+			case p == '_' && !escaped || p == str[si]:
+				si++
+				pi++
+				if escaped {
+					pi++
+				}
+				continue
+			}
+		}
+		if starPi < 0 {
+			return false
+		}
+		starSi++
+		si, pi = starSi, starPi+1
+	}
+	for pi < len(pat) && pat[pi] == '%' {
+		pi++
+	}
+	return pi == len(pat)
+}
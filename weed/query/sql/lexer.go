@@ -0,0 +1,119 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenKeyword
+	tokenString
+	tokenNumber
+	tokenOperator
+)
+
+type token struct {
+	kind tokenKind
+	// upper cased for keywords, unquoted for quoted identifiers and strings
+	text string
+	// the original text
+	raw string
+	pos int
+}
+
+var keywords = map[string]bool{
+	"SELECT": true, "FROM": true, "WHERE": true, "LIMIT": true, "AS": true,
+	"AND": true, "OR": true, "NOT": true, "LIKE": true, "ESCAPE": true,
+	"IS": true, "NULL": true, "TRUE": true, "FALSE": true,
+	"BETWEEN": true, "IN": true, "CAST": true,
+}
+
+var operators = []string{"<=", ">=", "<>", "!=", "=", "<", ">", "(", ")", ",", "*", ".", "[", "]", "-"}
+
+func lex(input string) (tokens []token, err error) {
+	for pos := 0; pos < len(input); {
+		c := input[pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			pos++
+		case c == '\'' || c == '"':
+			text, end, found := scanQuoted(input, pos)
+			if !found {
+				return nil, fmt.Errorf("unterminated quote at %d", pos)
+			}
+			kind := tokenString
+			if c == '"' {
+				kind = tokenIdentifier
+			}
+			tokens = append(tokens, token{kind: kind, text: text, raw: input[pos:end], pos: pos})
+			pos = end
+		case c >= '0' && c <= '9' || c == '.' && pos+1 < len(input) && input[pos+1] >= '0' && input[pos+1] <= '9':
+			end := pos
+			for end < len(input) && (input[end] >= '0' && input[end] <= '9' || input[end] == '.' ||
+				input[end] == 'e' || input[end] == 'E' ||
+				(input[end] == '-' || input[end] == '+') && (input[end-1] == 'e' || input[end-1] == 'E')) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: input[pos:end], raw: input[pos:end], pos: pos})
+			pos = end
+		case c == '_' || unicode.IsLetter(rune(c)):
+			end := pos
+			for end < len(input) && (input[end] == '_' || input[end] >= '0' && input[end] <= '9' || unicode.IsLetter(rune(input[end])) || input[end] >= 0x80) {
+				end++
+			}
+			word := input[pos:end]
+			if upper := strings.ToUpper(word); keywords[upper] {
+				tokens = append(tokens, token{kind: tokenKeyword, text: upper, raw: word, pos: pos})
+			} else {
+				tokens = append(tokens, token{kind: tokenIdentifier, text: word, raw: word, pos: pos})
+			}
+			pos = end
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(input[pos:], op) {
+					tokens = append(tokens, token{kind: tokenOperator, text: op, raw: op, pos: pos})
+					pos += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at %d", c, pos)
+			}
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(input)}), nil
+}
+
+// scanQuoted reads a quoted string or identifier, where the quote is escaped by doubling it
+func scanQuoted(input string, pos int) (text string, end int, found bool) {
+	quote := input[pos]
+	var sb strings.Builder
+	for i := pos + 1; i < len(input); i++ {
+		if input[i] != quote {
+			sb.WriteByte(input[i])
+			continue
+		}
+		if i+1 < len(input) && input[i+1] == quote {
+			sb.WriteByte(quote)
+			i++
+			continue
+		}
+		return sb.String(), i + 1, true
+	}
+	return "", 0, false
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,511 @@
+package sql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Statement is the supported subset of the S3 select SQL:
+//
+//	SELECT projections FROM S3Object [[AS] alias] [WHERE condition] [LIMIT number]
+//
+// The projections are *, or expressions and the aggregate functions COUNT, SUM, AVG, MIN, and MAX.
+// The conditions support AND, OR, NOT, comparisons, LIKE, BETWEEN, IN, IS NULL, and CAST.
+type Statement struct {
+	// nil for SELECT *
+	Projections []*Projection
+	// nil if there is no WHERE clause
+	Where Expr
+	// -1 if there is no LIMIT clause
+	Limit int64
+}
+
+type Projection struct {
+	// nil for COUNT(*)
+	Expr  Expr
+	Alias string
+	// COUNT, SUM, AVG, MIN, MAX, or empty if it is not an aggregate function
+	Aggregate string
+}
+
+var aggregates = map[string]bool{"COUNT": true, "SUM": true, "AVG": true, "MIN": true, "MAX": true}
+
+type parser struct {
+	tokens []token
+	pos    int
+	// the table alias, which is removed from the column names
+	alias string
+}
+
+// Parse parses a select statement
+func Parse(statement string) (*Statement, error) {
+	tokens, err := lex(statement)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	stmt, err := p.parseStatement()
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// ParseWhere parses a condition formatted by Expr.String(), without the table alias
+func ParseWhere(condition string) (Expr, error) {
+	if strings.TrimSpace(condition) == "" {
+		return nil, nil
+	}
+	tokens, err := lex(condition)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenEOF {
+		return nil, p.errorf("unexpected %q", p.peek().raw)
+	}
+	return expr, nil
+}
+
+// IsAggregate checks whether the statement returns one row of aggregate functions
+func (s *Statement) IsAggregate() bool {
+	return len(s.Projections) > 0 && s.Projections[0].Aggregate != ""
+}
+
+// ColumnNames returns the names of the output columns, or nil for SELECT *
+func (s *Statement) ColumnNames() (names []string) {
+	for i, projection := range s.Projections {
+		switch {
+		case projection.Alias != "":
+			names = append(names, projection.Alias)
+		case projection.Aggregate == "":
+			if column, ok := projection.Expr.(*Column); ok {
+				names = append(names, column.Name())
+				continue
+			}
+			fallthrough
+		default:
+			names = append(names, "_"+strconv.Itoa(i+1))
+		}
+	}
+	return
+}
+
+// Project evaluates the projections of a non-aggregate statement on the record
+func (s *Statement) Project(record Record) (names []string, values []Value) {
+	if s.Projections == nil {
+		return record.Fields()
+	}
+	for _, projection := range s.Projections {
+		values = append(values, projection.Expr.Eval(record))
+	}
+	return s.ColumnNames(), values
+}
+
+// Match checks whether the record passes the WHERE clause
+func (s *Statement) Match(record Record) bool {
+	return s.Where == nil || s.Where.Eval(record).IsTrue()
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isKeyword(keyword string) bool {
+	t := p.peek()
+	return t.kind == tokenKeyword && t.text == keyword
+}
+
+func (p *parser) isOperator(op string) bool {
+	t := p.peek()
+	return t.kind == tokenOperator && t.text == op
+}
+
+func (p *parser) acceptKeyword(keyword string) bool {
+	if p.isKeyword(keyword) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) acceptOperator(op string) bool {
+	if p.isOperator(op) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expectKeyword(keyword string) error {
+	if !p.acceptKeyword(keyword) {
+		return p.errorf("expecting %s", keyword)
+	}
+	return nil
+}
+
+func (p *parser) expectOperator(op string) error {
+	if !p.acceptOperator(op) {
+		return p.errorf("expecting %q", op)
+	}
+	return nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	t := p.peek()
+	if t.kind == tokenEOF {
+		return fmt.Errorf(format+" at the end", args...)
+	}
+	return fmt.Errorf(format+" at %d near %q", append(args, t.pos, t.raw)...)
+}
+
+func (p *parser) parseStatement() (stmt *Statement, err error) {
+
+	stmt = &Statement{Limit: -1}
+
+	if err = p.expectKeyword("SELECT"); err != nil {
+		return nil, err
+	}
+	// the projections are parsed after the table alias is known
+	projectionStart := p.pos
+	for depth := 0; !(depth == 0 && p.isKeyword("FROM")); p.next() {
+		switch {
+		case p.peek().kind == tokenEOF:
+			return nil, p.errorf("expecting FROM")
+		case p.isOperator("("):
+			depth++
+		case p.isOperator(")"):
+			depth--
+		}
+	}
+	p.next()
+
+	if t := p.next(); t.kind != tokenIdentifier || !strings.EqualFold(t.text, "S3Object") {
+		return nil, fmt.Errorf("only FROM S3Object is supported")
+	}
+	if p.acceptOperator("[") {
+		if err = p.expectOperator("*"); err != nil {
+			return nil, err
+		}
+		if err = p.expectOperator("]"); err != nil {
+			return nil, err
+		}
+	}
+	p.acceptKeyword("AS")
+	if t := p.peek(); t.kind == tokenIdentifier {
+		p.alias = t.text
+		p.next()
+	}
+	fromEnd := p.pos
+
+	p.pos = projectionStart
+	if stmt.Projections, err = p.parseProjections(); err != nil {
+		return nil, err
+	}
+	if !p.isKeyword("FROM") {
+		return nil, p.errorf("expecting FROM")
+	}
+	p.pos = fromEnd
+
+	if p.acceptKeyword("WHERE") {
+		if stmt.Where, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+	}
+	if p.acceptKeyword("LIMIT") {
+		t := p.next()
+		if t.kind != tokenNumber {
+			return nil, p.errorf("expecting a number for LIMIT")
+		}
+		if stmt.Limit, err = strconv.ParseInt(t.text, 10, 64); err != nil || stmt.Limit < 0 {
+			return nil, fmt.Errorf("invalid LIMIT %s", t.text)
+		}
+	}
+	if p.peek().kind != tokenEOF {
+		return nil, p.errorf("unexpected %q", p.peek().raw)
+	}
+	return stmt, nil
+}
+
+func (p *parser) parseProjections() (projections []*Projection, err error) {
+	if p.acceptOperator("*") {
+		return nil, nil
+	}
+	aggregateCount := 0
+	for {
+		projection, err := p.parseProjection()
+		if err != nil {
+			return nil, err
+		}
+		if projection.Aggregate != "" {
+			aggregateCount++
+		}
+		projections = append(projections, projection)
+		if !p.acceptOperator(",") {
+			break
+		}
+	}
+	if aggregateCount > 0 && aggregateCount < len(projections) {
+		return nil, fmt.Errorf("aggregate functions can not be mixed with other projections")
+	}
+	return projections, nil
+}
+
+func (p *parser) parseProjection() (projection *Projection, err error) {
+	projection = &Projection{}
+	if t := p.peek(); t.kind == tokenIdentifier && aggregates[strings.ToUpper(t.text)] &&
+		p.tokens[p.pos+1].kind == tokenOperator && p.tokens[p.pos+1].text == "(" {
+		projection.Aggregate = strings.ToUpper(t.text)
+		p.pos += 2
+		if projection.Aggregate == "COUNT" && p.acceptOperator("*") {
+			projection.Expr = nil
+		} else if projection.Expr, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+		if err = p.expectOperator(")"); err != nil {
+			return nil, err
+		}
+	} else if projection.Expr, err = p.parseExpr(); err != nil {
+		return nil, err
+	}
+	if p.acceptKeyword("AS") {
+		t := p.next()
+		if t.kind != tokenIdentifier {
+			return nil, p.errorf("expecting an alias")
+		}
+		projection.Alias = t.text
+	} else if t := p.peek(); t.kind == tokenIdentifier {
+		projection.Alias = t.text
+		p.next()
+	}
+	return projection, nil
+}
+
+func (p *parser) parseExpr() (Expr, error) {
+	return p.parseOr()
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Logical{Op: "OR", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptKeyword("AND") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &Logical{Op: "AND", Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if p.acceptKeyword("NOT") {
+		expr, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr}, nil
+	}
+	return p.parsePredicate()
+}
+
+func (p *parser) parsePredicate() (Expr, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind == tokenOperator {
+		switch t.text {
+		case "=", "!=", "<>", "<", "<=", ">", ">=":
+			p.next()
+			right, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			return &Comparison{Op: t.text, Left: left, Right: right}, nil
+		}
+	}
+
+	if p.acceptKeyword("IS") {
+		not := p.acceptKeyword("NOT")
+		if err = p.expectKeyword("NULL"); err != nil {
+			return nil, err
+		}
+		return &IsNull{Not: not, Expr: left}, nil
+	}
+
+	not := p.acceptKeyword("NOT")
+	switch {
+	case p.acceptKeyword("LIKE"):
+		pattern, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		like := &Like{Not: not, Left: left, Pattern: pattern}
+		if p.acceptKeyword("ESCAPE") {
+			t := p.next()
+			if t.kind != tokenString || len([]rune(t.text)) != 1 {
+				return nil, fmt.Errorf("ESCAPE should be one character")
+			}
+			like.Escape = t.text
+		}
+		return like, nil
+	case p.acceptKeyword("BETWEEN"):
+		low, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expectKeyword("AND"); err != nil {
+			return nil, err
+		}
+		high, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return &Between{Not: not, Expr: left, Low: low, High: high}, nil
+	case p.acceptKeyword("IN"):
+		if err = p.expectOperator("("); err != nil {
+			return nil, err
+		}
+		in := &In{Not: not, Expr: left}
+		for {
+			item, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			in.List = append(in.List, item)
+			if !p.acceptOperator(",") {
+				break
+			}
+		}
+		if err = p.expectOperator(")"); err != nil {
+			return nil, err
+		}
+		return in, nil
+	}
+	if not {
+		return nil, p.errorf("expecting LIKE, BETWEEN, or IN")
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseOperand() (Expr, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenString:
+		return &Literal{Value: StringValue(t.text)}, nil
+	case tokenNumber:
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s", t.text)
+		}
+		return &Literal{Value: NumberValue(n)}, nil
+	case tokenIdentifier:
+		return p.parseColumn(t)
+	case tokenKeyword:
+		switch t.text {
+		case "NULL":
+			return &Literal{Value: NullValue()}, nil
+		case "TRUE", "FALSE":
+			return &Literal{Value: BoolValue(t.text == "TRUE")}, nil
+		case "CAST":
+			return p.parseCast()
+		}
+	case tokenOperator:
+		switch t.text {
+		case "(":
+			expr, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err = p.expectOperator(")"); err != nil {
+				return nil, err
+			}
+			return expr, nil
+		case "-":
+			if n := p.next(); n.kind == tokenNumber {
+				v, err := strconv.ParseFloat(n.text, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid number %s", n.text)
+				}
+				return &Literal{Value: NumberValue(-v)}, nil
+			}
+		}
+	}
+	p.pos--
+	return nil, p.errorf("unexpected %q", t.raw)
+}
+
+func (p *parser) parseColumn(first token) (Expr, error) {
+	path := []string{first.text}
+	for p.acceptOperator(".") {
+		t := p.next()
+		if t.kind != tokenIdentifier && t.kind != tokenKeyword {
+			p.pos--
+			return nil, p.errorf("expecting a field name")
+		}
+		if t.kind == tokenKeyword {
+			path = append(path, t.raw)
+		} else {
+			path = append(path, t.text)
+		}
+	}
+	if len(path) > 1 && (p.alias != "" && path[0] == p.alias || strings.EqualFold(path[0], "S3Object")) {
+		path = path[1:]
+	}
+	return &Column{Path: path}, nil
+}
+
+func (p *parser) parseCast() (Expr, error) {
+	if err := p.expectOperator("("); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expectKeyword("AS"); err != nil {
+		return nil, err
+	}
+	t := p.next()
+	typeName := strings.ToUpper(t.text)
+	if t.kind != tokenIdentifier || Cast(StringValue("1"), typeName).IsNull() {
+		return nil, fmt.Errorf("unsupported CAST type %s", t.raw)
+	}
+	if err = p.expectOperator(")"); err != nil {
+		return nil, err
+	}
+	return &CastExpr{Expr: expr, Type: typeName}, nil
+}
@@ -0,0 +1,103 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mapRecord map[string]Value
+
+func (m mapRecord) Get(path []string) (Value, bool) {
+	v, found := m[path[len(path)-1]]
+	return v, found
+}
+
+func (m mapRecord) Fields() (names []string, values []Value) {
+	for name, v := range m {
+		names = append(names, name)
+		values = append(values, v)
+	}
+	return
+}
+
+func TestParseStatement(t *testing.T) {
+	stmt, err := Parse("SELECT s.name, s.age AS years FROM S3Object s WHERE s.age > 20 AND s.name LIKE 'j%' LIMIT 5")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(stmt.Projections))
+	assert.Equal(t, []string{"name", "years"}, stmt.ColumnNames())
+	assert.Equal(t, int64(5), stmt.Limit)
+
+	stmt, err = Parse("select * from s3object")
+	assert.NoError(t, err)
+	assert.Nil(t, stmt.Projections)
+	assert.Nil(t, stmt.Where)
+	assert.Equal(t, int64(-1), stmt.Limit)
+
+	for _, bad := range []string{
+		"SELECT FROM S3Object",
+		"SELECT a FROM t",
+		"SELECT a, COUNT(*) FROM S3Object",
+		"SELECT a FROM S3Object WHERE",
+		"SELECT a FROM S3Object LIMIT x",
+		"SELECT a FROM S3Object WHERE a = 'unterminated",
+	} {
+		_, err = Parse(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestWhere(t *testing.T) {
+	record := mapRecord{
+		"name": StringValue("jack"),
+		"age":  StringValue("31"),
+		"city": NullValue(),
+	}
+	tests := []struct {
+		where   string
+		matched bool
+	}{
+		{"age > 30", true},
+		{"age > 30 AND name = 'jill'", false},
+		{"age > 40 OR name = 'jack'", true},
+		{"NOT age < 30", true},
+		{"name LIKE 'j_c%'", true},
+		{"name NOT LIKE 'j%'", false},
+		{"age BETWEEN 30 AND 32", true},
+		{"name IN ('jill', 'jack')", true},
+		{"city IS NULL", true},
+		{"missing IS NULL", true},
+		{"city = 'x' OR age = 31", true},
+		{"CAST(age AS INT) = 31", true},
+	}
+	for _, tt := range tests {
+		stmt, err := Parse("SELECT * FROM S3Object WHERE " + tt.where)
+		assert.NoError(t, err, tt.where)
+		assert.Equal(t, tt.matched, stmt.Match(record), tt.where)
+
+		// the condition is sent to the volume servers as a string
+		where, err := ParseWhere(stmt.Where.String())
+		assert.NoError(t, err, stmt.Where.String())
+		assert.Equal(t, tt.matched, where.Eval(record).IsTrue(), stmt.Where.String())
+	}
+}
+
+func TestAggregation(t *testing.T) {
+	stmt, err := Parse("SELECT COUNT(*), SUM(age), AVG(age), MIN(age), MAX(age), COUNT(city) FROM S3Object")
+	assert.NoError(t, err)
+	assert.True(t, stmt.IsAggregate())
+
+	aggregation := stmt.NewAggregation()
+	for _, age := range []string{"10", "20", "60"} {
+		aggregation.Add(mapRecord{"age": StringValue(age), "city": NullValue()})
+	}
+	var results []string
+	for _, v := range aggregation.Result() {
+		results = append(results, v.String())
+	}
+	assert.Equal(t, []string{"3", "90", "30", "10", "60", "0"}, results)
+
+	empty := stmt.NewAggregation().Result()
+	assert.Equal(t, "0", empty[0].String())
+	assert.True(t, empty[1].IsNull())
+}
@@ -0,0 +1,149 @@
+package sql
+
+import (
+	"strconv"
+	"strings"
+)
+
+type Type int
+
+const (
+	Null Type = iota
+	String
+	Number
+	Bool
+	// a nested JSON object or array, kept as raw JSON in Str
+	Raw
+)
+
+type Value struct {
+	Type Type
+	Str  string
+	Num  float64
+	Bool bool
+}
+
+func NullValue() Value {
+	return Value{Type: Null}
+}
+
+func StringValue(s string) Value {
+	return Value{Type: String, Str: s}
+}
+
+func NumberValue(n float64) Value {
+	return Value{Type: Number, Num: n}
+}
+
+func BoolValue(b bool) Value {
+	return Value{Type: Bool, Bool: b}
+}
+
+func RawValue(raw string) Value {
+	return Value{Type: Raw, Str: raw}
+}
+
+func (v Value) IsNull() bool {
+	return v.Type == Null
+}
+
+// IsTrue checks the result of a condition, where NULL is not true
+func (v Value) IsTrue() bool {
+	return v.Type == Bool && v.Bool
+}
+
+// String formats the value for the output, with an empty string for NULL
+func (v Value) String() string {
+	switch v.Type {
+	case String, Raw:
+		return v.Str
+	case Number:
+		return strconv.FormatFloat(v.Num, 'f', -1, 64)
+	case Bool:
+		return strconv.FormatBool(v.Bool)
+	}
+	return ""
+}
+
+func (v Value) toNumber() (float64, bool) {
+	switch v.Type {
+	case Number:
+		return v.Num, true
+	case String:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v.Str), 64)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+func (v Value) toBool() (bool, bool) {
+	switch v.Type {
+	case Bool:
+		return v.Bool, true
+	case String:
+		b, err := strconv.ParseBool(strings.TrimSpace(v.Str))
+		return b, err == nil
+	}
+	return false, false
+}
+
+// Compare compares two values, with false if they are not comparable.
+// CSV fields are strings, so a string is compared as a number or a boolean with numbers or booleans.
+func Compare(a, b Value) (int, bool) {
+	if a.Type == Null || b.Type == Null {
+		return 0, false
+	}
+	if a.Type == Number || b.Type == Number {
+		x, xok := a.toNumber()
+		y, yok := b.toNumber()
+		if !xok || !yok {
+			return 0, false
+		}
+		switch {
+		case x < y:
+			return -1, true
+		case x > y:
+			return 1, true
+		}
+		return 0, true
+	}
+	if a.Type == Bool || b.Type == Bool {
+		x, xok := a.toBool()
+		y, yok := b.toBool()
+		if !xok || !yok {
+			return 0, false
+		}
+		switch {
+		case x == y:
+			return 0, true
+		case !x:
+			return -1, true
+		}
+		return 1, true
+	}
+	return strings.Compare(a.Str, b.Str), true
+}
+
+// Cast converts the value to INT, FLOAT, DECIMAL, STRING, or BOOL, with NULL if it can not be converted
+func Cast(v Value, typeName string) Value {
+	if v.Type == Null {
+		return v
+	}
+	switch typeName {
+	case "INT", "INTEGER":
+		if n, ok := v.toNumber(); ok {
+			return NumberValue(float64(int64(n)))
+		}
+	case "FLOAT", "DECIMAL", "NUMERIC":
+		if n, ok := v.toNumber(); ok {
+			return NumberValue(n)
+		}
+	case "STRING", "VARCHAR":
+		return StringValue(v.String())
+	case "BOOL", "BOOLEAN":
+		if b, ok := v.toBool(); ok {
+			return BoolValue(b)
+		}
+	}
+	return NullValue()
+}
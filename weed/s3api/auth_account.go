@@ -0,0 +1,129 @@
+package s3api
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	. "github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/gorilla/mux"
+)
+
+// Each account has its own bucket namespace. The bucket "logs" of the account "acme" is kept in the
+// filer directory "<buckets dir>/acme@logs", so the collections, quotas and lifecycles of the filer work
+// the same for all buckets, while the identities without an account keep using "<buckets dir>/logs".
+//
+// The identities of an account name their buckets without the account. The buckets of other accounts
+// are named with the account, e.g. "acme@logs", and are only accessible by their bucket policies or acls.
+const accountBucketSeparator = "@"
+
+func isValidAccount(account string) bool {
+	return !strings.Contains(account, accountBucketSeparator) && !strings.Contains(account, "/")
+}
+
+// accountBucket is the filer directory name of the bucket, as named by the account
+func accountBucket(account, bucket string) string {
+	if account == "" || strings.Contains(bucket, accountBucketSeparator) {
+		return bucket
+	}
+	return account + accountBucketSeparator + bucket
+}
+
+// bucketAccount is the account owning the bucket, named as the filer directory
+func bucketAccount(bucket string) string {
+	if i := strings.Index(bucket, accountBucketSeparator); i >= 0 {
+		return bucket[:i]
+	}
+	return ""
+}
+
+// relativeBucket is the bucket name as seen by the account, the reverse of accountBucket
+func relativeBucket(account, bucket string) string {
+	if account == "" {
+		return bucket
+	}
+	return strings.TrimPrefix(bucket, account+accountBucketSeparator)
+}
+
+// requestBucket is the bucket name as the client named it, for the responses
+func requestBucket(r *http.Request, bucket string) string {
+	return relativeBucket(r.Header.Get(xhttp.AmzAccountId), bucket)
+}
+
+func (identity *Identity) account() string {
+	if identity == nil {
+		return ""
+	}
+	return identity.Account
+}
+
+// ownsBucket checks whether the bucket, named as the filer directory, is in the namespace of the identity
+func (identity *Identity) ownsBucket(bucket string) bool {
+	return bucket == "" || bucketAccount(bucket) == identity.account()
+}
+
+// setAccountBucket names the bucket of the request as the filer directory, in the namespace of the identity
+func setAccountBucket(r *http.Request, identity *Identity) {
+	vars := mux.Vars(r)
+	if bucket := vars["bucket"]; bucket != "" {
+		vars["bucket"] = accountBucket(identity.account(), bucket)
+	}
+}
+
+// verifyStreamingSignature checks the seed signature of a streaming signed request, and finds the identity of its access key.
+// Only PutObject and UploadPart read the body as signed chunks, so the other requests can not be signed this way.
+func (iam *IdentityAccessManagement) verifyStreamingSignature(r *http.Request) (*Identity, s3err.ErrorCode) {
+	if !isStreamingUpload(r) {
+		return nil, s3err.ErrAccessDenied
+	}
+	if _, _, _, _, errCode := iam.calculateSeedSignature(r); errCode != s3err.ErrNone {
+		return nil, errCode
+	}
+	signV4Values, errCode := parseSignV4(r.Header.Get("Authorization"))
+	if errCode != s3err.ErrNone {
+		return nil, errCode
+	}
+	identity, _, errCode := iam.lookupCredential(signV4Values.Credential.accessKey, r.Header.Get(xhttp.AmzSecurityToken))
+	return identity, errCode
+}
+
+// isStreamingUpload checks whether the request is a PutObject or an UploadPart
+func isStreamingUpload(r *http.Request) bool {
+	if r.Method != http.MethodPut || r.Header.Get("X-Amz-Copy-Source") != "" {
+		return false
+	}
+	if _, object := getBucketAndObject(r); object == "/" {
+		return false
+	}
+	for name := range r.URL.Query() {
+		if name != "uploadId" && name != "partNumber" && name != "x-id" {
+			return false
+		}
+	}
+	return true
+}
+
+// authorizeCopySource checks whether the identity can read the source object of CopyObject and UploadPartCopy
+func (iam *IdentityAccessManagement) authorizeCopySource(r *http.Request, identity *Identity) s3err.ErrorCode {
+
+	if r.Method != http.MethodPut || r.Header.Get("X-Amz-Copy-Source") == "" {
+		return s3err.ErrNone
+	}
+	cpSrcPath, srcVersionId := parseCopySource(r.Header.Get("X-Amz-Copy-Source"))
+	srcBucket, srcObject := pathToBucketAndObject(cpSrcPath)
+	if srcBucket == "" || srcObject == "" {
+		// rejected by the handlers
+		return s3err.ErrNone
+	}
+
+	// evaluated as a GetObject request of the source
+	readRequest := r.Clone(r.Context())
+	readRequest.Method = http.MethodGet
+	readRequest.URL.RawQuery = ""
+	if srcVersionId != "" {
+		readRequest.URL.RawQuery = url.Values{"versionId": []string{srcVersionId}}.Encode()
+	}
+	return iam.authorize(readRequest, identity, ACTION_READ, accountBucket(identity.account(), srcBucket), srcObject)
+}
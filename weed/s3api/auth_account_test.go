@@ -0,0 +1,125 @@
+package s3api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/s3api/bucketpolicy"
+	. "github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountBucketNames(t *testing.T) {
+
+	assert.Equal(t, "logs", accountBucket("", "logs"))
+	assert.Equal(t, "acme@logs", accountBucket("acme", "logs"))
+	assert.Equal(t, "other@logs", accountBucket("acme", "other@logs"))
+
+	assert.Equal(t, "", bucketAccount("logs"))
+	assert.Equal(t, "acme", bucketAccount("acme@logs"))
+
+	assert.Equal(t, "logs", relativeBucket("acme", "acme@logs"))
+	assert.Equal(t, "other@logs", relativeBucket("acme", "other@logs"))
+	assert.Equal(t, "acme@logs", relativeBucket("", "acme@logs"))
+
+	assert.True(t, isValidAccount(""))
+	assert.True(t, isValidAccount("acme"))
+	assert.False(t, isValidAccount("a@b"))
+	assert.False(t, isValidAccount("a/b"))
+
+	r := mux.SetURLVars(httptest.NewRequest("GET", "/logs/a.txt", nil), map[string]string{"bucket": "logs", "object": "a.txt"})
+	setAccountBucket(r, &Identity{Name: "alice", Account: "acme"})
+	bucket, object := getBucketAndObject(r)
+	assert.Equal(t, "acme@logs", bucket)
+	assert.Equal(t, "/a.txt", object)
+
+}
+
+func TestCrossAccountAccess(t *testing.T) {
+
+	policy, err := bucketpolicy.Parse([]byte(`{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": ["bob"]},
+    "Action": "s3:GetObject",
+    "Resource": "arn:aws:s3:::logs/shared/*"
+  }]
+}`))
+	assert.Nil(t, err)
+
+	iam := &IdentityAccessManagement{
+		loadBucketPolicy: func(bucket string) (*bucketpolicy.Policy, error) {
+			if bucket == "acme@logs" {
+				return policy, nil
+			}
+			return nil, nil
+		},
+	}
+	alice := &Identity{Name: "alice", Account: "acme", Actions: []Action{ACTION_READ, ACTION_WRITE}}
+	bob := &Identity{Name: "bob", Account: "other", Actions: []Action{ACTION_READ, ACTION_WRITE}}
+	admin := &Identity{Name: "admin", Actions: []Action{ACTION_ADMIN}}
+
+	r := httptest.NewRequest("GET", "/logs/shared/a.txt", nil)
+	assert.Equal(t, s3err.ErrNone, iam.authorize(r, alice, ACTION_READ, "acme@logs", "/shared/a.txt"))
+
+	// the actions of the identities only apply to the buckets of their accounts
+	assert.Equal(t, s3err.ErrAccessDenied, iam.authorize(r, bob, ACTION_READ, "acme@logs", "/private/a.txt"))
+	assert.Equal(t, s3err.ErrAccessDenied, iam.authorize(r, admin, ACTION_READ, "acme@logs", "/private/a.txt"))
+	assert.Equal(t, s3err.ErrNone, iam.authorize(r, bob, ACTION_READ, "other@logs", "/private/a.txt"))
+	assert.Equal(t, s3err.ErrNone, iam.authorize(r, admin, ACTION_READ, "logs", "/private/a.txt"))
+
+	// unless the bucket policy allows it
+	assert.Equal(t, s3err.ErrNone, iam.authorize(r, bob, ACTION_READ, "acme@logs", "/shared/a.txt"))
+
+	r = httptest.NewRequest("PUT", "/logs/shared/a.txt", nil)
+	assert.Equal(t, s3err.ErrAccessDenied, iam.authorize(r, bob, ACTION_WRITE, "acme@logs", "/shared/a.txt"))
+
+}
+
+func TestStreamingSignedAuthorization(t *testing.T) {
+
+	iam := &IdentityAccessManagement{
+		identities: []*Identity{
+			{Name: "reader", Credentials: []*Credential{{AccessKey: "reader_key", SecretKey: "secret"}}, Actions: []Action{ACTION_READ}},
+			{Name: "writer", Credentials: []*Credential{{AccessKey: "writer_key", SecretKey: "secret"}}, Actions: []Action{ACTION_WRITE}},
+		},
+	}
+
+	newRequest := func(method, target, object, accessKey string) *http.Request {
+		r := mux.SetURLVars(httptest.NewRequest(method, "http://127.0.0.1:8333"+target, nil), map[string]string{"bucket": "logs", "object": object})
+		r.Header.Set("x-amz-content-sha256", streamingContentSHA256)
+		assert.NoError(t, signRequestV4(r, accessKey, "secret"))
+		return r
+	}
+
+	_, errCode := iam.authRequest(newRequest("PUT", "/logs/a.txt", "a.txt", "writer_key"), ACTION_WRITE)
+	assert.Equal(t, s3err.ErrNone, errCode)
+	_, errCode = iam.authRequest(newRequest("PUT", "/logs/a.txt?partNumber=1&uploadId=u1", "a.txt", "writer_key"), ACTION_WRITE)
+	assert.Equal(t, s3err.ErrNone, errCode)
+
+	// the identity of the access key is authorized before the chunks are read
+	_, errCode = iam.authRequest(newRequest("PUT", "/logs/a.txt", "a.txt", "reader_key"), ACTION_WRITE)
+	assert.Equal(t, s3err.ErrAccessDenied, errCode)
+	_, errCode = iam.authRequest(newRequest("PUT", "/logs/a.txt", "a.txt", "unknown_key"), ACTION_WRITE)
+	assert.NotEqual(t, s3err.ErrNone, errCode)
+
+	// but only after the seed signature is verified
+	forged := newRequest("PUT", "/logs/a.txt", "a.txt", "writer_key")
+	forged.Header.Set("Authorization", strings.Replace(forged.Header.Get("Authorization"), "Signature=", "Signature=0", 1))
+	_, errCode = iam.authRequest(forged, ACTION_WRITE)
+	assert.Equal(t, s3err.ErrSignatureDoesNotMatch, errCode)
+
+	// and only for the uploads, which verify the chunks
+	_, errCode = iam.authRequest(newRequest("PUT", "/logs/a.txt?acl", "a.txt", "writer_key"), ACTION_WRITE)
+	assert.Equal(t, s3err.ErrAccessDenied, errCode)
+	_, errCode = iam.authRequest(newRequest("PUT", "/logs?policy", "", "writer_key"), ACTION_WRITE)
+	assert.Equal(t, s3err.ErrAccessDenied, errCode)
+	_, errCode = iam.authUser(newRequest("PUT", "/logs/a.txt", "a.txt", "writer_key"))
+	assert.Equal(t, s3err.ErrAccessDenied, errCode)
+
+}
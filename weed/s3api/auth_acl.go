@@ -0,0 +1,90 @@
+package s3api
+
+import (
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3acl"
+)
+
+// evaluateAcl checks whether the acl of the object, or of the bucket, grants the request.
+// Acls only add access, for requests which the identity actions and the policies do not allow,
+// e.g. anonymous reads of a public-read object in an otherwise private bucket.
+func (iam *IdentityAccessManagement) evaluateAcl(r *http.Request, identity *Identity, bucket, object string) bool {
+
+	if bucket == "" || iam.loadAcl == nil {
+		return false
+	}
+
+	// the bucket level requests have no object
+	if object == "/" {
+		object = ""
+	}
+	permission, onObject := aclPermission(s3ActionName(r, object))
+	if permission == "" {
+		return false
+	}
+	if !onObject {
+		object = ""
+	}
+
+	acl, err := iam.loadAcl(bucket, object)
+	if err != nil {
+		glog.Errorf("load acl of %s%s: %v", bucket, object, err)
+		return false
+	}
+	if acl == nil {
+		return false
+	}
+
+	account := ""
+	if identity != nil {
+		account = aclAccount(identity.Name)
+	}
+	return acl.Grants(account, permission)
+}
+
+// aclPermission maps the action to the permission it needs, and whether the permission is granted by the object acl or the bucket acl
+func aclPermission(action string) (permission string, onObject bool) {
+	switch action {
+	case "s3:GetObject", "s3:GetObjectVersion":
+		return s3acl.PermissionRead, true
+	case "s3:GetObjectAcl":
+		return s3acl.PermissionReadAcp, true
+	case "s3:PutObjectAcl":
+		return s3acl.PermissionWriteAcp, true
+	case "s3:PutObject", "s3:DeleteObject", "s3:DeleteObjectVersion", "s3:AbortMultipartUpload":
+		return s3acl.PermissionWrite, false
+	case "s3:ListBucket", "s3:ListBucketVersions", "s3:ListBucketMultipartUploads":
+		return s3acl.PermissionRead, false
+	case "s3:GetBucketAcl":
+		return s3acl.PermissionReadAcp, false
+	case "s3:PutBucketAcl":
+		return s3acl.PermissionWriteAcp, false
+	}
+	return "", false
+}
+
+// aclAccount is the grantee id of the identity, and "" for anonymous requests
+func aclAccount(identityName string) string {
+	if identityName == "anonymous" {
+		return ""
+	}
+	return identityName
+}
+
+// hasAclAccess checks the bucket acl for the permission, with the identity set by the authentication
+func hasAclAccess(r *http.Request, bucketEntry *filer_pb.Entry, permission string) bool {
+	data := bucketEntry.Extended[xhttp.AmzBucketAcl]
+	if len(data) == 0 {
+		return false
+	}
+	acl, err := s3acl.Parse(data)
+	if err != nil {
+		glog.Errorf("parse acl of bucket %s: %v", bucketEntry.Name, err)
+		return false
+	}
+	return acl.Grants(aclAccount(r.Header.Get(xhttp.AmzIdentityId)), permission)
+}
@@ -0,0 +1,49 @@
+package s3api
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3acl"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnonymousAccessByAcl(t *testing.T) {
+
+	publicRead, _ := s3acl.Canned(s3acl.CannedPublicRead, "owner", "")
+	iam := &IdentityAccessManagement{
+		loadAcl: func(bucket, object string) (*s3acl.AccessControlPolicy, error) {
+			if bucket == "private" && object == "/logo.png" {
+				return publicRead, nil
+			}
+			if object == "" {
+				return s3acl.Private("owner"), nil
+			}
+			return nil, nil
+		},
+	}
+	anonymous := &Identity{Name: "anonymous"}
+
+	// the public object in the private bucket
+	r := httptest.NewRequest("GET", "/private/logo.png", nil)
+	assert.True(t, iam.evaluateAcl(r, nil, "private", "/logo.png"))
+	assert.True(t, iam.evaluateAcl(r, anonymous, "private", "/logo.png"))
+
+	r = httptest.NewRequest("HEAD", "/private/logo.png", nil)
+	assert.True(t, iam.evaluateAcl(r, nil, "private", "/logo.png"))
+
+	// the acl of the object is not public
+	r = httptest.NewRequest("GET", "/private/logo.png?acl", nil)
+	assert.False(t, iam.evaluateAcl(r, nil, "private", "/logo.png"))
+
+	// writes are checked against the bucket acl
+	r = httptest.NewRequest("PUT", "/private/logo.png", nil)
+	assert.False(t, iam.evaluateAcl(r, nil, "private", "/logo.png"))
+
+	r = httptest.NewRequest("GET", "/private/secret.txt", nil)
+	assert.False(t, iam.evaluateAcl(r, nil, "private", "/secret.txt"))
+
+	r = httptest.NewRequest("GET", "/private?list-type=2", nil)
+	assert.False(t, iam.evaluateAcl(r, nil, "private", "/"))
+	assert.True(t, iam.evaluateAcl(r, &Identity{Name: "owner"}, "private", "/"))
+}
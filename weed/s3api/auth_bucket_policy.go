@@ -0,0 +1,227 @@
+package s3api
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/s3api/bucketpolicy"
+)
+
+// evaluateBucketPolicy checks the request against the policy of the bucket, if any.
+// An explicit Deny rejects the request even if the identity is allowed by its actions,
+// and an Allow grants access to identities without the action, or to anonymous requests.
+// The resources of the policy name the bucket in the namespace of its own account.
+func (iam *IdentityAccessManagement) evaluateBucketPolicy(r *http.Request, identity *Identity, bucket, object string) bucketpolicy.Decision {
+
+	if bucket == "" || iam.loadBucketPolicy == nil {
+		return bucketpolicy.NotApplicable
+	}
+
+	policy, err := iam.loadBucketPolicy(bucket)
+	if err != nil {
+		glog.Errorf("load policy of bucket %s: %v", bucket, err)
+		return bucketpolicy.Deny
+	}
+	if policy == nil {
+		return bucketpolicy.NotApplicable
+	}
+
+	// the bucket level requests have no object
+	if object == "/" {
+		object = ""
+	}
+	action := s3ActionName(r, object)
+
+	// admins can always fix a policy which locks everyone out
+	if identity != nil && identity.isAdmin() && isBucketPolicyAction(action) {
+		return bucketpolicy.NotApplicable
+	}
+
+	account := ""
+	if identity != nil && identity.Name != "anonymous" {
+		account = identity.Name
+	}
+
+	return policy.Evaluate(&bucketpolicy.Args{
+		Account:    account,
+		Action:     action,
+		Resource:   bucketpolicy.ResourceArnPrefix + relativeBucket(bucketAccount(bucket), bucket) + object,
+		Conditions: requestConditions(r, account),
+	})
+}
+
+func isBucketPolicyAction(action string) bool {
+	return action == "s3:GetBucketPolicy" || action == "s3:PutBucketPolicy" || action == "s3:DeleteBucketPolicy"
+}
+
+func requestConditions(r *http.Request, account string) map[string][]string {
+
+	conditions := make(map[string][]string)
+
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		conditions[bucketpolicy.KeySourceIp] = []string{host}
+	}
+	if r.TLS != nil {
+		conditions[bucketpolicy.KeySecureTransport] = []string{"true"}
+	} else {
+		conditions[bucketpolicy.KeySecureTransport] = []string{"false"}
+	}
+	if referer := r.Header.Get("Referer"); referer != "" {
+		conditions[bucketpolicy.KeyReferer] = []string{referer}
+	}
+	if userAgent := r.Header.Get("User-Agent"); userAgent != "" {
+		conditions[bucketpolicy.KeyUserAgent] = []string{userAgent}
+	}
+	if account != "" {
+		conditions[bucketpolicy.KeyUsername] = []string{account}
+	}
+
+	query := r.URL.Query()
+	for key, name := range map[string]string{
+		bucketpolicy.KeyPrefix:    "prefix",
+		bucketpolicy.KeyDelimiter: "delimiter",
+		bucketpolicy.KeyMaxKeys:   "max-keys",
+		bucketpolicy.KeyVersionId: "versionId",
+	} {
+		if _, found := query[name]; found {
+			conditions[key] = []string{query.Get(name)}
+		}
+	}
+
+	return conditions
+}
+
+// s3ActionName maps the request to the S3 action name used in bucket policies
+func s3ActionName(r *http.Request, object string) string {
+
+	query := r.URL.Query()
+	has := func(name string) bool {
+		_, found := query[name]
+		return found
+	}
+
+	if object != "" {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead:
+			switch {
+			case has("tagging"):
+				return "s3:GetObjectTagging"
+			case has("acl"):
+				return "s3:GetObjectAcl"
+			case has("retention"):
+				return "s3:GetObjectRetention"
+			case has("legal-hold"):
+				return "s3:GetObjectLegalHold"
+			case has("uploadId"):
+				return "s3:ListMultipartUploadParts"
+			case has("versionId"):
+				return "s3:GetObjectVersion"
+			}
+			return "s3:GetObject"
+		case http.MethodPut:
+			switch {
+			case has("tagging"):
+				return "s3:PutObjectTagging"
+			case has("acl"):
+				return "s3:PutObjectAcl"
+			case has("retention"):
+				return "s3:PutObjectRetention"
+			case has("legal-hold"):
+				return "s3:PutObjectLegalHold"
+			}
+			return "s3:PutObject"
+		case http.MethodPost:
+			if has("select") {
+				return "s3:GetObject"
+			}
+			return "s3:PutObject"
+		case http.MethodDelete:
+			switch {
+			case has("tagging"):
+				return "s3:DeleteObjectTagging"
+			case has("uploadId"):
+				return "s3:AbortMultipartUpload"
+			case has("versionId"):
+				return "s3:DeleteObjectVersion"
+			}
+			return "s3:DeleteObject"
+		}
+		return ""
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		switch {
+		case has("policy"):
+			return "s3:GetBucketPolicy"
+		case has("versioning"):
+			return "s3:GetBucketVersioning"
+		case has("versions"):
+			return "s3:ListBucketVersions"
+		case has("uploads"):
+			return "s3:ListBucketMultipartUploads"
+		case has("lifecycle"):
+			return "s3:GetLifecycleConfiguration"
+		case has("cors"):
+			return "s3:GetBucketCORS"
+		case has("notification"):
+			return "s3:GetBucketNotification"
+		case has("acl"):
+			return "s3:GetBucketAcl"
+		case has("website"):
+			return "s3:GetBucketWebsite"
+		case has("logging"):
+			return "s3:GetBucketLogging"
+		case has("replication"):
+			return "s3:GetReplicationConfiguration"
+		case has("object-lock"):
+			return "s3:GetBucketObjectLockConfiguration"
+		}
+		return "s3:ListBucket"
+	case http.MethodPut:
+		switch {
+		case has("policy"):
+			return "s3:PutBucketPolicy"
+		case has("versioning"):
+			return "s3:PutBucketVersioning"
+		case has("lifecycle"):
+			return "s3:PutLifecycleConfiguration"
+		case has("cors"):
+			return "s3:PutBucketCORS"
+		case has("notification"):
+			return "s3:PutBucketNotification"
+		case has("acl"):
+			return "s3:PutBucketAcl"
+		case has("website"):
+			return "s3:PutBucketWebsite"
+		case has("logging"):
+			return "s3:PutBucketLogging"
+		case has("replication"):
+			return "s3:PutReplicationConfiguration"
+		case has("object-lock"):
+			return "s3:PutBucketObjectLockConfiguration"
+		}
+		return "s3:CreateBucket"
+	case http.MethodDelete:
+		switch {
+		case has("policy"):
+			return "s3:DeleteBucketPolicy"
+		case has("lifecycle"):
+			return "s3:PutLifecycleConfiguration"
+		case has("cors"):
+			return "s3:PutBucketCORS"
+		case has("website"):
+			return "s3:DeleteBucketWebsite"
+		case has("replication"):
+			return "s3:PutReplicationConfiguration"
+		}
+		return "s3:DeleteBucket"
+	case http.MethodPost:
+		if has("delete") {
+			return "s3:DeleteObject"
+		}
+		return "s3:PutObject"
+	}
+	return ""
+}
@@ -0,0 +1,96 @@
+package s3api
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/s3api/bucketpolicy"
+	. "github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestS3ActionName(t *testing.T) {
+	tests := []struct {
+		method, target, object, expected string
+	}{
+		{"GET", "/bucket/key", "/key", "s3:GetObject"},
+		{"HEAD", "/bucket/key", "/key", "s3:GetObject"},
+		{"GET", "/bucket/key?versionId=abc", "/key", "s3:GetObjectVersion"},
+		{"PUT", "/bucket/key?tagging", "/key", "s3:PutObjectTagging"},
+		{"DELETE", "/bucket/key?uploadId=1", "/key", "s3:AbortMultipartUpload"},
+		{"PUT", "/bucket/key?retention", "/key", "s3:PutObjectRetention"},
+		{"GET", "/bucket?list-type=2", "", "s3:ListBucket"},
+		{"GET", "/bucket?versions", "", "s3:ListBucketVersions"},
+		{"PUT", "/bucket?policy", "", "s3:PutBucketPolicy"},
+		{"POST", "/bucket?delete", "", "s3:DeleteObject"},
+		{"GET", "/bucket/key?acl", "/key", "s3:GetObjectAcl"},
+		{"PUT", "/bucket?acl", "", "s3:PutBucketAcl"},
+		{"GET", "/bucket?object-lock", "", "s3:GetBucketObjectLockConfiguration"},
+		{"PUT", "/bucket?object-lock", "", "s3:PutBucketObjectLockConfiguration"},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest(tt.method, tt.target, nil)
+		assert.Equal(t, tt.expected, s3ActionName(r, tt.object), tt.method+" "+tt.target)
+	}
+}
+
+func TestAnonymousAccessByBucketPolicy(t *testing.T) {
+
+	policy, err := bucketpolicy.Parse([]byte(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Allow", "Principal": "*", "Action": "s3:GetObject", "Resource": "arn:aws:s3:::assets/*"},
+    {"Effect": "Allow", "Principal": "*", "Action": "s3:ListBucket", "Resource": "arn:aws:s3:::assets"}
+  ]
+}`))
+	assert.Nil(t, err)
+
+	iam := &IdentityAccessManagement{
+		loadBucketPolicy: func(bucket string) (*bucketpolicy.Policy, error) {
+			if bucket == "assets" {
+				return policy, nil
+			}
+			return nil, nil
+		},
+	}
+
+	r := httptest.NewRequest("GET", "/assets/logo.png", nil)
+	assert.Equal(t, bucketpolicy.Allow, iam.evaluateBucketPolicy(r, nil, "assets", "/logo.png"))
+
+	r = httptest.NewRequest("PUT", "/assets/logo.png", nil)
+	assert.Equal(t, bucketpolicy.NotApplicable, iam.evaluateBucketPolicy(r, nil, "assets", "/logo.png"))
+
+	r = httptest.NewRequest("GET", "/assets?list-type=2", nil)
+	assert.Equal(t, bucketpolicy.Allow, iam.evaluateBucketPolicy(r, nil, "assets", "/"))
+
+	r = httptest.NewRequest("GET", "/private/logo.png", nil)
+	assert.Equal(t, bucketpolicy.NotApplicable, iam.evaluateBucketPolicy(r, nil, "private", "/logo.png"))
+
+}
+
+func TestDeleteObjectsAuthorizedPerKey(t *testing.T) {
+
+	policy, err := bucketpolicy.Parse([]byte(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Effect": "Deny", "Principal": "*", "Action": "s3:DeleteObject", "Resource": "arn:aws:s3:::logs/audit/*"},
+    {"Effect": "Deny", "Principal": "*", "Action": "s3:DeleteObjectVersion", "Resource": "arn:aws:s3:::logs/*"}
+  ]
+}`))
+	assert.Nil(t, err)
+
+	alice := &Identity{Name: "alice", Actions: []Action{ACTION_READ, ACTION_WRITE}}
+	s3a := &S3ApiServer{iam: &IdentityAccessManagement{
+		identities: []*Identity{alice},
+		loadBucketPolicy: func(bucket string) (*bucketpolicy.Policy, error) {
+			return policy, nil
+		},
+	}}
+
+	r := withRequestIdentity(httptest.NewRequest("POST", "/logs?delete", nil), alice)
+	assert.Equal(t, s3err.ErrNone, s3a.authorizeDeleteObject(r, "logs", ObjectIdentifier{ObjectName: "tmp/a.txt"}))
+	assert.Equal(t, s3err.ErrAccessDenied, s3a.authorizeDeleteObject(r, "logs", ObjectIdentifier{ObjectName: "audit/a.txt"}))
+	assert.Equal(t, s3err.ErrAccessDenied, s3a.authorizeDeleteObject(r, "logs", ObjectIdentifier{ObjectName: "tmp/a.txt", VersionId: "v1"}))
+
+}
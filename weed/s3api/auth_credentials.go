@@ -1,16 +1,23 @@
 package s3api
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/filer"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+	"github.com/chrislusf/seaweedfs/weed/s3api/bucketpolicy"
 	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3acl"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3ratelimit"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3sts"
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 )
 
 type Action string
@@ -22,12 +29,38 @@ type Iam interface {
 type IdentityAccessManagement struct {
 	identities []*Identity
 	domain     string
+
+	// for the temporary credentials
+	roles                []*Role
+	webIdentityProviders map[string]*s3sts.WebIdentityProvider
+	sessionSigningKey    []byte
+
+	// the managed policies by name, attached to identities, groups and roles
+	policies map[string]*bucketpolicy.Policy
+
+	// the rate limits of the buckets, and the limiters of the buckets and the access keys
+	bucketRateLimits  map[string]s3ratelimit.Limit
+	bucketLimiters    rateLimiters
+	accessKeyLimiters rateLimiters
+
+	loadBucketPolicy func(bucket string) (*bucketpolicy.Policy, error)
+	loadAcl          func(bucket, object string) (*s3acl.AccessControlPolicy, error)
 }
 
 type Identity struct {
-	Name        string
-	Credentials []*Credential
-	Actions     []Action
+	Name         string
+	Credentials  []*Credential
+	Actions      []Action
+	QuotaBytes   uint64
+	QuotaObjects uint64
+	// the managed policies of the identity and its groups
+	PolicyNames []string
+	// the account owning the buckets, empty for the default bucket namespace
+	Account string
+	// the rate limits of each access key
+	RateLimit s3ratelimit.Limit
+	// only for the temporary credentials, further limiting the actions
+	SessionPolicy *bucketpolicy.Policy
 }
 
 type Credential struct {
@@ -35,6 +68,17 @@ type Credential struct {
 	SecretKey string
 }
 
+type Role struct {
+	Name               string
+	Actions            []Action
+	TrustedIdentities  []string
+	TrustedProviders   []string
+	TrustedSubjects    []string
+	MaxSessionDuration time.Duration
+	PolicyNames        []string
+	Account            string
+}
+
 func NewIdentityAccessManagement(option *S3ApiServerOption) *IdentityAccessManagement {
 	iam := &IdentityAccessManagement{
 		domain: option.DomainName,
@@ -52,7 +96,13 @@ func NewIdentityAccessManagement(option *S3ApiServerOption) *IdentityAccessManag
 }
 
 func (iam *IdentityAccessManagement) loadS3ApiConfigurationFromFiler(option *S3ApiServerOption) error {
-	content, err := filer.ReadContent(option.Filer, filer.IamConfigDirecotry, filer.IamIdentityFile)
+	content, err := filer.ReadContent(option.Filer, filer.IamConfigDirecotry, filer.IamPoliciesFile)
+	if err != nil {
+		glog.V(1).Infof("read managed policies: %v", err)
+	} else if err = iam.loadPoliciesFromBytes(content); err != nil {
+		glog.Warningf("fail to load managed policies: %v", err)
+	}
+	content, err = filer.ReadContent(option.Filer, filer.IamConfigDirecotry, filer.IamIdentityFile)
 	if err != nil {
 		return fmt.Errorf("read S3 config: %v", err)
 	}
@@ -80,18 +130,59 @@ func (iam *IdentityAccessManagement) loadS3ApiConfigurationFromBytes(content []b
 	return nil
 }
 
+// loadPoliciesFromBytes loads the managed policies, saved by the IAM api as {"policies": {"<name>": <policy>}}
+func (iam *IdentityAccessManagement) loadPoliciesFromBytes(content []byte) error {
+	var managed struct {
+		Policies map[string]json.RawMessage `json:"policies"`
+	}
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, &managed); err != nil {
+			return fmt.Errorf("unmarshal managed policies: %v", err)
+		}
+	}
+	policies := make(map[string]*bucketpolicy.Policy)
+	for name, document := range managed.Policies {
+		policy, err := bucketpolicy.Parse(document)
+		if err != nil {
+			return fmt.Errorf("parse managed policy %s: %v", name, err)
+		}
+		policies[name] = policy
+	}
+	iam.policies = policies
+	return nil
+}
+
 func (iam *IdentityAccessManagement) loadS3ApiConfiguration(config *iam_pb.S3ApiConfiguration) error {
+	groupPolicyNames := make(map[string][]string)
+	for _, group := range config.Groups {
+		for _, member := range group.Members {
+			groupPolicyNames[member] = append(groupPolicyNames[member], group.PolicyNames...)
+		}
+	}
+
 	var identities []*Identity
 	for _, ident := range config.Identities {
 		t := &Identity{
-			Name:        ident.Name,
-			Credentials: nil,
-			Actions:     nil,
+			Name:         ident.Name,
+			Credentials:  nil,
+			Actions:      nil,
+			QuotaBytes:   ident.QuotaBytes,
+			QuotaObjects: ident.QuotaObjects,
+			Account:      ident.Account,
+			RateLimit:    toRateLimit(ident.RateLimit),
+		}
+		if !isValidAccount(t.Account) {
+			return fmt.Errorf("invalid account %q of identity %s", t.Account, t.Name)
 		}
 		for _, action := range ident.Actions {
 			t.Actions = append(t.Actions, Action(action))
 		}
+		t.PolicyNames = append(t.PolicyNames, ident.PolicyNames...)
+		t.PolicyNames = append(t.PolicyNames, groupPolicyNames[ident.Name]...)
 		for _, cred := range ident.Credentials {
+			if cred.IsDisabled {
+				continue
+			}
 			t.Credentials = append(t.Credentials, &Credential{
 				AccessKey: cred.AccessKey,
 				SecretKey: cred.SecretKey,
@@ -100,8 +191,48 @@ func (iam *IdentityAccessManagement) loadS3ApiConfiguration(config *iam_pb.S3Api
 		identities = append(identities, t)
 	}
 
+	var roles []*Role
+	for _, role := range config.Roles {
+		t := &Role{
+			Name:               role.Name,
+			TrustedIdentities:  role.TrustedIdentities,
+			TrustedProviders:   role.TrustedProviders,
+			TrustedSubjects:    role.TrustedSubjects,
+			MaxSessionDuration: time.Duration(role.MaxSessionDurationSeconds) * time.Second,
+			PolicyNames:        role.PolicyNames,
+			Account:            role.Account,
+		}
+		if !isValidAccount(t.Account) {
+			return fmt.Errorf("invalid account %q of role %s", t.Account, t.Name)
+		}
+		if t.MaxSessionDuration <= 0 {
+			t.MaxSessionDuration = defaultRoleSessionDuration
+		}
+		if t.MaxSessionDuration > maxRoleSessionDuration {
+			t.MaxSessionDuration = maxRoleSessionDuration
+		}
+		for _, action := range role.Actions {
+			t.Actions = append(t.Actions, Action(action))
+		}
+		roles = append(roles, t)
+	}
+
+	bucketRateLimits := make(map[string]s3ratelimit.Limit)
+	for bucket, limit := range config.BucketRateLimits {
+		bucketRateLimits[bucket] = toRateLimit(limit)
+	}
+
+	webIdentityProviders := make(map[string]*s3sts.WebIdentityProvider)
+	for _, provider := range config.WebIdentityProviders {
+		webIdentityProviders[provider.Name] = s3sts.NewWebIdentityProvider(provider.Name, provider.Issuer, provider.Jwks, provider.Audiences)
+	}
+
 	// atomically switch
 	iam.identities = identities
+	iam.roles = roles
+	iam.webIdentityProviders = webIdentityProviders
+	iam.sessionSigningKey = []byte(config.SessionSigningKey)
+	iam.bucketRateLimits = bucketRateLimits
 	return nil
 }
 
@@ -122,6 +253,38 @@ func (iam *IdentityAccessManagement) lookupByAccessKey(accessKey string) (identi
 	return nil, nil, false
 }
 
+func (iam *IdentityAccessManagement) lookupByName(name string) (identity *Identity, found bool) {
+
+	for _, ident := range iam.identities {
+		if ident.Name == name {
+			return ident, true
+		}
+	}
+	return nil, false
+}
+
+// lookupCredential finds the identity of a long-lived access key, or of the temporary credentials with a session token
+func (iam *IdentityAccessManagement) lookupCredential(accessKey, sessionToken string) (*Identity, *Credential, s3err.ErrorCode) {
+	if sessionToken != "" {
+		return iam.lookupSession(accessKey, sessionToken)
+	}
+	identity, cred, found := iam.lookupByAccessKey(accessKey)
+	if !found {
+		return nil, nil, s3err.ErrInvalidAccessKeyID
+	}
+	return identity, cred, s3err.ErrNone
+}
+
+func (iam *IdentityAccessManagement) lookupRole(name string) (role *Role, found bool) {
+
+	for _, r := range iam.roles {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
 func (iam *IdentityAccessManagement) lookupAnonymous() (identity *Identity, found bool) {
 
 	for _, ident := range iam.identities {
@@ -135,25 +298,52 @@ func (iam *IdentityAccessManagement) lookupAnonymous() (identity *Identity, foun
 func (iam *IdentityAccessManagement) Auth(f http.HandlerFunc, action Action) http.HandlerFunc {
 
 	if !iam.isEnabled() {
-		return f
+		return func(w http.ResponseWriter, r *http.Request) {
+			iam.serveLimited(w, r, nil, f)
+		}
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
+		// only set here after the authentication, never by the client
+		r.Header.Del(xhttp.AmzIsAdmin)
+		r.Header.Del(xhttp.AmzIdentityId)
+		r.Header.Del(xhttp.AmzAccountId)
 		identity, errCode := iam.authRequest(r, action)
 		if errCode == s3err.ErrNone {
-			if identity != nil && identity.Name != "" {
-				r.Header.Set(xhttp.AmzIdentityId, identity.Name)
-				if identity.isAdmin() {
-					r.Header.Set(xhttp.AmzIsAdmin, "true")
-				}
-			}
-			f(w, r)
+			setIdentityHeaders(r, identity)
+			r = withRequestIdentity(r, identity)
+			iam.serveLimited(w, r, identity, f)
 			return
 		}
 		writeErrorResponse(w, errCode, r.URL)
 	}
 }
 
+type identityContextKey struct{}
+
+// withRequestIdentity keeps the authenticated identity for the handlers authorizing more than the request itself
+func withRequestIdentity(r *http.Request, identity *Identity) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), identityContextKey{}, identity))
+}
+
+func requestIdentity(r *http.Request) *Identity {
+	identity, _ := r.Context().Value(identityContextKey{}).(*Identity)
+	return identity
+}
+
+// setIdentityHeaders passes the authenticated identity to the handlers
+func setIdentityHeaders(r *http.Request, identity *Identity) {
+	if identity != nil && identity.Account != "" {
+		r.Header.Set(xhttp.AmzAccountId, identity.Account)
+	}
+	if identity != nil && identity.Name != "" {
+		r.Header.Set(xhttp.AmzIdentityId, identity.Name)
+		if identity.isAdmin() {
+			r.Header.Set(xhttp.AmzIsAdmin, "true")
+		}
+	}
+}
+
 // check whether the request has valid access keys
 func (iam *IdentityAccessManagement) authRequest(r *http.Request, action Action) (*Identity, s3err.ErrorCode) {
 	var identity *Identity
@@ -161,7 +351,9 @@ func (iam *IdentityAccessManagement) authRequest(r *http.Request, action Action)
 	var found bool
 	switch getRequestAuthType(r) {
 	case authTypeStreamingSigned:
-		return identity, s3err.ErrNone
+		// the seed signature is verified here, and the signatures of the chunks while uploading them
+		glog.V(3).Infof("v4 streaming auth type")
+		identity, s3Err = iam.verifyStreamingSignature(r)
 	case authTypeUnknown:
 		glog.V(3).Infof("unknown auth type")
 		return identity, s3err.ErrAccessDenied
@@ -172,15 +364,17 @@ func (iam *IdentityAccessManagement) authRequest(r *http.Request, action Action)
 		glog.V(3).Infof("v4 auth type")
 		identity, s3Err = iam.reqSignatureV4Verify(r)
 	case authTypePostPolicy:
+		// the signature is in the form, verified and authorized by the handler
 		glog.V(3).Infof("post policy auth type")
 		return identity, s3err.ErrNone
 	case authTypeJWT:
 		glog.V(3).Infof("jwt auth type")
 		return identity, s3err.ErrNotImplemented
 	case authTypeAnonymous:
+		// without the anonymous identity, the bucket policy can still allow the request
 		identity, found = iam.lookupAnonymous()
 		if !found {
-			return identity, s3err.ErrAccessDenied
+			identity = nil
 		}
 	default:
 		return identity, s3err.ErrNotImplemented
@@ -190,15 +384,54 @@ func (iam *IdentityAccessManagement) authRequest(r *http.Request, action Action)
 		return identity, s3Err
 	}
 
-	glog.V(3).Infof("user name: %v actions: %v", identity.Name, identity.Actions)
+	if identity != nil {
+		glog.V(3).Infof("user name: %v actions: %v", identity.Name, identity.Actions)
+	}
 
-	bucket, _ := getBucketAndObject(r)
+	setAccountBucket(r, identity)
+	bucket, object := getBucketAndObject(r)
 
-	if !identity.canDo(action, bucket) {
-		return identity, s3err.ErrAccessDenied
+	if errCode := iam.authorize(r, identity, action, bucket, object); errCode != s3err.ErrNone {
+		return identity, errCode
 	}
 
-	return identity, s3err.ErrNone
+	return identity, iam.authorizeCopySource(r, identity)
+
+}
+
+// authorize checks whether the identity can do the request on the object, with the bucket named as the filer directory
+func (iam *IdentityAccessManagement) authorize(r *http.Request, identity *Identity, action Action, bucket, object string) s3err.ErrorCode {
+
+	// the actions and the policies of the identity name the buckets relative to its account
+	identityBucket := relativeBucket(identity.account(), bucket)
+
+	if !identity.sessionAllows(r, identityBucket, object) {
+		return s3err.ErrAccessDenied
+	}
+
+	decision := iam.evaluateBucketPolicy(r, identity, bucket, object)
+	if decision == bucketpolicy.Deny {
+		return s3err.ErrAccessDenied
+	}
+
+	identityDecision := iam.evaluateIdentityPolicies(r, identity, identityBucket, object)
+	if identityDecision == bucketpolicy.Deny {
+		return s3err.ErrAccessDenied
+	}
+
+	if identity != nil && identity.ownsBucket(bucket) && identity.canDo(action, identityBucket) {
+		return s3err.ErrNone
+	}
+
+	if decision == bucketpolicy.Allow || identityDecision == bucketpolicy.Allow {
+		return s3err.ErrNone
+	}
+
+	if iam.evaluateAcl(r, identity, bucket, object) {
+		return s3err.ErrNone
+	}
+
+	return s3err.ErrAccessDenied
 
 }
 
@@ -208,7 +441,8 @@ func (iam *IdentityAccessManagement) authUser(r *http.Request) (*Identity, s3err
 	var found bool
 	switch getRequestAuthType(r) {
 	case authTypeStreamingSigned:
-		return identity, s3err.ErrNone
+		// only used to upload objects and parts
+		return identity, s3err.ErrAccessDenied
 	case authTypeUnknown:
 		glog.V(3).Infof("unknown auth type")
 		return identity, s3err.ErrAccessDenied
@@ -237,6 +471,9 @@ func (iam *IdentityAccessManagement) authUser(r *http.Request) (*Identity, s3err
 	if s3Err != s3err.ErrNone {
 		return identity, s3Err
 	}
+	if bucket, object := getBucketAndObject(r); !identity.sessionAllows(r, bucket, object) {
+		return identity, s3err.ErrAccessDenied
+	}
 	return identity, s3err.ErrNone
 }
 
@@ -10,10 +10,12 @@ import (
 	"time"
 )
 
-func (s3a *S3ApiServer) subscribeMetaEvents(clientName string, prefix string, lastTsNs int64) error {
+func (s3a *S3ApiServer) subscribeMetaEvents(clientName string, prefix string, directories []string, lastTsNs int64) error {
 
 	processEventFn := func(resp *filer_pb.SubscribeMetadataResponse) error {
 
+		s3a.onBucketMetadataChange(resp)
+
 		message := resp.EventNotification
 		if message.NewEntry == nil {
 			return nil
@@ -30,6 +32,12 @@ func (s3a *S3ApiServer) subscribeMetaEvents(clientName string, prefix string, la
 			}
 			glog.V(0).Infof("updated %s/%s", filer.IamConfigDirecotry, filer.IamIdentityFile)
 		}
+		if dir == filer.IamConfigDirecotry && message.NewEntry.Name == filer.IamPoliciesFile {
+			if err := s3a.iam.loadPoliciesFromBytes(message.NewEntry.Content); err != nil {
+				return err
+			}
+			glog.V(0).Infof("updated %s/%s", filer.IamConfigDirecotry, filer.IamPoliciesFile)
+		}
 
 		return nil
 	}
@@ -39,9 +47,10 @@ func (s3a *S3ApiServer) subscribeMetaEvents(clientName string, prefix string, la
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
 			stream, err := client.SubscribeMetadata(ctx, &filer_pb.SubscribeMetadataRequest{
-				ClientName: clientName,
-				PathPrefix: prefix,
-				SinceNs:    lastTsNs,
+				ClientName:  clientName,
+				PathPrefix:  prefix,
+				SinceNs:     lastTsNs,
+				Directories: directories,
 			})
 			if err != nil {
 				return fmt.Errorf("subscribe: %v", err)
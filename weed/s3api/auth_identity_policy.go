@@ -0,0 +1,46 @@
+package s3api
+
+import (
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/s3api/bucketpolicy"
+)
+
+// evaluateIdentityPolicies checks the request against the managed policies attached to the identity,
+// its groups, or its role. Same as the bucket policy, an explicit Deny rejects the request,
+// and an Allow grants access beyond the actions of the identity.
+func (iam *IdentityAccessManagement) evaluateIdentityPolicies(r *http.Request, identity *Identity, bucket, object string) bucketpolicy.Decision {
+
+	if identity == nil || len(identity.PolicyNames) == 0 {
+		return bucketpolicy.NotApplicable
+	}
+
+	// the bucket level requests have no object
+	if object == "/" {
+		object = ""
+	}
+	args := &bucketpolicy.Args{
+		Account:    identity.Name,
+		Action:     s3ActionName(r, object),
+		Resource:   bucketpolicy.ResourceArnPrefix + bucket + object,
+		Conditions: requestConditions(r, identity.Name),
+	}
+
+	policies := iam.policies
+	decision := bucketpolicy.NotApplicable
+	for _, name := range identity.PolicyNames {
+		policy, found := policies[name]
+		if !found {
+			glog.V(1).Infof("managed policy %s of %s is not found", name, identity.Name)
+			continue
+		}
+		switch policy.Evaluate(args) {
+		case bucketpolicy.Deny:
+			return bucketpolicy.Deny
+		case bucketpolicy.Allow:
+			decision = bucketpolicy.Allow
+		}
+	}
+	return decision
+}
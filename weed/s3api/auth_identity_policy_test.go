@@ -0,0 +1,50 @@
+package s3api
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+	"github.com/chrislusf/seaweedfs/weed/s3api/bucketpolicy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManagedPolicies(t *testing.T) {
+
+	iam := &IdentityAccessManagement{}
+	assert.Nil(t, iam.loadPoliciesFromBytes([]byte(`{"policies": {
+  "own-bucket": {"Version": "2012-10-17", "Statement": [
+    {"Effect": "Allow", "Action": "s3:*", "Resource": ["arn:aws:s3:::${aws:username}", "arn:aws:s3:::${aws:username}/*"]}
+  ]},
+  "no-delete": {"Version": "2012-10-17", "Statement": [
+    {"Effect": "Deny", "Action": ["s3:DeleteObject"], "Resource": ["*"]}
+  ]}
+}}`)))
+	assert.Nil(t, iam.loadS3ApiConfiguration(&iam_pb.S3ApiConfiguration{
+		Identities: []*iam_pb.Identity{
+			{Name: "tenant1", PolicyNames: []string{"own-bucket"}},
+			{Name: "admin", Actions: []string{"Admin"}},
+		},
+		Groups: []*iam_pb.Group{
+			{Name: "tenants", Members: []string{"tenant1", "admin"}, PolicyNames: []string{"no-delete"}},
+		},
+	}))
+
+	tenant, _ := iam.lookupByName("tenant1")
+	assert.Equal(t, []string{"own-bucket", "no-delete"}, tenant.PolicyNames)
+
+	r := httptest.NewRequest("PUT", "/tenant1/a.txt", nil)
+	assert.Equal(t, bucketpolicy.Allow, iam.evaluateIdentityPolicies(r, tenant, "tenant1", "/a.txt"))
+
+	r = httptest.NewRequest("GET", "/tenant1?list-type=2", nil)
+	assert.Equal(t, bucketpolicy.Allow, iam.evaluateIdentityPolicies(r, tenant, "tenant1", "/"))
+
+	r = httptest.NewRequest("GET", "/tenant2/a.txt", nil)
+	assert.Equal(t, bucketpolicy.NotApplicable, iam.evaluateIdentityPolicies(r, tenant, "tenant2", "/a.txt"))
+
+	// the explicit deny of the group also applies to the admin
+	admin, _ := iam.lookupByName("admin")
+	r = httptest.NewRequest("DELETE", "/tenant1/a.txt", nil)
+	assert.Equal(t, bucketpolicy.Deny, iam.evaluateIdentityPolicies(r, tenant, "tenant1", "/a.txt"))
+	assert.Equal(t, bucketpolicy.Deny, iam.evaluateIdentityPolicies(r, admin, "tenant1", "/a.txt"))
+}
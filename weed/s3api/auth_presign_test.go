@@ -0,0 +1,74 @@
+package s3api
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3presign"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPresignV4(t *testing.T) {
+	option := S3ApiServerOption{}
+	iam := NewIdentityAccessManagement(&option)
+	iam.identities = []*Identity{
+		{
+			Name:        "someone",
+			Credentials: []*Credential{{AccessKey: "access_key_1", SecretKey: "secret_key_1"}},
+		},
+	}
+
+	presign := func(opts s3presign.Options) *http.Request {
+		opts.Endpoint = "http://127.0.0.1:8333"
+		opts.AccessKey, opts.SecretKey = "access_key_1", "secret_key_1"
+		if opts.Expires == 0 {
+			opts.Expires = time.Hour
+		}
+		presignedUrl, err := s3presign.PresignV4(opts)
+		assert.NoError(t, err)
+		req, err := http.NewRequest(opts.Method, presignedUrl, strings.NewReader("data"))
+		assert.NoError(t, err)
+		return req
+	}
+	verify := func(req *http.Request) s3err.ErrorCode {
+		_, errCode := iam.reqSignatureV4Verify(req)
+		return errCode
+	}
+
+	req := presign(s3presign.Options{Method: http.MethodGet, Bucket: "b", Object: "dir/my file+.txt"})
+	assert.Equal(t, "/b/dir/my file+.txt", req.URL.Path)
+	assert.Equal(t, s3err.ErrNone, verify(req))
+
+	// a part of a multipart upload, with the content constraints
+	req = presign(s3presign.Options{
+		Method:  http.MethodPut,
+		Bucket:  "b",
+		Object:  "big",
+		Query:   url.Values{"partNumber": []string{"3"}, "uploadId": []string{"abc"}},
+		Headers: http.Header{"Content-Type": []string{"video/mp4"}, "Content-Length": []string{"4"}},
+	})
+	req.Header.Set("Content-Type", "video/mp4")
+	assert.Equal(t, s3err.ErrNone, verify(req))
+	req.Header.Set("Content-Type", "text/plain")
+	assert.Equal(t, s3err.ErrSignatureDoesNotMatch, verify(req))
+
+	// the signed query parameters can not be changed
+	req = presign(s3presign.Options{Method: http.MethodPut, Bucket: "b", Object: "big", Query: url.Values{"x-amz-acl": []string{"private"}}})
+	query := req.URL.Query()
+	query.Set("x-amz-acl", "public-read")
+	req.URL.RawQuery = query.Encode()
+	assert.Equal(t, s3err.ErrSignatureDoesNotMatch, verify(req))
+
+	// expired
+	req = presign(s3presign.Options{Method: http.MethodGet, Bucket: "b", Object: "a", Expires: time.Minute, Date: time.Now().Add(-time.Hour)})
+	assert.Equal(t, s3err.ErrExpiredPresignRequest, verify(req))
+
+	_, err := s3presign.PresignV4(s3presign.Options{Endpoint: "localhost:8333", AccessKey: "a", SecretKey: "s", Expires: time.Hour})
+	assert.Error(t, err)
+	_, err = s3presign.PresignV4(s3presign.Options{Endpoint: "http://localhost:8333", AccessKey: "a", SecretKey: "s", Expires: 8 * 24 * time.Hour})
+	assert.Error(t, err)
+}
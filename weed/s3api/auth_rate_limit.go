@@ -0,0 +1,119 @@
+package s3api
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3ratelimit"
+)
+
+// the idle limiters are dropped once there are more limiters than this,
+// e.g. of the access keys of the expired temporary credentials
+const maxRateLimiters = 10000
+
+// rateLimiters keeps the token buckets of the access keys or the buckets between the requests,
+// and replaces them when the limits are changed by the configuration
+type rateLimiters struct {
+	sync.Mutex
+	limiters map[string]*s3ratelimit.Limiter
+}
+
+func (rl *rateLimiters) get(key string, limit s3ratelimit.Limit) *s3ratelimit.Limiter {
+	if limit.IsZero() {
+		return nil
+	}
+	rl.Lock()
+	defer rl.Unlock()
+	if limiter, found := rl.limiters[key]; found && limiter.Limit() == limit {
+		return limiter
+	}
+	if rl.limiters == nil {
+		rl.limiters = make(map[string]*s3ratelimit.Limiter)
+	}
+	if len(rl.limiters) >= maxRateLimiters {
+		for k, limiter := range rl.limiters {
+			if limiter.Idle() {
+				delete(rl.limiters, k)
+			}
+		}
+	}
+	limiter := s3ratelimit.NewLimiter(limit)
+	rl.limiters[key] = limiter
+	return limiter
+}
+
+func toRateLimit(limit *iam_pb.RateLimit) s3ratelimit.Limit {
+	if limit == nil {
+		return s3ratelimit.Limit{}
+	}
+	return s3ratelimit.Limit{
+		ReadRequests:  limit.ReadRequests,
+		WriteRequests: limit.WriteRequests,
+		ReadBytes:     limit.ReadBytes,
+		WriteBytes:    limit.WriteBytes,
+	}
+}
+
+// serveLimited rejects the request with SlowDown if its access key or its bucket is over the request rate
+// or the bandwidth, otherwise throttles the request body of writes, or the response body of reads.
+// The buckets are limited by the bucket name in the filer, including the account of the identity.
+func (iam *IdentityAccessManagement) serveLimited(w http.ResponseWriter, r *http.Request, identity *Identity, f http.HandlerFunc) {
+
+	var limiters []*s3ratelimit.Limiter
+	if identity != nil {
+		if limiter := iam.accessKeyLimiters.get(requestAccessKey(r), identity.RateLimit); limiter != nil {
+			limiters = append(limiters, limiter)
+		}
+	}
+	if bucket, _ := getBucketAndObject(r); bucket != "" {
+		bucket = accountBucket(identity.account(), bucket)
+		if limiter := iam.bucketLimiters.get(bucket, iam.bucketRateLimits[bucket]); limiter != nil {
+			limiters = append(limiters, limiter)
+		}
+	}
+	if len(limiters) == 0 {
+		f(w, r)
+		return
+	}
+
+	write := r.Method != http.MethodGet && r.Method != http.MethodHead
+	for i, limiter := range limiters {
+		if !limiter.Allow(write) {
+			// the rejected request is not counted by the limiters allowing it
+			for _, allowed := range limiters[:i] {
+				allowed.Cancel(write)
+			}
+			glog.V(2).Infof("slow down %s %s", r.Method, r.URL)
+			writeErrorResponse(w, s3err.ErrSlowDown, r.URL)
+			return
+		}
+	}
+
+	if write {
+		r.Body = s3ratelimit.NewReader(r.Body, limiters)
+	} else {
+		w = s3ratelimit.NewResponseWriter(w, limiters)
+	}
+	f(w, r)
+}
+
+// requestAccessKey returns the access key of the signed or presigned request, or "" for anonymous requests
+func requestAccessKey(r *http.Request) string {
+	if authorization := r.Header.Get("Authorization"); strings.HasPrefix(authorization, signV4Algorithm) {
+		if signV4Values, errCode := parseSignV4(authorization); errCode == s3err.ErrNone {
+			return signV4Values.Credential.accessKey
+		}
+		return ""
+	} else if strings.HasPrefix(authorization, signV2Algorithm+" ") {
+		return strings.SplitN(strings.TrimPrefix(authorization, signV2Algorithm+" "), ":", 2)[0]
+	}
+	query := r.URL.Query()
+	if credential := query.Get("X-Amz-Credential"); credential != "" {
+		return strings.SplitN(credential, "/", 2)[0]
+	}
+	return query.Get("AWSAccessKeyId")
+}
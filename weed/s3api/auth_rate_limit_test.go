@@ -0,0 +1,71 @@
+package s3api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3ratelimit"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestAccessKey(t *testing.T) {
+
+	r := httptest.NewRequest("GET", "/bucket/a.txt", nil)
+	assert.Equal(t, "", requestAccessKey(r))
+
+	r.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=some_access_key/20130524/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-date, Signature=abcdef")
+	assert.Equal(t, "some_access_key", requestAccessKey(r))
+
+	r.Header.Set("Authorization", "AWS some_access_key:signature")
+	assert.Equal(t, "some_access_key", requestAccessKey(r))
+
+	r = httptest.NewRequest("GET", "/bucket/a.txt?X-Amz-Credential=some_access_key%2F20130524%2Fus-east-1%2Fs3%2Faws4_request", nil)
+	assert.Equal(t, "some_access_key", requestAccessKey(r))
+
+	r = httptest.NewRequest("GET", "/bucket/a.txt?AWSAccessKeyId=some_access_key", nil)
+	assert.Equal(t, "some_access_key", requestAccessKey(r))
+
+}
+
+func TestBucketRateLimit(t *testing.T) {
+
+	iam := &IdentityAccessManagement{
+		bucketRateLimits: map[string]s3ratelimit.Limit{
+			"limited":      {ReadRequests: 1},
+			"tenant1@logs": {ReadRequests: 1},
+		},
+	}
+	ok := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+	serveAs := func(identity *Identity, method, bucket string) int {
+		r := mux.SetURLVars(httptest.NewRequest(method, "/"+bucket+"/a.txt", nil), map[string]string{"bucket": bucket, "object": "a.txt"})
+		r.Header.Set("Authorization", "AWS some_access_key:signature")
+		w := httptest.NewRecorder()
+		iam.serveLimited(w, r, identity, ok)
+		return w.Code
+	}
+	serve := func(method, bucket string) int {
+		return serveAs(nil, method, bucket)
+	}
+
+	assert.Equal(t, http.StatusOK, serve("GET", "limited"))
+	assert.Equal(t, http.StatusServiceUnavailable, serve("GET", "limited"))
+
+	// writes and other buckets are not limited
+	assert.Equal(t, http.StatusOK, serve("PUT", "limited"))
+	assert.Equal(t, http.StatusOK, serve("GET", "other"))
+	assert.Equal(t, http.StatusOK, serve("GET", "other"))
+
+	// the bucket of an account is limited by its name in the filer,
+	// and the requests rejected by the bucket are not counted for the access key
+	alice := &Identity{Name: "alice", Account: "tenant1", RateLimit: s3ratelimit.Limit{ReadRequests: 2}}
+	assert.Equal(t, http.StatusOK, serve("GET", "logs"))
+	assert.Equal(t, http.StatusOK, serveAs(alice, "GET", "logs"))
+	assert.Equal(t, http.StatusServiceUnavailable, serveAs(alice, "GET", "tenant1@logs"))
+	assert.Equal(t, http.StatusOK, serveAs(alice, "GET", "other"))
+	assert.Equal(t, http.StatusServiceUnavailable, serveAs(alice, "GET", "other"))
+
+}
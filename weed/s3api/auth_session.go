@@ -0,0 +1,113 @@
+package s3api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/s3api/bucketpolicy"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3sts"
+)
+
+const (
+	defaultRoleSessionDuration = time.Hour
+	maxRoleSessionDuration     = 12 * time.Hour
+)
+
+// lookupSession verifies the session token, and returns the identity with the current permissions
+// of the parent identity or the assumed role, so removing them also revokes the temporary credentials.
+func (iam *IdentityAccessManagement) lookupSession(accessKey, sessionToken string) (*Identity, *Credential, s3err.ErrorCode) {
+
+	claims, err := s3sts.ParseSession(iam.sessionSigningKey, accessKey, sessionToken)
+	if err == s3sts.ErrExpiredToken {
+		return nil, nil, s3err.ErrExpiredToken
+	}
+	if err != nil {
+		glog.V(1).Infof("session token of %s: %v", accessKey, err)
+		return nil, nil, s3err.ErrInvalidToken
+	}
+
+	identity := &Identity{}
+	if claims.Role != "" {
+		role, found := iam.lookupRole(claims.Role)
+		if !found {
+			return nil, nil, s3err.ErrInvalidToken
+		}
+		identity.Name = role.Name
+		identity.Actions = role.Actions
+		identity.PolicyNames = role.PolicyNames
+		identity.Account = role.Account
+	} else {
+		parent, found := iam.lookupByName(claims.Parent)
+		if !found {
+			return nil, nil, s3err.ErrInvalidToken
+		}
+		identity.Name = parent.Name
+		identity.Actions = parent.Actions
+		identity.PolicyNames = parent.PolicyNames
+		identity.QuotaBytes = parent.QuotaBytes
+		identity.QuotaObjects = parent.QuotaObjects
+		identity.Account = parent.Account
+		identity.RateLimit = parent.RateLimit
+	}
+	if claims.Policy != "" {
+		if identity.SessionPolicy, err = bucketpolicy.Parse([]byte(claims.Policy)); err != nil {
+			return nil, nil, s3err.ErrInvalidToken
+		}
+	}
+
+	cred := &Credential{
+		AccessKey: accessKey,
+		SecretKey: s3sts.SecretKey(iam.sessionSigningKey, accessKey),
+	}
+	identity.Credentials = []*Credential{cred}
+	return identity, cred, s3err.ErrNone
+}
+
+// sessionAllows checks the request against the session policy of the temporary credentials, if any
+func (identity *Identity) sessionAllows(r *http.Request, bucket, object string) bool {
+	if identity == nil || identity.SessionPolicy == nil {
+		return true
+	}
+	if object == "/" {
+		object = ""
+	}
+	return identity.SessionPolicy.Evaluate(&bucketpolicy.Args{
+		Account:    identity.Name,
+		Action:     s3ActionName(r, object),
+		Resource:   bucketpolicy.ResourceArnPrefix + bucket + object,
+		Conditions: requestConditions(r, identity.Name),
+	}) == bucketpolicy.Allow
+}
+
+func (role *Role) trustsIdentity(name string) bool {
+	for _, trusted := range role.TrustedIdentities {
+		if trusted == name || trusted == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func (role *Role) trustsWebIdentity(webIdentity *s3sts.WebIdentity) bool {
+	trusted := false
+	for _, provider := range role.TrustedProviders {
+		if provider == webIdentity.Provider {
+			trusted = true
+			break
+		}
+	}
+	if !trusted {
+		return false
+	}
+	if len(role.TrustedSubjects) == 0 {
+		return true
+	}
+	for _, subject := range role.TrustedSubjects {
+		if bucketpolicy.WildcardMatch(subject, webIdentity.Subject) {
+			return true
+		}
+	}
+	return false
+}
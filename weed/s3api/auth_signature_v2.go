@@ -23,6 +23,7 @@ import (
 	"crypto/subtle"
 	"encoding/base64"
 	"fmt"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
 	"net"
 	"net/http"
@@ -69,18 +70,18 @@ func (iam *IdentityAccessManagement) isReqAuthenticatedV2(r *http.Request) (*Ide
 	return iam.doesPresignV2SignatureMatch(r)
 }
 
-func (iam *IdentityAccessManagement) doesPolicySignatureV2Match(formValues http.Header) s3err.ErrorCode {
+func (iam *IdentityAccessManagement) doesPolicySignatureV2Match(formValues http.Header) (*Identity, s3err.ErrorCode) {
 	accessKey := formValues.Get("AWSAccessKeyId")
-	_, cred, found := iam.lookupByAccessKey(accessKey)
-	if !found {
-		return s3err.ErrInvalidAccessKeyID
+	identity, cred, errCode := iam.lookupCredential(accessKey, formValues.Get(xhttp.AmzSecurityToken))
+	if errCode != s3err.ErrNone {
+		return nil, errCode
 	}
 	policy := formValues.Get("Policy")
 	signature := formValues.Get("Signature")
 	if !compareSignatureV2(signature, calculateSignatureV2(policy, cred.SecretKey)) {
-		return s3err.ErrSignatureDoesNotMatch
+		return nil, s3err.ErrSignatureDoesNotMatch
 	}
-	return s3err.ErrNone
+	return identity, s3err.ErrNone
 }
 
 // Authorization = "AWS" + " " + AWSAccessKeyId + ":" + Signature;
@@ -138,9 +139,9 @@ func (iam *IdentityAccessManagement) doesSignV2Match(r *http.Request) (*Identity
 
 	// Access credentials.
 	// Validate if access key id same.
-	ident, cred, found := iam.lookupByAccessKey(accessKey)
-	if !found {
-		return nil, s3err.ErrInvalidAccessKeyID
+	ident, cred, errCode := iam.lookupCredential(accessKey, r.Header.Get(xhttp.AmzSecurityToken))
+	if errCode != s3err.ErrNone {
+		return nil, errCode
 	}
 
 	// r.RequestURI will have raw encoded URI as sent by the client.
@@ -224,9 +225,9 @@ func (iam *IdentityAccessManagement) doesPresignV2SignatureMatch(r *http.Request
 	}
 
 	// Validate if access key id same.
-	ident, cred, found := iam.lookupByAccessKey(accessKey)
-	if !found {
-		return nil, s3err.ErrInvalidAccessKeyID
+	ident, cred, errCode := iam.lookupCredential(accessKey, r.URL.Query().Get(xhttp.AmzSecurityToken))
+	if errCode != s3err.ErrNone {
+		return nil, errCode
 	}
 
 	// Make sure the request has not expired.
@@ -23,6 +23,7 @@ import (
 	"crypto/sha256"
 	"crypto/subtle"
 	"encoding/hex"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
 	"io/ioutil"
 	"net/http"
@@ -112,9 +113,9 @@ func (iam *IdentityAccessManagement) doesSignatureMatch(hashedPayload string, r
 	}
 
 	// Verify if the access key id matches.
-	identity, cred, found := iam.lookupByAccessKey(signV4Values.Credential.accessKey)
-	if !found {
-		return nil, s3err.ErrInvalidAccessKeyID
+	identity, cred, errCode := iam.lookupCredential(signV4Values.Credential.accessKey, r.Header.Get(xhttp.AmzSecurityToken))
+	if errCode != s3err.ErrNone {
+		return nil, errCode
 	}
 
 	// Extract date, if not present throw error.
@@ -310,18 +311,18 @@ func parseSignature(signElement string) (string, s3err.ErrorCode) {
 
 // doesPolicySignatureMatch - Verify query headers with post policy
 //     - http://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-HTTPPOSTConstructPolicy.html
-// returns ErrNone if the signature matches.
-func (iam *IdentityAccessManagement) doesPolicySignatureV4Match(formValues http.Header) s3err.ErrorCode {
+// returns the identity of the access key and ErrNone if the signature matches.
+func (iam *IdentityAccessManagement) doesPolicySignatureV4Match(formValues http.Header) (*Identity, s3err.ErrorCode) {
 
 	// Parse credential tag.
 	credHeader, err := parseCredentialHeader("Credential=" + formValues.Get("X-Amz-Credential"))
 	if err != s3err.ErrNone {
-		return s3err.ErrMissingFields
+		return nil, s3err.ErrMissingFields
 	}
 
-	_, cred, found := iam.lookupByAccessKey(credHeader.accessKey)
-	if !found {
-		return s3err.ErrInvalidAccessKeyID
+	identity, cred, errCode := iam.lookupCredential(credHeader.accessKey, formValues.Get(xhttp.AmzSecurityToken))
+	if errCode != s3err.ErrNone {
+		return nil, errCode
 	}
 
 	// Get signing key.
@@ -332,11 +333,11 @@ func (iam *IdentityAccessManagement) doesPolicySignatureV4Match(formValues http.
 
 	// Verify signature.
 	if !compareSignatureV4(newSignature, formValues.Get("X-Amz-Signature")) {
-		return s3err.ErrSignatureDoesNotMatch
+		return nil, s3err.ErrSignatureDoesNotMatch
 	}
 
 	// Success.
-	return s3err.ErrNone
+	return identity, s3err.ErrNone
 }
 
 // check query headers with presigned signature
@@ -353,9 +354,9 @@ func (iam *IdentityAccessManagement) doesPresignedSignatureMatch(hashedPayload s
 	}
 
 	// Verify if the access key id matches.
-	identity, cred, found := iam.lookupByAccessKey(pSignValues.Credential.accessKey)
-	if !found {
-		return nil, s3err.ErrInvalidAccessKeyID
+	identity, cred, errCode := iam.lookupCredential(pSignValues.Credential.accessKey, req.URL.Query().Get(xhttp.AmzSecurityToken))
+	if errCode != s3err.ErrNone {
+		return nil, errCode
 	}
 
 	// Extract all the signed headers along with its values.
@@ -393,15 +394,10 @@ func (iam *IdentityAccessManagement) doesPresignedSignatureMatch(hashedPayload s
 	query.Set("X-Amz-SignedHeaders", getSignedHeaders(extractedSignedHeaders))
 	query.Set("X-Amz-Credential", cred.AccessKey+"/"+getScope(t, pSignValues.Credential.scope.region))
 
-	// Save other headers available in the request parameters.
+	// Save other parameters available in the request, all of them are signed except the signature itself,
+	// e.g., the multipart upload id and part number, the metadata, or the x-amz-acl of a presigned put.
 	for k, v := range req.URL.Query() {
-
-		// Handle the metadata in presigned put query string
-		if strings.Contains(strings.ToLower(k), "x-amz-meta-") {
-			query.Set(k, v[0])
-		}
-
-		if strings.HasPrefix(strings.ToLower(k), "x-amz") {
+		if _, found := query[k]; found || k == "X-Amz-Signature" {
 			continue
 		}
 		query[k] = v
@@ -0,0 +1,115 @@
+package bucketpolicy
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// condition keys filled in from the request
+const (
+	KeySourceIp        = "aws:SourceIp"
+	KeySecureTransport = "aws:SecureTransport"
+	KeyReferer         = "aws:Referer"
+	KeyUserAgent       = "aws:UserAgent"
+	KeyUsername        = "aws:username"
+	KeyPrefix          = "s3:prefix"
+	KeyDelimiter       = "s3:delimiter"
+	KeyMaxKeys         = "s3:max-keys"
+	KeyVersionId       = "s3:VersionId"
+)
+
+// a condition operator checks the values in the policy against the values of the request,
+// where the request values are empty if the request does not have the condition key
+type conditionOperator func(policyValues, requestValues []string) bool
+
+var conditionOperators = map[string]conditionOperator{
+	"StringEquals": func(policyValues, requestValues []string) bool {
+		return anyMatch(policyValues, requestValues, func(p, r string) bool { return p == r })
+	},
+	"StringNotEquals": func(policyValues, requestValues []string) bool {
+		return !anyMatch(policyValues, requestValues, func(p, r string) bool { return p == r })
+	},
+	"StringEqualsIgnoreCase": func(policyValues, requestValues []string) bool {
+		return anyMatch(policyValues, requestValues, strings.EqualFold)
+	},
+	"StringNotEqualsIgnoreCase": func(policyValues, requestValues []string) bool {
+		return !anyMatch(policyValues, requestValues, strings.EqualFold)
+	},
+	"StringLike": func(policyValues, requestValues []string) bool {
+		return anyMatch(policyValues, requestValues, WildcardMatch)
+	},
+	"StringNotLike": func(policyValues, requestValues []string) bool {
+		return !anyMatch(policyValues, requestValues, WildcardMatch)
+	},
+	"Bool": func(policyValues, requestValues []string) bool {
+		return anyMatch(policyValues, requestValues, strings.EqualFold)
+	},
+	"IpAddress": func(policyValues, requestValues []string) bool {
+		return ipMatch(policyValues, requestValues)
+	},
+	"NotIpAddress": func(policyValues, requestValues []string) bool {
+		return !ipMatch(policyValues, requestValues)
+	},
+	"Null": func(policyValues, requestValues []string) bool {
+		isNull := len(requestValues) == 0
+		for _, p := range policyValues {
+			if strings.EqualFold(p, "true") != isNull {
+				return false
+			}
+		}
+		return true
+	},
+}
+
+func anyMatch(policyValues, requestValues []string, fn func(p, r string) bool) bool {
+	for _, r := range requestValues {
+		for _, p := range policyValues {
+			if fn(p, r) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func ipMatch(policyValues, requestValues []string) bool {
+	networks, err := parseCIDRs(policyValues)
+	if err != nil {
+		return false
+	}
+	for _, r := range requestValues {
+		ip := net.ParseIP(r)
+		if ip == nil {
+			continue
+		}
+		for _, network := range networks {
+			if network.Contains(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parseCIDRs accepts both "1.2.3.0/24" and a single address "1.2.3.4"
+func parseCIDRs(values []string) (networks []*net.IPNet, err error) {
+	for _, value := range values {
+		if !strings.Contains(value, "/") {
+			if ip := net.ParseIP(value); ip != nil {
+				bits := 8 * len(ip.To16())
+				if ip.To4() != nil {
+					ip, bits = ip.To4(), 32
+				}
+				networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+				continue
+			}
+		}
+		_, network, parseErr := net.ParseCIDR(value)
+		if parseErr != nil {
+			return nil, fmt.Errorf("invalid ip address %s: %v", value, parseErr)
+		}
+		networks = append(networks, network)
+	}
+	return
+}
@@ -0,0 +1,320 @@
+package bucketpolicy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Policy is the S3 bucket policy document.
+// Reference: https://docs.aws.amazon.com/AmazonS3/latest/userguide/access-policy-language-overview.html
+type Policy struct {
+	Version   string      `json:"Version"`
+	Id        string      `json:"Id,omitempty"`
+	Statement []Statement `json:"Statement"`
+}
+
+type Statement struct {
+	Sid       string                          `json:"Sid,omitempty"`
+	Effect    string                          `json:"Effect"`
+	Principal *Principal                      `json:"Principal,omitempty"`
+	Action    StringSet                       `json:"Action,omitempty"`
+	NotAction StringSet                       `json:"NotAction,omitempty"`
+	Resource  StringSet                       `json:"Resource,omitempty"`
+	Condition map[string]map[string]StringSet `json:"Condition,omitempty"`
+}
+
+const (
+	EffectAllow = "Allow"
+	EffectDeny  = "Deny"
+
+	ResourceArnPrefix = "arn:aws:s3:::"
+)
+
+type Decision int
+
+const (
+	NotApplicable Decision = iota
+	Allow
+	Deny
+)
+
+// Args describes one request to check against the policy
+type Args struct {
+	Account    string // the identity name, empty for anonymous requests
+	Action     string // e.g. "s3:GetObject"
+	Resource   string // e.g. "arn:aws:s3:::bucket/key"
+	Conditions map[string][]string
+}
+
+var (
+	ErrInvalidVersion   = errors.New("policy Version must be 2012-10-17 or 2008-10-17")
+	ErrNoStatement      = errors.New("policy must have at least one statement")
+	ErrInvalidEffect    = errors.New("statement Effect must be Allow or Deny")
+	ErrMissingPrincipal = errors.New("statement must have a Principal")
+	ErrMissingAction    = errors.New("statement must have an Action or NotAction")
+	ErrMissingResource  = errors.New("statement must have a Resource")
+)
+
+func Parse(data []byte) (*Policy, error) {
+	p := &Policy{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Validate checks the policy is well formed, and only refers to resources of the bucket
+func (p *Policy) Validate(bucket string) error {
+	if p.Version != "2012-10-17" && p.Version != "2008-10-17" {
+		return ErrInvalidVersion
+	}
+	if len(p.Statement) == 0 {
+		return ErrNoStatement
+	}
+	for _, s := range p.Statement {
+		if s.Effect != EffectAllow && s.Effect != EffectDeny {
+			return ErrInvalidEffect
+		}
+		if s.Principal == nil || (!s.Principal.Any && len(s.Principal.AWS) == 0) {
+			return ErrMissingPrincipal
+		}
+		if len(s.Action) == 0 && len(s.NotAction) == 0 {
+			return ErrMissingAction
+		}
+		if len(s.Resource) == 0 {
+			return ErrMissingResource
+		}
+		for _, resource := range s.Resource {
+			if !strings.HasPrefix(resource, ResourceArnPrefix) {
+				return fmt.Errorf("resource %s must start with %s", resource, ResourceArnPrefix)
+			}
+			resourceBucket := strings.SplitN(resource[len(ResourceArnPrefix):], "/", 2)[0]
+			if !WildcardMatch(resourceBucket, bucket) {
+				return fmt.Errorf("resource %s does not refer to bucket %s", resource, bucket)
+			}
+		}
+		for operator, conditions := range s.Condition {
+			if _, found := conditionOperators[operator]; !found {
+				return fmt.Errorf("condition operator %s is not supported", operator)
+			}
+			if operator == "IpAddress" || operator == "NotIpAddress" {
+				for _, values := range conditions {
+					if _, err := parseCIDRs(values); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateIdentityPolicy checks a policy of identities, groups or roles, or the session policy of
+// temporary credentials, which has no principal, and can refer to any bucket
+func (p *Policy) ValidateIdentityPolicy() error {
+	if p.Version != "2012-10-17" && p.Version != "2008-10-17" {
+		return ErrInvalidVersion
+	}
+	if len(p.Statement) == 0 {
+		return ErrNoStatement
+	}
+	for _, s := range p.Statement {
+		if s.Effect != EffectAllow && s.Effect != EffectDeny {
+			return ErrInvalidEffect
+		}
+		if s.Principal != nil {
+			return fmt.Errorf("identity policy statement must not have a Principal")
+		}
+		if len(s.Action) == 0 && len(s.NotAction) == 0 {
+			return ErrMissingAction
+		}
+		if len(s.Resource) == 0 {
+			return ErrMissingResource
+		}
+		for _, resource := range s.Resource {
+			if resource != "*" && !strings.HasPrefix(resource, ResourceArnPrefix) {
+				return fmt.Errorf("resource %s must be * or start with %s", resource, ResourceArnPrefix)
+			}
+		}
+		for operator := range s.Condition {
+			if _, found := conditionOperators[operator]; !found {
+				return fmt.Errorf("condition operator %s is not supported", operator)
+			}
+		}
+	}
+	return nil
+}
+
+// Evaluate checks the request against all statements. An explicit Deny overrides any Allow.
+func (p *Policy) Evaluate(args *Args) Decision {
+	decision := NotApplicable
+	for i := range p.Statement {
+		s := &p.Statement[i]
+		if !s.matches(args) {
+			continue
+		}
+		if s.Effect == EffectDeny {
+			return Deny
+		}
+		decision = Allow
+	}
+	return decision
+}
+
+func (s *Statement) matches(args *Args) bool {
+	// the session policies have no principal
+	if s.Principal != nil && !s.Principal.matches(args.Account) {
+		return false
+	}
+	if len(s.Action) > 0 && !s.Action.matchesIgnoreCase(args.Action) {
+		return false
+	}
+	if len(s.NotAction) > 0 && s.NotAction.matchesIgnoreCase(args.Action) {
+		return false
+	}
+	if !args.resolve(s.Resource).matches(args.Resource) {
+		return false
+	}
+	for operator, conditions := range s.Condition {
+		fn := conditionOperators[operator]
+		for key, values := range conditions {
+			if !fn(args.resolve(values), args.Conditions[key]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// resolve replaces the ${aws:username} policy variable with the identity name
+func (args *Args) resolve(patterns StringSet) StringSet {
+	if args.Account == "" {
+		return patterns
+	}
+	resolved := make(StringSet, len(patterns))
+	for i, pattern := range patterns {
+		resolved[i] = strings.ReplaceAll(pattern, "${aws:username}", args.Account)
+	}
+	return resolved
+}
+
+// StringSet accepts either a single string or a list of strings
+type StringSet []string
+
+func (ss *StringSet) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*ss = StringSet{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*ss = list
+	return nil
+}
+
+func (ss StringSet) matches(value string) bool {
+	for _, pattern := range ss {
+		if WildcardMatch(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ss StringSet) matchesIgnoreCase(value string) bool {
+	for _, pattern := range ss {
+		if WildcardMatch(strings.ToLower(pattern), strings.ToLower(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Principal is either "*" for everyone, including anonymous requests, or {"AWS": [...]}
+type Principal struct {
+	Any bool
+	AWS StringSet
+}
+
+func (p *Principal) UnmarshalJSON(data []byte) error {
+	var wildcard string
+	if err := json.Unmarshal(data, &wildcard); err == nil {
+		if wildcard != "*" {
+			return fmt.Errorf("invalid principal %s", wildcard)
+		}
+		p.Any = true
+		return nil
+	}
+	var principals struct {
+		AWS StringSet `json:"AWS"`
+	}
+	if err := json.Unmarshal(data, &principals); err != nil {
+		return err
+	}
+	p.AWS = principals.AWS
+	for _, account := range p.AWS {
+		if account == "*" {
+			p.Any = true
+		}
+	}
+	return nil
+}
+
+func (p *Principal) MarshalJSON() ([]byte, error) {
+	if p.Any && len(p.AWS) == 0 {
+		return json.Marshal("*")
+	}
+	return json.Marshal(map[string]StringSet{"AWS": p.AWS})
+}
+
+// matches compares with the identity name, either directly or as "arn:aws:iam::<account>:user/<name>"
+func (p *Principal) matches(account string) bool {
+	if p.Any {
+		return true
+	}
+	if account == "" {
+		return false
+	}
+	for _, principal := range p.AWS {
+		if principal == account {
+			return true
+		}
+		if strings.HasPrefix(principal, "arn:aws:iam::") {
+			if i := strings.LastIndex(principal, ":user/"); i > 0 && WildcardMatch(principal[i+len(":user/"):], account) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WildcardMatch matches the value against a pattern, where "*" matches any characters, including "/",
+// and "?" matches any single character.
+func WildcardMatch(pattern, value string) bool {
+	p, v := 0, 0
+	starP, starV := -1, 0
+	for v < len(value) {
+		if p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]) {
+			p++
+			v++
+		} else if p < len(pattern) && pattern[p] == '*' {
+			starP, starV = p, v
+			p++
+		} else if starP >= 0 {
+			p = starP + 1
+			starV++
+			v = starV
+		} else {
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
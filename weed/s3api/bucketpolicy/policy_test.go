@@ -0,0 +1,100 @@
+package bucketpolicy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicReadPolicy(t *testing.T) {
+
+	input := `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "PublicRead",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": ["s3:GetObject"],
+      "Resource": "arn:aws:s3:::assets/*"
+    },
+    {
+      "Sid": "DenyInsecure",
+      "Effect": "Deny",
+      "Principal": {"AWS": "*"},
+      "Action": "s3:*",
+      "Resource": ["arn:aws:s3:::assets", "arn:aws:s3:::assets/*"],
+      "Condition": {"Bool": {"aws:SecureTransport": "false"}}
+    }
+  ]
+}`
+
+	p, err := Parse([]byte(input))
+	assert.Nil(t, err)
+	assert.Nil(t, p.Validate("assets"))
+	assert.NotNil(t, p.Validate("other"))
+
+	secure := map[string][]string{KeySecureTransport: {"true"}}
+	insecure := map[string][]string{KeySecureTransport: {"false"}}
+
+	assert.Equal(t, Allow, p.Evaluate(&Args{Action: "s3:GetObject", Resource: "arn:aws:s3:::assets/css/site.css", Conditions: secure}))
+	assert.Equal(t, NotApplicable, p.Evaluate(&Args{Action: "s3:PutObject", Resource: "arn:aws:s3:::assets/css/site.css", Conditions: secure}))
+	assert.Equal(t, NotApplicable, p.Evaluate(&Args{Action: "s3:ListBucket", Resource: "arn:aws:s3:::assets", Conditions: secure}))
+	assert.Equal(t, Deny, p.Evaluate(&Args{Account: "admin", Action: "s3:GetObject", Resource: "arn:aws:s3:::assets/a.png", Conditions: insecure}))
+
+	data, err := json.Marshal(p)
+	assert.Nil(t, err)
+	reparsed, err := Parse(data)
+	assert.Nil(t, err)
+	assert.Equal(t, p, reparsed)
+
+}
+
+func TestPrincipalAndConditions(t *testing.T) {
+
+	p := &Policy{
+		Version: "2012-10-17",
+		Statement: []Statement{
+			{
+				Effect:    EffectAllow,
+				Principal: &Principal{AWS: StringSet{"arn:aws:iam::123456789012:user/alice", "bob"}},
+				Action:    StringSet{"s3:ListBucket"},
+				Resource:  StringSet{"arn:aws:s3:::data"},
+				Condition: map[string]map[string]StringSet{
+					"StringLike": {KeyPrefix: {"home/${aws:username}/*", "shared/*"}},
+					"IpAddress":  {KeySourceIp: {"10.0.0.0/8", "192.168.1.1"}},
+				},
+			},
+		},
+	}
+	assert.Nil(t, p.Validate("data"))
+
+	allowed := map[string][]string{KeyPrefix: {"shared/docs/"}, KeySourceIp: {"10.1.2.3"}}
+	assert.Equal(t, Allow, p.Evaluate(&Args{Account: "alice", Action: "s3:ListBucket", Resource: "arn:aws:s3:::data", Conditions: allowed}))
+	assert.Equal(t, Allow, p.Evaluate(&Args{Account: "bob", Action: "s3:listbucket", Resource: "arn:aws:s3:::data", Conditions: allowed}))
+	assert.Equal(t, NotApplicable, p.Evaluate(&Args{Account: "", Action: "s3:ListBucket", Resource: "arn:aws:s3:::data", Conditions: allowed}))
+	assert.Equal(t, NotApplicable, p.Evaluate(&Args{Account: "carol", Action: "s3:ListBucket", Resource: "arn:aws:s3:::data", Conditions: allowed}))
+
+	home := map[string][]string{KeyPrefix: {"home/alice/photos/"}, KeySourceIp: {"10.1.2.3"}}
+	assert.Equal(t, Allow, p.Evaluate(&Args{Account: "alice", Action: "s3:ListBucket", Resource: "arn:aws:s3:::data", Conditions: home}))
+	assert.Equal(t, NotApplicable, p.Evaluate(&Args{Account: "bob", Action: "s3:ListBucket", Resource: "arn:aws:s3:::data", Conditions: home}))
+
+	wrongIp := map[string][]string{KeyPrefix: {"shared/docs/"}, KeySourceIp: {"192.168.1.2"}}
+	assert.Equal(t, NotApplicable, p.Evaluate(&Args{Account: "alice", Action: "s3:ListBucket", Resource: "arn:aws:s3:::data", Conditions: wrongIp}))
+
+	noPrefix := map[string][]string{KeySourceIp: {"192.168.1.1"}}
+	assert.Equal(t, NotApplicable, p.Evaluate(&Args{Account: "alice", Action: "s3:ListBucket", Resource: "arn:aws:s3:::data", Conditions: noPrefix}))
+
+	p.Statement[0].Condition["NumericLessThan"] = map[string]StringSet{KeyMaxKeys: {"10"}}
+	assert.NotNil(t, p.Validate("data"))
+
+}
+
+func TestWildcardMatch(t *testing.T) {
+	assert.True(t, WildcardMatch("*", ""))
+	assert.True(t, WildcardMatch("arn:aws:s3:::b/*", "arn:aws:s3:::b/x/y/z"))
+	assert.True(t, WildcardMatch("a?c*", "abcdef"))
+	assert.False(t, WildcardMatch("arn:aws:s3:::b/*", "arn:aws:s3:::b"))
+	assert.False(t, WildcardMatch("a*c", "abcd"))
+}
@@ -24,6 +24,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
 	"hash"
 	"io"
@@ -85,9 +86,9 @@ func (iam *IdentityAccessManagement) calculateSeedSignature(r *http.Request) (cr
 		return nil, "", "", time.Time{}, errCode
 	}
 	// Verify if the access key id matches.
-	_, cred, found := iam.lookupByAccessKey(signV4Values.Credential.accessKey)
-	if !found {
-		return nil, "", "", time.Time{}, s3err.ErrInvalidAccessKeyID
+	_, cred, errCode = iam.lookupCredential(signV4Values.Credential.accessKey, req.Header.Get(xhttp.AmzSecurityToken))
+	if errCode != s3err.ErrNone {
+		return nil, "", "", time.Time{}, errCode
 	}
 
 	// Verify if region is valid.
@@ -0,0 +1,165 @@
+package cors
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+)
+
+// CORSConfiguration is the S3 bucket cross-origin resource sharing configuration.
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketCors.html
+type CORSConfiguration struct {
+	XMLName xml.Name   `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CORSConfiguration"`
+	Rules   []CORSRule `xml:"CORSRule"`
+}
+
+type CORSRule struct {
+	ID             string   `xml:"ID,omitempty"`
+	AllowedHeaders []string `xml:"AllowedHeader,omitempty"`
+	AllowedMethods []string `xml:"AllowedMethod"`
+	AllowedOrigins []string `xml:"AllowedOrigin"`
+	ExposeHeaders  []string `xml:"ExposeHeader,omitempty"`
+	MaxAgeSeconds  int      `xml:"MaxAgeSeconds,omitempty"`
+}
+
+const maxRules = 100
+
+var (
+	ErrNoRules        = errors.New("CORS configuration must have at least one rule")
+	ErrTooManyRules   = errors.New("CORS configuration allows at most 100 rules")
+	ErrNoOrigin       = errors.New("CORS rule must have at least one AllowedOrigin")
+	ErrInvalidMethod  = errors.New("CORS rule AllowedMethod must be one of GET, PUT, HEAD, POST, DELETE")
+	ErrInvalidOrigin  = errors.New("CORS rule AllowedOrigin can contain at most one wildcard")
+	ErrInvalidHeader  = errors.New("CORS rule AllowedHeader can contain at most one wildcard")
+	ErrInvalidMaxAge  = errors.New("CORS rule MaxAgeSeconds must not be negative")
+	ErrRuleIdTooLong  = errors.New("CORS rule ID must be less than 255 characters")
+	supportedMethods  = map[string]bool{"GET": true, "PUT": true, "HEAD": true, "POST": true, "DELETE": true}
+	errInvalidPattern = errors.New("invalid wildcard pattern")
+)
+
+func Parse(data []byte) (*CORSConfiguration, error) {
+	c := &CORSConfiguration{}
+	if err := xml.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *CORSConfiguration) Validate() error {
+	if len(c.Rules) == 0 {
+		return ErrNoRules
+	}
+	if len(c.Rules) > maxRules {
+		return ErrTooManyRules
+	}
+	for i := range c.Rules {
+		if err := c.Rules[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *CORSRule) Validate() error {
+	if len(r.ID) > 255 {
+		return ErrRuleIdTooLong
+	}
+	if len(r.AllowedOrigins) == 0 {
+		return ErrNoOrigin
+	}
+	if len(r.AllowedMethods) == 0 {
+		return ErrInvalidMethod
+	}
+	for _, method := range r.AllowedMethods {
+		if !supportedMethods[method] {
+			return ErrInvalidMethod
+		}
+	}
+	for _, origin := range r.AllowedOrigins {
+		if validatePattern(origin) != nil {
+			return ErrInvalidOrigin
+		}
+	}
+	for _, header := range r.AllowedHeaders {
+		if validatePattern(header) != nil {
+			return ErrInvalidHeader
+		}
+	}
+	if r.MaxAgeSeconds < 0 {
+		return ErrInvalidMaxAge
+	}
+	return nil
+}
+
+func validatePattern(pattern string) error {
+	if strings.Count(pattern, "*") > 1 {
+		return errInvalidPattern
+	}
+	return nil
+}
+
+// wildcardMatch matches a value against a pattern with at most one "*"
+func wildcardMatch(pattern, value string) bool {
+	i := strings.Index(pattern, "*")
+	if i < 0 {
+		return pattern == value
+	}
+	prefix, suffix := pattern[:i], pattern[i+1:]
+	return len(value) >= len(prefix)+len(suffix) && strings.HasPrefix(value, prefix) && strings.HasSuffix(value, suffix)
+}
+
+// Match returns the first rule which allows the origin, the method,
+// and all the headers a preflight request asks for, or nil.
+func (c *CORSConfiguration) Match(origin, method string, requestHeaders []string) *CORSRule {
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.allowsOrigin(origin) && rule.allowsMethod(method) && rule.allowsHeaders(requestHeaders) {
+			return rule
+		}
+	}
+	return nil
+}
+
+func (r *CORSRule) allowsOrigin(origin string) bool {
+	for _, pattern := range r.AllowedOrigins {
+		if wildcardMatch(pattern, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *CORSRule) allowsMethod(method string) bool {
+	for _, m := range r.AllowedMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *CORSRule) allowsHeaders(requestHeaders []string) bool {
+	for _, header := range requestHeaders {
+		allowed := false
+		for _, pattern := range r.AllowedHeaders {
+			if wildcardMatch(strings.ToLower(pattern), strings.ToLower(header)) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
+
+// AllowsAnyOrigin is true if the rule matches any origin with the "*" wildcard
+func (r *CORSRule) AllowsAnyOrigin() bool {
+	for _, origin := range r.AllowedOrigins {
+		if origin == "*" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package cors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAndValidate(t *testing.T) {
+
+	input := `<CORSConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <CORSRule>
+    <AllowedOrigin>https://*.example.com</AllowedOrigin>
+    <AllowedMethod>PUT</AllowedMethod>
+    <AllowedMethod>POST</AllowedMethod>
+    <AllowedHeader>x-amz-*</AllowedHeader>
+    <AllowedHeader>Content-Type</AllowedHeader>
+    <ExposeHeader>ETag</ExposeHeader>
+    <MaxAgeSeconds>3000</MaxAgeSeconds>
+  </CORSRule>
+  <CORSRule>
+    <AllowedOrigin>*</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+  </CORSRule>
+</CORSConfiguration>`
+
+	c, err := Parse([]byte(input))
+	assert.Nil(t, err)
+	assert.Nil(t, c.Validate())
+	assert.Equal(t, 2, len(c.Rules))
+	assert.Equal(t, []string{"x-amz-*", "Content-Type"}, c.Rules[0].AllowedHeaders)
+	assert.Equal(t, 3000, c.Rules[0].MaxAgeSeconds)
+
+	c.Rules[1].AllowedMethods = []string{"PATCH"}
+	assert.Equal(t, ErrInvalidMethod, c.Validate())
+
+	c.Rules[1].AllowedMethods = []string{"GET"}
+	c.Rules[1].AllowedOrigins = []string{"https://*.*.com"}
+	assert.Equal(t, ErrInvalidOrigin, c.Validate())
+
+	assert.Equal(t, ErrNoRules, (&CORSConfiguration{}).Validate())
+
+}
+
+func TestMatch(t *testing.T) {
+
+	c := &CORSConfiguration{Rules: []CORSRule{
+		{
+			AllowedOrigins: []string{"https://*.example.com"},
+			AllowedMethods: []string{"PUT", "POST"},
+			AllowedHeaders: []string{"x-amz-*", "Content-Type"},
+		},
+		{
+			AllowedOrigins: []string{"*"},
+			AllowedMethods: []string{"GET"},
+		},
+	}}
+
+	rule := c.Match("https://app.example.com", "PUT", []string{"X-Amz-Date", "content-type"})
+	assert.Equal(t, &c.Rules[0], rule)
+	assert.False(t, rule.AllowsAnyOrigin())
+
+	assert.Nil(t, c.Match("https://app.example.com", "PUT", []string{"Authorization"}))
+	assert.Nil(t, c.Match("https://example.org", "PUT", nil))
+	assert.Nil(t, c.Match("https://app.example.com", "DELETE", nil))
+
+	rule = c.Match("https://example.org", "GET", nil)
+	assert.Equal(t, &c.Rules[1], rule)
+	assert.True(t, rule.AllowsAnyOrigin())
+
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/filer"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
 )
 
 type InitiateMultipartUploadResult struct {
@@ -35,6 +36,21 @@ func (s3a *S3ApiServer) createMultipartUpload(input *s3.CreateMultipartUploadInp
 			entry.Extended = make(map[string][]byte)
 		}
 		entry.Extended["key"] = []byte(*input.Key)
+		// kept for the object created when the upload completes
+		if input.ObjectLockMode != nil && input.ObjectLockRetainUntilDate != nil {
+			entry.Extended[filer.ObjectLockModeKey] = []byte(*input.ObjectLockMode)
+			entry.Extended[filer.ObjectLockRetainUntilDateKey] = []byte(input.ObjectLockRetainUntilDate.UTC().Format(time.RFC3339))
+		}
+		if input.ObjectLockLegalHoldStatus != nil {
+			entry.Extended[filer.ObjectLockLegalHoldKey] = []byte(*input.ObjectLockLegalHoldStatus)
+		}
+		// the parts are encrypted the same way
+		if input.SSECustomerAlgorithm != nil && input.SSECustomerKeyMD5 != nil {
+			entry.Extended[xhttp.SeaweedServerSideEncryptionCustomerAlgorithm] = []byte(*input.SSECustomerAlgorithm)
+			entry.Extended[xhttp.SeaweedServerSideEncryptionCustomerKeyMD5] = []byte(*input.SSECustomerKeyMD5)
+		} else if input.ServerSideEncryption != nil {
+			entry.Extended[xhttp.SeaweedServerSideEncryption] = []byte(*input.ServerSideEncryption)
+		}
 	}); err != nil {
 		glog.Errorf("NewMultipartUpload error: %v", err)
 		return nil, s3err.ErrInternalError
@@ -68,6 +84,12 @@ func (s3a *S3ApiServer) completeMultipartUpload(input *s3.CompleteMultipartUploa
 		return nil, s3err.ErrNoSuchUpload
 	}
 
+	uploadEntry, err := s3a.getEntry(s3a.genUploadsFolder(*input.Bucket), *input.UploadId)
+	if err != nil || uploadEntry == nil {
+		glog.Errorf("completeMultipartUpload %s %s: %v", *input.Bucket, *input.UploadId, err)
+		return nil, s3err.ErrNoSuchUpload
+	}
+
 	var finalParts []*filer_pb.FileChunk
 	var offset int64
 
@@ -75,12 +97,13 @@ func (s3a *S3ApiServer) completeMultipartUpload(input *s3.CompleteMultipartUploa
 		if strings.HasSuffix(entry.Name, ".part") && !entry.IsDirectory {
 			for _, chunk := range entry.Chunks {
 				p := &filer_pb.FileChunk{
-					FileId:    chunk.GetFileIdString(),
-					Offset:    offset,
-					Size:      chunk.Size,
-					Mtime:     chunk.Mtime,
-					CipherKey: chunk.CipherKey,
-					ETag:      chunk.ETag,
+					FileId:       chunk.GetFileIdString(),
+					Offset:       offset,
+					Size:         chunk.Size,
+					Mtime:        chunk.Mtime,
+					CipherKey:    chunk.CipherKey,
+					ETag:         chunk.ETag,
+					IsCompressed: chunk.IsCompressed,
 				}
 				finalParts = append(finalParts, p)
 				offset += int64(chunk.Size)
@@ -103,12 +126,41 @@ func (s3a *S3ApiServer) completeMultipartUpload(input *s3.CompleteMultipartUploa
 		dirName = dirName[:len(dirName)-1]
 	}
 
-	err = s3a.mkFile(dirName, entryName, finalParts)
+	object := "/" + strings.TrimPrefix(*input.Key, "/")
+	defer s3a.lockObject(*input.Bucket, object)()
+
+	versionId, err := s3a.prepareObjectOverwrite(*input.Bucket, object)
+	if err != nil {
+		glog.Errorf("completeMultipartUpload %s%s prepare versioning: %v", *input.Bucket, object, err)
+		return nil, s3err.ErrInternalError
+	}
+
+	err = s3a.mkFile(dirName, entryName, finalParts, func(entry *filer_pb.Entry) {
+		if entry.Extended == nil {
+			entry.Extended = make(map[string][]byte)
+		}
+		if versionId != "" {
+			entry.Extended[xhttp.SeaweedVersionId] = []byte(versionId)
+		}
+		for _, headers := range []map[string]string{objectLockHeaders, sseHeaders} {
+			for key := range headers {
+				if value, found := uploadEntry.Extended[key]; found {
+					entry.Extended[key] = value
+				}
+			}
+		}
+	})
 
 	if err != nil {
 		glog.Errorf("completeMultipartUpload %s/%s error: %v", dirName, entryName, err)
+		if restoreErr := s3a.restoreLatestVersion(*input.Bucket, object); restoreErr != nil {
+			glog.Errorf("completeMultipartUpload restore %s%s: %v", *input.Bucket, object, restoreErr)
+		}
 		return nil, s3err.ErrInternalError
 	}
+	if err = s3a.finishObjectOverwrite(*input.Bucket, object, versionId); err != nil {
+		glog.Errorf("completeMultipartUpload remove replaced version of %s%s: %v", *input.Bucket, object, err)
+	}
 
 	output = &CompleteMultipartUploadResult{
 		CompleteMultipartUploadOutput: s3.CompleteMultipartUploadOutput{
@@ -118,6 +170,9 @@ func (s3a *S3ApiServer) completeMultipartUpload(input *s3.CompleteMultipartUploa
 			Key:      objectKey(input.Key),
 		},
 	}
+	if versionId != "" {
+		output.VersionId = aws.String(versionId)
+	}
 
 	if err = s3a.rm(s3a.genUploadsFolder(*input.Bucket), *input.UploadId, false, true); err != nil {
 		glog.V(1).Infof("completeMultipartUpload cleanup %s upload %s: %v", *input.Bucket, *input.UploadId, err)
@@ -15,9 +15,9 @@ func (s3a *S3ApiServer) mkdir(parentDirectoryPath string, dirName string, fn fun
 
 }
 
-func (s3a *S3ApiServer) mkFile(parentDirectoryPath string, fileName string, chunks []*filer_pb.FileChunk) error {
+func (s3a *S3ApiServer) mkFile(parentDirectoryPath string, fileName string, chunks []*filer_pb.FileChunk, fn func(entry *filer_pb.Entry)) error {
 
-	return filer_pb.MkFile(s3a, parentDirectoryPath, fileName, chunks)
+	return filer_pb.MkFile(s3a, parentDirectoryPath, fileName, chunks, fn)
 
 }
 
@@ -73,6 +73,26 @@ func doDeleteEntry(client filer_pb.SeaweedFilerClient, parentDirectoryPath strin
 	return nil
 }
 
+func (s3a *S3ApiServer) mv(oldDirectoryPath, oldName, newDirectoryPath, newName string) error {
+
+	return s3a.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+
+		request := &filer_pb.AtomicRenameEntryRequest{
+			OldDirectory: oldDirectoryPath,
+			OldName:      oldName,
+			NewDirectory: newDirectoryPath,
+			NewName:      newName,
+		}
+
+		glog.V(1).Infof("move entry %s/%s to %s/%s", oldDirectoryPath, oldName, newDirectoryPath, newName)
+		if _, err := client.AtomicRenameEntry(context.Background(), request); err != nil {
+			return fmt.Errorf("move entry %s/%s: %v", oldDirectoryPath, oldName, err)
+		}
+		return nil
+	})
+
+}
+
 func (s3a *S3ApiServer) exists(parentDirectoryPath string, entryName string, isDirectory bool) (exists bool, err error) {
 
 	return filer_pb.Exists(s3a, parentDirectoryPath, entryName, isDirectory)
@@ -90,6 +110,45 @@ func (s3a *S3ApiServer) getEntry(parentDirectoryPath, entryName string) (entry *
 	return filer_pb.GetEntry(s3a, fullPath)
 }
 
+// getBucketExtended reads one bucket level setting kept in the bucket entry extended attributes
+func (s3a *S3ApiServer) getBucketExtended(bucket, key string) (value []byte, err error) {
+	entry, err := s3a.getBucketEntry(bucket)
+	if err != nil || entry == nil || entry.Extended == nil {
+		return nil, err
+	}
+	return entry.Extended[key], nil
+}
+
+// setBucketExtended saves one bucket level setting, or removes it if the value is nil
+func (s3a *S3ApiServer) setBucketExtended(bucket, key string, value []byte) error {
+	return s3a.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+
+		resp, err := filer_pb.LookupEntry(client, &filer_pb.LookupDirectoryEntryRequest{
+			Directory: s3a.option.BucketsPath,
+			Name:      bucket,
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.Entry.Extended == nil {
+			resp.Entry.Extended = make(map[string][]byte)
+		}
+		if value == nil {
+			delete(resp.Entry.Extended, key)
+		} else {
+			resp.Entry.Extended[key] = value
+		}
+
+		err = filer_pb.UpdateEntry(client, &filer_pb.UpdateEntryRequest{
+			Directory: s3a.option.BucketsPath,
+			Entry:     resp.Entry,
+		})
+		s3a.bucketConfigs.remove(bucket)
+		return err
+	})
+}
+
 func objectKey(key *string) *string {
 	if strings.HasPrefix(*key, "/") {
 		t := (*key)[1:]
@@ -0,0 +1,104 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3acl"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// getAcl reads the acl of the bucket, or of the object if not empty. It returns nil if no acl is set.
+func (s3a *S3ApiServer) getAcl(bucket, object string) (*s3acl.AccessControlPolicy, error) {
+	var data []byte
+	if object == "" || object == "/" {
+		value, err := s3a.getBucketExtended(bucket, xhttp.AmzBucketAcl)
+		if err != nil {
+			return nil, err
+		}
+		data = value
+	} else {
+		entry, err := filer_pb.GetEntry(s3a, util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object)))
+		if err != nil || entry == nil {
+			return nil, err
+		}
+		data = entry.Extended[xhttp.SeaweedAcl]
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return s3acl.Parse(data)
+}
+
+// setObjectAcl replaces the acl of an existing object
+func (s3a *S3ApiServer) setObjectAcl(parentDirectoryPath string, entryName string, acl *s3acl.AccessControlPolicy) error {
+
+	data, err := xml.Marshal(acl)
+	if err != nil {
+		return err
+	}
+
+	return s3a.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+
+		resp, err := filer_pb.LookupEntry(client, &filer_pb.LookupDirectoryEntryRequest{
+			Directory: parentDirectoryPath,
+			Name:      entryName,
+		})
+		if err != nil {
+			return err
+		}
+
+		if resp.Entry.Extended == nil {
+			resp.Entry.Extended = make(map[string][]byte)
+		}
+		resp.Entry.Extended[xhttp.SeaweedAcl] = data
+
+		return filer_pb.UpdateEntry(client, &filer_pb.UpdateEntryRequest{
+			Directory: parentDirectoryPath,
+			Entry:     resp.Entry,
+		})
+	})
+}
+
+// getBucketOwner returns the identity which created the bucket, or "" if not known
+func (s3a *S3ApiServer) getBucketOwner(bucket string) (string, error) {
+	owner, err := s3a.getBucketExtended(bucket, xhttp.AmzIdentityId)
+	return string(owner), err
+}
+
+// setObjectAclHeader passes the acl of the x-amz-acl or x-amz-grant-* headers to the filer,
+// which keeps it in the entry extended attributes. The client can not set the acl header directly.
+func (s3a *S3ApiServer) setObjectAclHeader(r *http.Request, bucket string) s3err.ErrorCode {
+	r.Header.Del(xhttp.SeaweedAcl)
+
+	owner := r.Header.Get(xhttp.AmzIdentityId)
+	bucketOwner := ""
+	if s3acl.IsBucketOwnerCanned(r.Header.Get(xhttp.AmzAcl)) {
+		var err error
+		if bucketOwner, err = s3a.getBucketOwner(bucket); err != nil {
+			glog.Errorf("get owner of bucket %s: %v", bucket, err)
+			return s3err.ErrInternalError
+		}
+	}
+
+	acl, err := s3acl.FromHeaders(r.Header, owner, bucketOwner)
+	if err != nil {
+		glog.V(1).Infof("acl of %s: %v", r.URL, err)
+		return s3err.ErrUnsupportedAcl
+	}
+	if acl == nil {
+		return s3err.ErrNone
+	}
+
+	data, err := xml.Marshal(acl)
+	if err != nil {
+		return s3err.ErrInternalError
+	}
+	r.Header.Set(xhttp.SeaweedAcl, string(data))
+	return s3err.ErrNone
+}
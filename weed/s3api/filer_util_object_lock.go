@@ -0,0 +1,161 @@
+package s3api
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc/metadata"
+)
+
+// The object lock is enforced by the filer, so the S3 gateway only keeps the attributes
+// in the entry extended attributes, and maps the filer errors to S3 errors.
+
+// the object lock headers returned by the filer, and their S3 names
+var objectLockHeaders = map[string]string{
+	filer.ObjectLockModeKey:            xhttp.AmzObjectLockMode,
+	filer.ObjectLockRetainUntilDateKey: xhttp.AmzObjectLockRetainUntilDate,
+	filer.ObjectLockLegalHoldKey:       xhttp.AmzObjectLockLegalHold,
+}
+
+func (s3a *S3ApiServer) getBucketObjectLock(bucket string) (*ObjectLockConfiguration, error) {
+	data, err := s3a.getBucketExtended(bucket, filer.BucketObjectLockKey)
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	config := &ObjectLockConfiguration{}
+	if err = xml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("unmarshal object lock configuration of %s: %v", bucket, err)
+	}
+	return config, nil
+}
+
+// objectLockAttributes reads the object lock headers of a new object,
+// and applies the default retention of the bucket if the request has no retention.
+func (s3a *S3ApiServer) objectLockAttributes(r *http.Request, bucket string) (attributes map[string][]byte, code s3err.ErrorCode) {
+
+	mode := r.Header.Get(xhttp.AmzObjectLockMode)
+	retainUntilDate := r.Header.Get(xhttp.AmzObjectLockRetainUntilDate)
+	legalHold := r.Header.Get(xhttp.AmzObjectLockLegalHold)
+
+	config, err := s3a.getBucketObjectLock(bucket)
+	if err != nil {
+		glog.Errorf("object lock configuration of %s: %v", bucket, err)
+		return nil, s3err.ErrInternalError
+	}
+	if config == nil {
+		if mode != "" || retainUntilDate != "" || legalHold != "" {
+			return nil, s3err.ErrInvalidRequest
+		}
+		return nil, s3err.ErrNone
+	}
+
+	attributes = make(map[string][]byte)
+
+	if (mode == "") != (retainUntilDate == "") {
+		return nil, s3err.ErrInvalidRequest
+	}
+	if mode != "" {
+		retainUntil, err := time.Parse(time.RFC3339, retainUntilDate)
+		if err != nil || !isValidRetention(mode, retainUntil) {
+			return nil, s3err.ErrInvalidRetentionPeriod
+		}
+		attributes[filer.ObjectLockModeKey] = []byte(mode)
+		attributes[filer.ObjectLockRetainUntilDateKey] = []byte(retainUntil.UTC().Format(time.RFC3339))
+	} else if mode, retainUntil := config.defaultRetention(time.Now()); mode != "" {
+		attributes[filer.ObjectLockModeKey] = []byte(mode)
+		attributes[filer.ObjectLockRetainUntilDateKey] = []byte(retainUntil.UTC().Format(time.RFC3339))
+	}
+
+	switch legalHold {
+	case "":
+	case filer.LegalHoldOn, filer.LegalHoldOff:
+		attributes[filer.ObjectLockLegalHoldKey] = []byte(legalHold)
+	default:
+		return nil, s3err.ErrInvalidRequest
+	}
+
+	return attributes, s3err.ErrNone
+}
+
+func isValidRetention(mode string, retainUntil time.Time) bool {
+	return (mode == filer.ObjectLockGovernance || mode == filer.ObjectLockCompliance) && retainUntil.After(time.Now())
+}
+
+// setObjectLockHeaders passes the object lock attributes to the filer as Seaweed- headers
+func setObjectLockHeaders(r *http.Request, attributes map[string][]byte) {
+	for key := range objectLockHeaders {
+		r.Header.Del(key)
+	}
+	for key, value := range attributes {
+		r.Header.Set(key, string(value))
+	}
+}
+
+// canBypassGovernanceRetention checks the x-amz-bypass-governance-retention header, which is only honored for admins
+func (s3a *S3ApiServer) canBypassGovernanceRetention(r *http.Request) bool {
+	if !strings.EqualFold(r.Header.Get(xhttp.AmzBypassGovernanceRetention), "true") {
+		return false
+	}
+	return !s3a.iam.isEnabled() || r.Header.Get(xhttp.AmzIsAdmin) != ""
+}
+
+// withObjectLockPrivileges signs the bypass of the GOVERNANCE retention, and turning the legal hold off,
+// with the filer signing key, which the filer verifies
+func (s3a *S3ApiServer) withObjectLockPrivileges(bypassGovernance, changeLegalHold bool) context.Context {
+	ctx := context.Background()
+	if bypassGovernance {
+		token := security.GenJwtForFilerServer(s3a.filerSigningKey, s3a.filerSigningExpiresAfterSec, filer.BypassGovernanceRetentionKey)
+		ctx = metadata.AppendToOutgoingContext(ctx, filer.BypassGovernanceRetentionKey, string(token))
+	}
+	if changeLegalHold {
+		token := security.GenJwtForFilerServer(s3a.filerSigningKey, s3a.filerSigningExpiresAfterSec, filer.ChangeLegalHoldKey)
+		ctx = metadata.AppendToOutgoingContext(ctx, filer.ChangeLegalHoldKey, string(token))
+	}
+	return ctx
+}
+
+// rmObject deletes one object, and its data, which the filer refuses if the object is locked
+func (s3a *S3ApiServer) rmObject(parentDirectoryPath, entryName string, bypassGovernance bool) error {
+	return s3a.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.DeleteEntry(s3a.withObjectLockPrivileges(bypassGovernance, false), &filer_pb.DeleteEntryRequest{
+			Directory:    parentDirectoryPath,
+			Name:         entryName,
+			IsDeleteData: true,
+		})
+		if err != nil {
+			return fmt.Errorf("delete entry %s/%s: %v", parentDirectoryPath, entryName, err)
+		}
+		if resp.Error != "" {
+			return fmt.Errorf("delete entry %s/%s: %v", parentDirectoryPath, entryName, resp.Error)
+		}
+		return nil
+	})
+}
+
+// updateObjectLock saves the changed object lock attributes of the entry,
+// which the filer refuses if the retention is shortened, or the legal hold turned off, without the privileges
+func (s3a *S3ApiServer) updateObjectLock(fullPath util.FullPath, entry *filer_pb.Entry, bypassGovernance, changeLegalHold bool) error {
+	dir, _ := fullPath.DirAndName()
+	return s3a.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.UpdateEntry(s3a.withObjectLockPrivileges(bypassGovernance, changeLegalHold), &filer_pb.UpdateEntryRequest{
+			Directory: dir,
+			Entry:     entry,
+		})
+		return err
+	})
+}
+
+func isObjectLockedError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), filer.ErrObjectLocked.Error())
+}
@@ -0,0 +1,266 @@
+package s3api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// The current version of an object stays at its normal path, so filer, mount and webdav clients keep working.
+// Older versions and delete markers are kept under <bucket>/.versions/<object>/<versionId>.
+func (s3a *S3ApiServer) genVersionsFolder(bucket string) string {
+	return fmt.Sprintf("%s/%s/%s", s3a.option.BucketsPath, bucket, s3_constants.VersionsFolder)
+}
+
+func (s3a *S3ApiServer) genObjectVersionsFolder(bucket, object string) string {
+	return s3a.genVersionsFolder(bucket) + object
+}
+
+// isVersionsFolderKey tells whether the object key is under the reserved versions folder
+func isVersionsFolderKey(object string) bool {
+	object = strings.TrimPrefix(object, "/")
+	return object == s3_constants.VersionsFolder || strings.HasPrefix(object, s3_constants.VersionsFolder+"/")
+}
+
+func getVersionId(entry *filer_pb.Entry) string {
+	if entry.Extended != nil {
+		if v, ok := entry.Extended[xhttp.SeaweedVersionId]; ok && len(v) > 0 {
+			return string(v)
+		}
+	}
+	return s3_constants.NullVersionId
+}
+
+func isDeleteMarker(entry *filer_pb.Entry) bool {
+	if entry.Extended == nil {
+		return false
+	}
+	_, found := entry.Extended[xhttp.SeaweedDeleteMarker]
+	return found
+}
+
+func (s3a *S3ApiServer) getBucketVersioning(bucket string) (status string, err error) {
+	value, err := s3a.getBucketExtended(bucket, xhttp.AmzBucketVersioning)
+	return string(value), err
+}
+
+func (s3a *S3ApiServer) setBucketVersioning(bucket string, status string) error {
+	return s3a.setBucketExtended(bucket, xhttp.AmzBucketVersioning, []byte(status))
+}
+
+// objectLocks serializes the writes replacing the current version of the same object through this gateway,
+// so no version is lost between moving the current object to the versions and writing the new one
+type objectLocks struct {
+	sync.Mutex
+	locks map[string]*objectLock
+}
+
+type objectLock struct {
+	sync.Mutex
+	users int
+}
+
+// lockObject waits for the lock of the object, and returns the function to release it
+func (s3a *S3ApiServer) lockObject(bucket, object string) (unlock func()) {
+	locks, key := &s3a.objectLocks, bucket+object
+
+	locks.Lock()
+	if locks.locks == nil {
+		locks.locks = make(map[string]*objectLock)
+	}
+	l, found := locks.locks[key]
+	if !found {
+		l = &objectLock{}
+		locks.locks[key] = l
+	}
+	l.users++
+	locks.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		locks.Lock()
+		if l.users--; l.users <= 0 {
+			delete(locks.locks, key)
+		}
+		locks.Unlock()
+	}
+}
+
+// prepareObjectOverwrite keeps the current object as an older version before it is overwritten,
+// and returns the version id for the new object, or empty for the "null" version.
+// The object should be locked until the new object is written and finishObjectOverwrite is called.
+func (s3a *S3ApiServer) prepareObjectOverwrite(bucket, object string) (versionId string, err error) {
+
+	status, err := s3a.getBucketVersioning(bucket)
+	if err != nil || status == "" {
+		return "", err
+	}
+
+	dir, name := util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object)).DirAndName()
+	current, err := s3a.getEntry(dir, name)
+	if err != nil {
+		return "", err
+	}
+
+	versionId = newObjectVersionId(status)
+
+	if current != nil && !current.IsDirectory {
+		if status == s3_constants.VersioningEnabled || getVersionId(current) != s3_constants.NullVersionId {
+			if err = s3a.mv(dir, name, s3a.genObjectVersionsFolder(bucket, object), getVersionId(current)); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return versionId, nil
+}
+
+// finishObjectOverwrite removes the older "null" version replaced by the new object of a suspended bucket,
+// only after the new object is written, so a failed write does not lose it
+func (s3a *S3ApiServer) finishObjectOverwrite(bucket, object, versionId string) error {
+	if versionId != "" {
+		return nil
+	}
+	status, err := s3a.getBucketVersioning(bucket)
+	if err != nil || status != s3_constants.VersioningSuspended {
+		return err
+	}
+	return s3a.rmObjectVersion(bucket, object, s3_constants.NullVersionId)
+}
+
+// newObjectVersionId returns the version id for a new object, or empty for the "null" version
+func newObjectVersionId(status string) string {
+	if status == s3_constants.VersioningEnabled {
+		return s3_constants.NewVersionId()
+	}
+	return ""
+}
+
+// deleteVersionedObject deletes an object on a bucket which has versioning configured.
+// Without a version id, a delete marker becomes the current version.
+// With a version id, that version is permanently removed, unless it is locked.
+func (s3a *S3ApiServer) deleteVersionedObject(bucket, object, versionId string, bypassGovernance bool) (resultVersionId string, deleteMarker bool, err error) {
+
+	dir, name := util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object)).DirAndName()
+
+	defer s3a.lockObject(bucket, object)()
+
+	if versionId == "" {
+		// the delete marker of a suspended bucket replaces the older "null" version
+		if versionId, err = s3a.prepareObjectOverwrite(bucket, object); err != nil {
+			return "", false, err
+		}
+		if versionId == "" {
+			if err = filer_pb.Remove(s3a, dir, name, true, false, false, false, nil); err != nil {
+				return "", false, err
+			}
+			versionId = s3_constants.NullVersionId
+		}
+		if err = s3a.mkFile(s3a.genObjectVersionsFolder(bucket, object), versionId, nil, func(entry *filer_pb.Entry) {
+			entry.Extended = map[string][]byte{
+				xhttp.SeaweedVersionId:    []byte(versionId),
+				xhttp.SeaweedDeleteMarker: []byte("true"),
+			}
+		}); err != nil {
+			return "", false, err
+		}
+		return versionId, true, nil
+	}
+
+	current, err := s3a.getEntry(dir, name)
+	if err != nil {
+		return "", false, err
+	}
+	if current != nil && !current.IsDirectory && getVersionId(current) == versionId {
+		if err = s3a.rmObject(dir, name, bypassGovernance); err != nil {
+			return "", false, err
+		}
+	} else {
+		versionEntry, lookupErr := s3a.getEntry(s3a.genObjectVersionsFolder(bucket, object), versionId)
+		if lookupErr != nil {
+			return "", false, lookupErr
+		}
+		if versionEntry == nil {
+			return versionId, false, nil
+		}
+		deleteMarker = isDeleteMarker(versionEntry)
+		if err = s3a.rmObject(s3a.genObjectVersionsFolder(bucket, object), versionId, bypassGovernance); err != nil {
+			return "", false, err
+		}
+	}
+
+	return versionId, deleteMarker, s3a.restoreLatestVersion(bucket, object)
+}
+
+func (s3a *S3ApiServer) rmObjectVersion(bucket, object, versionId string) error {
+	return filer_pb.Remove(s3a, s3a.genObjectVersionsFolder(bucket, object), versionId, true, false, false, false, nil)
+}
+
+// restoreLatestVersion moves the newest older version back as the current object,
+// unless the object still exists or its newest version is a delete marker.
+func (s3a *S3ApiServer) restoreLatestVersion(bucket, object string) error {
+
+	dir, name := util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object)).DirAndName()
+	if exists, err := s3a.exists(dir, name, false); err != nil || exists {
+		return err
+	}
+
+	versions, err := s3a.listObjectVersions(bucket, object)
+	if err != nil || len(versions) == 0 || isDeleteMarker(versions[0]) {
+		return err
+	}
+
+	glog.V(1).Infof("restore %s%s version %s", bucket, object, versions[0].Name)
+	return s3a.mv(s3a.genObjectVersionsFolder(bucket, object), versions[0].Name, dir, name)
+}
+
+// listObjectVersions returns the older versions of one object, the newest first
+func (s3a *S3ApiServer) listObjectVersions(bucket, object string) (versions []*filer_pb.Entry, err error) {
+	versions, _, err = s3a.readVersionsFolder(s3a.genObjectVersionsFolder(bucket, object))
+	return
+}
+
+func sortVersions(versions []*filer_pb.Entry) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		if versions[i].Attributes.Mtime != versions[j].Attributes.Mtime {
+			return versions[i].Attributes.Mtime > versions[j].Attributes.Mtime
+		}
+		return versions[i].Name < versions[j].Name
+	})
+}
+
+// getObjectVersion locates the filer path of one object version
+func (s3a *S3ApiServer) getObjectVersion(bucket, object, versionId string) (fullPath util.FullPath, entry *filer_pb.Entry, code s3err.ErrorCode) {
+
+	fullPath = util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object))
+	dir, name := fullPath.DirAndName()
+	current, err := s3a.getEntry(dir, name)
+	if err != nil {
+		return "", nil, s3err.ErrInternalError
+	}
+	if current != nil && !current.IsDirectory && getVersionId(current) == versionId {
+		return fullPath, current, s3err.ErrNone
+	}
+
+	fullPath = util.NewFullPath(s3a.genObjectVersionsFolder(bucket, object), versionId)
+	entry, err = s3a.getEntry(s3a.genObjectVersionsFolder(bucket, object), versionId)
+	if err != nil {
+		return "", nil, s3err.ErrInternalError
+	}
+	if entry == nil || entry.IsDirectory {
+		return "", nil, s3err.ErrNoSuchVersion
+	}
+	if isDeleteMarker(entry) {
+		return fullPath, entry, s3err.ErrMethodNotAllowed
+	}
+	return fullPath, entry, s3err.ErrNone
+}
@@ -27,10 +27,86 @@ const (
 	// S3 object tagging
 	AmzObjectTagging = "X-Amz-Tagging"
 	AmzTagCount      = "x-amz-tagging-count"
+
+	// S3 object versioning
+	AmzVersionId    = "x-amz-version-id"
+	AmzDeleteMarker = "x-amz-delete-marker"
+
+	AmzCopySourceVersionId = "x-amz-copy-source-version-id"
+	AmzCopySourceRange     = "x-amz-copy-source-range"
+
+	// S3 object lock
+	AmzBucketObjectLockEnabled   = "x-amz-bucket-object-lock-enabled"
+	AmzObjectLockMode            = "x-amz-object-lock-mode"
+	AmzObjectLockRetainUntilDate = "x-amz-object-lock-retain-until-date"
+	AmzObjectLockLegalHold       = "x-amz-object-lock-legal-hold"
+	AmzBypassGovernanceRetention = "x-amz-bypass-governance-retention"
+
+	// S3 server side encryption
+	AmzServerSideEncryption                         = "x-amz-server-side-encryption"
+	AmzServerSideEncryptionCustomerAlgorithm        = "x-amz-server-side-encryption-customer-algorithm"
+	AmzServerSideEncryptionCustomerKey              = "x-amz-server-side-encryption-customer-key"
+	AmzServerSideEncryptionCustomerKeyMD5           = "x-amz-server-side-encryption-customer-key-MD5"
+	AmzCopySourceServerSideEncryptionCustomerPrefix = "x-amz-copy-source-server-side-encryption-customer-"
+
+	// S3 access control lists
+	AmzAcl              = "x-amz-acl"
+	AmzGrantRead        = "x-amz-grant-read"
+	AmzGrantWrite       = "x-amz-grant-write"
+	AmzGrantReadAcp     = "x-amz-grant-read-acp"
+	AmzGrantWriteAcp    = "x-amz-grant-write-acp"
+	AmzGrantFullControl = "x-amz-grant-full-control"
+
+	// S3 replication, PENDING, COMPLETED or FAILED on the source objects, and REPLICA on the replicas
+	AmzReplicationStatus = "x-amz-replication-status"
+
+	// S3 additional checksums
+	AmzChecksumCrc32  = "x-amz-checksum-crc32"
+	AmzChecksumCrc32c = "x-amz-checksum-crc32c"
+	AmzChecksumSha1   = "x-amz-checksum-sha1"
+	AmzChecksumSha256 = "x-amz-checksum-sha256"
+
+	// S3 conditional copy
+	AmzCopySourceIfMatch           = "x-amz-copy-source-if-match"
+	AmzCopySourceIfNoneMatch       = "x-amz-copy-source-if-none-match"
+	AmzCopySourceIfModifiedSince   = "x-amz-copy-source-if-modified-since"
+	AmzCopySourceIfUnmodifiedSince = "x-amz-copy-source-if-unmodified-since"
+)
+
+// the session token of the temporary credentials, in the header, the query, or the post form
+const (
+	AmzSecurityToken = "X-Amz-Security-Token"
 )
 
 // Non-Standard S3 HTTP request constants
 const (
 	AmzIdentityId = "s3-identity-id"
 	AmzIsAdmin    = "s3-is-admin" // only set to http request header as a context
+	AmzAccountId  = "s3-account-id"
+
+	// the filer path of the copy source, for the filer to copy the object without reading the data through the s3 gateway
+	AmzCopySourcePath = "s3-copy-source-path"
+
+	// stored in the bucket entry extended attributes
+	AmzBucketVersioning   = "s3-versioning"
+	AmzBucketLifecycle    = "s3-lifecycle"
+	AmzBucketCors         = "s3-cors"
+	AmzBucketPolicy       = "s3-policy"
+	AmzBucketNotification = "s3-notification"
+	AmzBucketAcl          = "s3-acl"
+	AmzBucketWebsite      = "s3-website"
+	AmzBucketLogging      = "s3-logging"
+	AmzBucketReplication  = "s3-replication"
+
+	// object version attributes, passed to the filer as Seaweed- headers and kept in the entry extended attributes
+	SeaweedVersionId    = "Seaweed-X-Amz-Version-Id"
+	SeaweedDeleteMarker = "Seaweed-X-Amz-Delete-Marker"
+
+	// the access control list of an object, passed to the filer as a Seaweed- header and kept in the entry extended attributes
+	SeaweedAcl = "Seaweed-X-Amz-Acl"
+
+	// server side encryption attributes, kept in the entry extended attributes by the filer
+	SeaweedServerSideEncryption                  = "Seaweed-X-Amz-Server-Side-Encryption"
+	SeaweedServerSideEncryptionCustomerAlgorithm = "Seaweed-X-Amz-Server-Side-Encryption-Customer-Algorithm"
+	SeaweedServerSideEncryptionCustomerKeyMD5    = "Seaweed-X-Amz-Server-Side-Encryption-Customer-Key-Md5"
 )
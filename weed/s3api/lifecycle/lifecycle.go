@@ -0,0 +1,333 @@
+package lifecycle
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Lifecycle is the S3 bucket lifecycle configuration.
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLifecycleConfiguration.html
+type Lifecycle struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LifecycleConfiguration"`
+	Rules   []Rule   `xml:"Rule"`
+}
+
+type Rule struct {
+	ID                             string                          `xml:"ID,omitempty"`
+	Status                         string                          `xml:"Status"`
+	Prefix                         string                          `xml:"Prefix,omitempty"` // deprecated, use Filter instead
+	Filter                         *Filter                         `xml:"Filter,omitempty"`
+	Expiration                     *Expiration                     `xml:"Expiration,omitempty"`
+	Transitions                    []Transition                    `xml:"Transition,omitempty"`
+	NoncurrentVersionExpiration    *NoncurrentVersionExpiration    `xml:"NoncurrentVersionExpiration,omitempty"`
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload,omitempty"`
+}
+
+type Tag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+type Filter struct {
+	Prefix string `xml:"Prefix,omitempty"`
+	Tag    *Tag   `xml:"Tag,omitempty"`
+	And    *And   `xml:"And,omitempty"`
+}
+
+type And struct {
+	Prefix string `xml:"Prefix,omitempty"`
+	Tags   []Tag  `xml:"Tag,omitempty"`
+}
+
+type Expiration struct {
+	Days                      int        `xml:"Days,omitempty"`
+	Date                      *time.Time `xml:"Date,omitempty"`
+	ExpiredObjectDeleteMarker bool       `xml:"ExpiredObjectDeleteMarker,omitempty"`
+}
+
+type Transition struct {
+	Days         int        `xml:"Days,omitempty"`
+	Date         *time.Time `xml:"Date,omitempty"`
+	StorageClass string     `xml:"StorageClass"`
+}
+
+type NoncurrentVersionExpiration struct {
+	NoncurrentDays          int `xml:"NoncurrentDays"`
+	NewerNoncurrentVersions int `xml:"NewerNoncurrentVersions,omitempty"`
+}
+
+type AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+}
+
+const (
+	StatusEnabled  = "Enabled"
+	StatusDisabled = "Disabled"
+
+	maxRules = 1000
+)
+
+var (
+	ErrTooManyRules        = errors.New("lifecycle configuration allows at most 1000 rules")
+	ErrDuplicateRuleId     = errors.New("rule ID must be unique")
+	ErrRuleIdTooLong       = errors.New("rule ID must be less than 255 characters")
+	ErrInvalidStatus       = errors.New("rule status must be Enabled or Disabled")
+	ErrNoAction            = errors.New("rule must specify at least one action")
+	ErrInvalidFilter       = errors.New("filter must have exactly one of Prefix, Tag, or And")
+	ErrInvalidExpiration   = errors.New("expiration must have exactly one of Days, Date, or ExpiredObjectDeleteMarker")
+	ErrInvalidTransition   = errors.New("transition must have a StorageClass and exactly one of Days or Date")
+	ErrInvalidDays         = errors.New("days must be a positive integer")
+	ErrInvalidDate         = errors.New("date must be at midnight UTC")
+	ErrTagFilterNotAllowed = errors.New("tag filters are not allowed with ExpiredObjectDeleteMarker or AbortIncompleteMultipartUpload")
+)
+
+func Parse(data []byte) (*Lifecycle, error) {
+	l := &Lifecycle{}
+	if err := xml.Unmarshal(data, l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *Lifecycle) Validate() error {
+	if len(l.Rules) > maxRules {
+		return ErrTooManyRules
+	}
+	ids := make(map[string]bool)
+	for i := range l.Rules {
+		rule := &l.Rules[i]
+		if rule.ID != "" {
+			if ids[rule.ID] {
+				return ErrDuplicateRuleId
+			}
+			ids[rule.ID] = true
+		}
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Rule) Validate() error {
+	if len(r.ID) > 255 {
+		return ErrRuleIdTooLong
+	}
+	if r.Status != StatusEnabled && r.Status != StatusDisabled {
+		return ErrInvalidStatus
+	}
+	if r.Expiration == nil && len(r.Transitions) == 0 && r.NoncurrentVersionExpiration == nil && r.AbortIncompleteMultipartUpload == nil {
+		return ErrNoAction
+	}
+	if r.Filter != nil {
+		count := 0
+		if r.Filter.Prefix != "" {
+			count++
+		}
+		if r.Filter.Tag != nil {
+			count++
+		}
+		if r.Filter.And != nil {
+			count++
+		}
+		if count > 1 {
+			return ErrInvalidFilter
+		}
+	}
+	if e := r.Expiration; e != nil {
+		count := 0
+		if e.Days != 0 {
+			count++
+		}
+		if e.Date != nil {
+			count++
+		}
+		if e.ExpiredObjectDeleteMarker {
+			count++
+		}
+		if count != 1 {
+			return ErrInvalidExpiration
+		}
+		if err := validateDaysOrDate(e.Days, e.Date); err != nil {
+			return err
+		}
+		if e.ExpiredObjectDeleteMarker && r.hasTagFilter() {
+			return ErrTagFilterNotAllowed
+		}
+	}
+	for _, t := range r.Transitions {
+		if t.StorageClass == "" || (t.Days != 0) == (t.Date != nil) {
+			return ErrInvalidTransition
+		}
+		if err := validateDaysOrDate(t.Days, t.Date); err != nil {
+			return err
+		}
+	}
+	if n := r.NoncurrentVersionExpiration; n != nil {
+		if n.NoncurrentDays <= 0 || n.NewerNoncurrentVersions < 0 {
+			return ErrInvalidDays
+		}
+	}
+	if a := r.AbortIncompleteMultipartUpload; a != nil {
+		if a.DaysAfterInitiation <= 0 {
+			return ErrInvalidDays
+		}
+		if r.hasTagFilter() {
+			return ErrTagFilterNotAllowed
+		}
+	}
+	return nil
+}
+
+func validateDaysOrDate(days int, date *time.Time) error {
+	if days < 0 {
+		return ErrInvalidDays
+	}
+	if date != nil && !date.UTC().Equal(date.UTC().Truncate(24*time.Hour)) {
+		return ErrInvalidDate
+	}
+	return nil
+}
+
+func (r *Rule) hasTagFilter() bool {
+	return r.Filter != nil && (r.Filter.Tag != nil || (r.Filter.And != nil && len(r.Filter.And.Tags) > 0))
+}
+
+// GetPrefix returns the key prefix the rule applies to
+func (r *Rule) GetPrefix() string {
+	if r.Filter == nil {
+		return r.Prefix
+	}
+	if r.Filter.And != nil {
+		return r.Filter.And.Prefix
+	}
+	return r.Filter.Prefix
+}
+
+// Match checks whether an enabled rule applies to the object key, without the leading "/", and its tags
+func (r *Rule) Match(key string, tags map[string]string) bool {
+	if r.Status != StatusEnabled {
+		return false
+	}
+	if !strings.HasPrefix(key, r.GetPrefix()) {
+		return false
+	}
+	if r.Filter == nil {
+		return true
+	}
+	if r.Filter.Tag != nil && tags[r.Filter.Tag.Key] != r.Filter.Tag.Value {
+		return false
+	}
+	if r.Filter.And != nil {
+		for _, tag := range r.Filter.And.Tags {
+			if v, found := tags[tag.Key]; !found || v != tag.Value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ExpectedExpiryTime adds the days to the time and rounds it up to the next midnight UTC, the same as AWS S3
+func ExpectedExpiryTime(t time.Time, days int) time.Time {
+	return t.UTC().Add(time.Duration(days+1) * 24 * time.Hour).Truncate(24 * time.Hour)
+}
+
+func isDue(days int, date *time.Time, since, now time.Time) bool {
+	if date != nil {
+		return !now.Before(*date)
+	}
+	if days > 0 {
+		return !now.Before(ExpectedExpiryTime(since, days))
+	}
+	return false
+}
+
+// ExpireObject checks whether the current version of an object, last modified at modTime, has expired
+func (l *Lifecycle) ExpireObject(key string, tags map[string]string, modTime, now time.Time) bool {
+	for i := range l.Rules {
+		rule := &l.Rules[i]
+		if rule.Expiration == nil || !rule.Match(key, tags) {
+			continue
+		}
+		if isDue(rule.Expiration.Days, rule.Expiration.Date, modTime, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpireDeleteMarker checks whether a delete marker without any older versions can be removed
+func (l *Lifecycle) ExpireDeleteMarker(key string) bool {
+	for i := range l.Rules {
+		rule := &l.Rules[i]
+		if rule.Expiration == nil || !rule.Match(key, nil) {
+			continue
+		}
+		if rule.Expiration.ExpiredObjectDeleteMarker || rule.Expiration.Days > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpireNoncurrentVersion checks whether a noncurrent version has expired.
+// The version became noncurrent at noncurrentSince, and newerNoncurrent versions are more recent than it.
+func (l *Lifecycle) ExpireNoncurrentVersion(key string, tags map[string]string, noncurrentSince time.Time, newerNoncurrent int, now time.Time) bool {
+	for i := range l.Rules {
+		rule := &l.Rules[i]
+		n := rule.NoncurrentVersionExpiration
+		if n == nil || !rule.Match(key, tags) {
+			continue
+		}
+		if newerNoncurrent < n.NewerNoncurrentVersions {
+			continue
+		}
+		if isDue(n.NoncurrentDays, nil, noncurrentSince, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// AbortMultipartUpload checks whether an incomplete multipart upload for the key should be aborted
+func (l *Lifecycle) AbortMultipartUpload(key string, initiated, now time.Time) bool {
+	for i := range l.Rules {
+		rule := &l.Rules[i]
+		a := rule.AbortIncompleteMultipartUpload
+		if a == nil || !rule.Match(key, nil) {
+			continue
+		}
+		if isDue(a.DaysAfterInitiation, nil, initiated, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionObject returns the storage class the current version of an object should be moved to, or empty.
+// If several transitions are due, the one with the latest schedule wins.
+func (l *Lifecycle) TransitionObject(key string, tags map[string]string, modTime, now time.Time) (storageClass string) {
+	var latest time.Time
+	for i := range l.Rules {
+		rule := &l.Rules[i]
+		if !rule.Match(key, tags) {
+			continue
+		}
+		for _, t := range rule.Transitions {
+			if !isDue(t.Days, t.Date, modTime, now) {
+				continue
+			}
+			scheduled := ExpectedExpiryTime(modTime, t.Days)
+			if t.Date != nil {
+				scheduled = *t.Date
+			}
+			if storageClass == "" || scheduled.After(latest) {
+				storageClass, latest = t.StorageClass, scheduled
+			}
+		}
+	}
+	return
+}
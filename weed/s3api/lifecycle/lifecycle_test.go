@@ -0,0 +1,110 @@
+package lifecycle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAndValidate(t *testing.T) {
+
+	input := `<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Rule>
+    <ID>logs</ID>
+    <Filter><Prefix>logs/</Prefix></Filter>
+    <Status>Enabled</Status>
+    <Expiration><Days>30</Days></Expiration>
+    <NoncurrentVersionExpiration><NoncurrentDays>7</NoncurrentDays></NoncurrentVersionExpiration>
+    <AbortIncompleteMultipartUpload><DaysAfterInitiation>2</DaysAfterInitiation></AbortIncompleteMultipartUpload>
+  </Rule>
+  <Rule>
+    <ID>tmp</ID>
+    <Filter><And><Prefix>tmp/</Prefix><Tag><Key>k</Key><Value>v</Value></Tag></And></Filter>
+    <Status>Disabled</Status>
+    <Transition><Date>2021-01-01T00:00:00Z</Date><StorageClass>GLACIER</StorageClass></Transition>
+  </Rule>
+</LifecycleConfiguration>`
+
+	l, err := Parse([]byte(input))
+	assert.Nil(t, err)
+	assert.Nil(t, l.Validate())
+	assert.Equal(t, 2, len(l.Rules))
+	assert.Equal(t, "logs/", l.Rules[0].GetPrefix())
+	assert.Equal(t, 30, l.Rules[0].Expiration.Days)
+	assert.Equal(t, "tmp/", l.Rules[1].GetPrefix())
+	assert.Equal(t, "GLACIER", l.Rules[1].Transitions[0].StorageClass)
+
+	l.Rules[1].ID = "logs"
+	assert.Equal(t, ErrDuplicateRuleId, l.Validate())
+
+	invalid := &Lifecycle{Rules: []Rule{{Status: StatusEnabled}}}
+	assert.Equal(t, ErrNoAction, invalid.Validate())
+
+	invalid.Rules[0].Expiration = &Expiration{Days: 1, ExpiredObjectDeleteMarker: true}
+	assert.Equal(t, ErrInvalidExpiration, invalid.Validate())
+
+	notMidnight := time.Date(2021, 1, 1, 1, 0, 0, 0, time.UTC)
+	invalid.Rules[0].Expiration = &Expiration{Date: &notMidnight}
+	assert.Equal(t, ErrInvalidDate, invalid.Validate())
+
+	invalid.Rules[0].Expiration = nil
+	invalid.Rules[0].Filter = &Filter{Tag: &Tag{Key: "k", Value: "v"}}
+	invalid.Rules[0].AbortIncompleteMultipartUpload = &AbortIncompleteMultipartUpload{DaysAfterInitiation: 1}
+	assert.Equal(t, ErrTagFilterNotAllowed, invalid.Validate())
+
+}
+
+func TestExpectedExpiryTime(t *testing.T) {
+	modTime := time.Date(2021, 3, 1, 15, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2021, 3, 2, 0, 0, 0, 0, time.UTC), ExpectedExpiryTime(modTime, 0))
+	assert.Equal(t, time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC), ExpectedExpiryTime(modTime, 2))
+}
+
+func TestLifecycleActions(t *testing.T) {
+
+	l := &Lifecycle{Rules: []Rule{
+		{
+			Status:     StatusEnabled,
+			Filter:     &Filter{Prefix: "logs/"},
+			Expiration: &Expiration{Days: 1},
+			NoncurrentVersionExpiration: &NoncurrentVersionExpiration{
+				NoncurrentDays:          1,
+				NewerNoncurrentVersions: 2,
+			},
+			AbortIncompleteMultipartUpload: &AbortIncompleteMultipartUpload{DaysAfterInitiation: 3},
+		},
+		{
+			Status:      StatusEnabled,
+			Filter:      &Filter{Tag: &Tag{Key: "tier", Value: "cold"}},
+			Transitions: []Transition{{Days: 1, StorageClass: "STANDARD_IA"}, {Days: 10, StorageClass: "GLACIER"}},
+		},
+		{
+			Status:     StatusDisabled,
+			Expiration: &Expiration{Days: 1},
+		},
+	}}
+
+	modTime := time.Date(2021, 3, 1, 15, 30, 0, 0, time.UTC)
+	beforeDue := time.Date(2021, 3, 2, 23, 0, 0, 0, time.UTC)
+	afterDue := time.Date(2021, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	assert.False(t, l.ExpireObject("logs/a.txt", nil, modTime, beforeDue))
+	assert.True(t, l.ExpireObject("logs/a.txt", nil, modTime, afterDue))
+	assert.False(t, l.ExpireObject("data/a.txt", nil, modTime, afterDue), "disabled rule")
+
+	assert.True(t, l.ExpireDeleteMarker("logs/a.txt"))
+	assert.False(t, l.ExpireDeleteMarker("data/a.txt"))
+
+	assert.False(t, l.ExpireNoncurrentVersion("logs/a.txt", nil, modTime, 1, afterDue), "keep newer noncurrent versions")
+	assert.True(t, l.ExpireNoncurrentVersion("logs/a.txt", nil, modTime, 2, afterDue))
+
+	assert.False(t, l.AbortMultipartUpload("logs/a.txt", modTime, afterDue))
+	assert.True(t, l.AbortMultipartUpload("logs/a.txt", modTime, afterDue.Add(48*time.Hour)))
+
+	cold := map[string]string{"tier": "cold"}
+	assert.Equal(t, "", l.TransitionObject("data/a.txt", nil, modTime, afterDue))
+	assert.Equal(t, "STANDARD_IA", l.TransitionObject("data/a.txt", cold, modTime, afterDue))
+	assert.Equal(t, "GLACIER", l.TransitionObject("data/a.txt", cold, modTime, afterDue.Add(30*24*time.Hour)))
+
+}
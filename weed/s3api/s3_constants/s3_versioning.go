@@ -0,0 +1,22 @@
+package s3_constants
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+const (
+	VersioningEnabled   = "Enabled"
+	VersioningSuspended = "Suspended"
+	NullVersionId       = "null"
+
+	// VersionsFolder keeps the older versions and delete markers under <bucket>/.versions/<object>/<versionId>
+	VersionsFolder = ".versions"
+)
+
+// NewVersionId returns ids sorted from the newest to the oldest
+func NewVersionId() string {
+	return fmt.Sprintf("%016x%08x", math.MaxInt64-time.Now().UnixNano(), rand.Uint32())
+}
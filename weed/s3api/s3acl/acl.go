@@ -0,0 +1,282 @@
+package s3acl
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strings"
+
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+)
+
+// AccessControlPolicy is the access control list of a bucket or an object.
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectAcl.html
+type AccessControlPolicy struct {
+	XMLName           xml.Name          `xml:"http://s3.amazonaws.com/doc/2006-03-01/ AccessControlPolicy"`
+	Owner             Owner             `xml:"Owner"`
+	AccessControlList AccessControlList `xml:"AccessControlList"`
+}
+
+type Owner struct {
+	ID          string `xml:"ID"`
+	DisplayName string `xml:"DisplayName,omitempty"`
+}
+
+type AccessControlList struct {
+	Grants []Grant `xml:"Grant"`
+}
+
+type Grant struct {
+	Grantee    Grantee `xml:"Grantee"`
+	Permission string  `xml:"Permission"`
+}
+
+// Grantee is either a user, by its canonical id, or one of the predefined groups, by its uri
+type Grantee struct {
+	Type         string `xml:"-"`
+	ID           string `xml:"ID,omitempty"`
+	DisplayName  string `xml:"DisplayName,omitempty"`
+	URI          string `xml:"URI,omitempty"`
+	EmailAddress string `xml:"EmailAddress,omitempty"`
+}
+
+const (
+	PermissionFullControl = "FULL_CONTROL"
+	PermissionRead        = "READ"
+	PermissionWrite       = "WRITE"
+	PermissionReadAcp     = "READ_ACP"
+	PermissionWriteAcp    = "WRITE_ACP"
+
+	GranteeCanonicalUser = "CanonicalUser"
+	GranteeGroup         = "Group"
+	GranteeEmail         = "AmazonCustomerByEmail"
+
+	AllUsersUri           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	AuthenticatedUsersUri = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+
+	CannedPrivate                = "private"
+	CannedPublicRead             = "public-read"
+	CannedPublicReadWrite        = "public-read-write"
+	CannedAuthenticatedRead      = "authenticated-read"
+	CannedBucketOwnerRead        = "bucket-owner-read"
+	CannedBucketOwnerFullControl = "bucket-owner-full-control"
+
+	xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+	maxGrants    = 100
+)
+
+var (
+	ErrUnsupportedCannedAcl = errors.New("canned ACL is not supported")
+	ErrCannedAclAndGrants   = errors.New("canned ACL and grant headers can not be used together")
+	ErrInvalidGrantHeader   = errors.New("grant header should be a list of id=\"...\" or uri=\"...\"")
+	ErrInvalidPermission    = errors.New("grant permission must be one of FULL_CONTROL, READ, WRITE, READ_ACP, WRITE_ACP")
+	ErrUnsupportedGrantee   = errors.New("grantee must be a canonical user id, or the AllUsers or AuthenticatedUsers group")
+	ErrTooManyGrants        = errors.New("access control list allows at most 100 grants")
+
+	permissions  = map[string]bool{PermissionFullControl: true, PermissionRead: true, PermissionWrite: true, PermissionReadAcp: true, PermissionWriteAcp: true}
+	grantHeaders = []struct{ header, permission string }{
+		{xhttp.AmzGrantFullControl, PermissionFullControl},
+		{xhttp.AmzGrantRead, PermissionRead},
+		{xhttp.AmzGrantWrite, PermissionWrite},
+		{xhttp.AmzGrantReadAcp, PermissionReadAcp},
+		{xhttp.AmzGrantWriteAcp, PermissionWriteAcp},
+	}
+)
+
+// MarshalXML writes the grantee type as the xsi:type attribute
+func (g Grantee) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type grantee Grantee
+	start.Attr = []xml.Attr{
+		{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		{Name: xml.Name{Local: "xsi:type"}, Value: g.Type},
+	}
+	return e.EncodeElement(grantee(g), start)
+}
+
+// UnmarshalXML reads the xsi:type attribute, also when the client did not declare the xsi namespace
+func (g *Grantee) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type grantee Grantee
+	if err := d.DecodeElement((*grantee)(g), &start); err != nil {
+		return err
+	}
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "type" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") {
+			g.Type = attr.Value
+		}
+	}
+	return nil
+}
+
+func Parse(data []byte) (*AccessControlPolicy, error) {
+	acl := &AccessControlPolicy{}
+	if err := xml.Unmarshal(data, acl); err != nil {
+		return nil, err
+	}
+	return acl, nil
+}
+
+func (acl *AccessControlPolicy) Validate() error {
+	if len(acl.AccessControlList.Grants) > maxGrants {
+		return ErrTooManyGrants
+	}
+	for _, grant := range acl.AccessControlList.Grants {
+		if !permissions[grant.Permission] {
+			return ErrInvalidPermission
+		}
+		switch grant.Grantee.Type {
+		case GranteeCanonicalUser:
+			if grant.Grantee.ID == "" {
+				return ErrUnsupportedGrantee
+			}
+		case GranteeGroup:
+			if grant.Grantee.URI != AllUsersUri && grant.Grantee.URI != AuthenticatedUsersUri {
+				return ErrUnsupportedGrantee
+			}
+		default:
+			return ErrUnsupportedGrantee
+		}
+	}
+	return nil
+}
+
+// Grants checks whether the account has the permission. The empty account is an anonymous request,
+// which is only covered by the grants to all users. The owner can always read and change the acl.
+func (acl *AccessControlPolicy) Grants(account, permission string) bool {
+	if account != "" && account == acl.Owner.ID && (permission == PermissionReadAcp || permission == PermissionWriteAcp) {
+		return true
+	}
+	for _, grant := range acl.AccessControlList.Grants {
+		if grant.Permission != permission && grant.Permission != PermissionFullControl {
+			continue
+		}
+		switch grant.Grantee.Type {
+		case GranteeCanonicalUser:
+			if account != "" && grant.Grantee.ID == account {
+				return true
+			}
+		case GranteeGroup:
+			if grant.Grantee.URI == AllUsersUri || (grant.Grantee.URI == AuthenticatedUsersUri && account != "") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsPublic checks whether anyone has any permission
+func (acl *AccessControlPolicy) IsPublic() bool {
+	for _, grant := range acl.AccessControlList.Grants {
+		if grant.Grantee.Type == GranteeGroup && grant.Grantee.URI == AllUsersUri {
+			return true
+		}
+	}
+	return false
+}
+
+// Private is the default acl, which gives the owner full control
+func Private(owner string) *AccessControlPolicy {
+	acl := &AccessControlPolicy{Owner: Owner{ID: owner, DisplayName: owner}}
+	if owner != "" {
+		acl.grantUser(owner, PermissionFullControl)
+	}
+	return acl
+}
+
+// Canned creates one of the predefined acls. The bucket owner is only used by the bucket-owner-* acls of objects.
+func Canned(name, owner, bucketOwner string) (*AccessControlPolicy, error) {
+	acl := Private(owner)
+	switch name {
+	case CannedPrivate:
+	case CannedPublicRead:
+		acl.grantGroup(AllUsersUri, PermissionRead)
+	case CannedPublicReadWrite:
+		acl.grantGroup(AllUsersUri, PermissionRead)
+		acl.grantGroup(AllUsersUri, PermissionWrite)
+	case CannedAuthenticatedRead:
+		acl.grantGroup(AuthenticatedUsersUri, PermissionRead)
+	case CannedBucketOwnerRead:
+		if bucketOwner != "" && bucketOwner != owner {
+			acl.grantUser(bucketOwner, PermissionRead)
+		}
+	case CannedBucketOwnerFullControl:
+		if bucketOwner != "" && bucketOwner != owner {
+			acl.grantUser(bucketOwner, PermissionFullControl)
+		}
+	default:
+		return nil, ErrUnsupportedCannedAcl
+	}
+	return acl, nil
+}
+
+// IsBucketOwnerCanned checks whether the canned acl needs the bucket owner
+func IsBucketOwnerCanned(name string) bool {
+	return name == CannedBucketOwnerRead || name == CannedBucketOwnerFullControl
+}
+
+// FromHeaders creates the acl from either the x-amz-acl header or the x-amz-grant-* headers.
+// It returns nil if the request has neither.
+func FromHeaders(header http.Header, owner, bucketOwner string) (*AccessControlPolicy, error) {
+	canned := header.Get(xhttp.AmzAcl)
+	var grants *AccessControlPolicy
+	for _, h := range grantHeaders {
+		value := header.Get(h.header)
+		if value == "" {
+			continue
+		}
+		if canned != "" {
+			return nil, ErrCannedAclAndGrants
+		}
+		if grants == nil {
+			grants = &AccessControlPolicy{Owner: Owner{ID: owner, DisplayName: owner}}
+		}
+		if err := grants.parseGrantHeader(value, h.permission); err != nil {
+			return nil, err
+		}
+	}
+	if grants != nil {
+		return grants, grants.Validate()
+	}
+	if canned != "" {
+		return Canned(canned, owner, bucketOwner)
+	}
+	return nil, nil
+}
+
+// parseGrantHeader parses grantees like `id="user1", uri="http://acs.amazonaws.com/groups/global/AllUsers"`
+func (acl *AccessControlPolicy) parseGrantHeader(value, permission string) error {
+	for _, grantee := range strings.Split(value, ",") {
+		parts := strings.SplitN(strings.TrimSpace(grantee), "=", 2)
+		if len(parts) != 2 {
+			return ErrInvalidGrantHeader
+		}
+		id := strings.Trim(strings.TrimSpace(parts[1]), `"`)
+		if id == "" {
+			return ErrInvalidGrantHeader
+		}
+		switch strings.ToLower(strings.TrimSpace(parts[0])) {
+		case "id":
+			acl.grantUser(id, permission)
+		case "uri":
+			acl.grantGroup(id, permission)
+		case "emailaddress":
+			return ErrUnsupportedGrantee
+		default:
+			return ErrInvalidGrantHeader
+		}
+	}
+	return nil
+}
+
+func (acl *AccessControlPolicy) grantUser(id, permission string) {
+	acl.AccessControlList.Grants = append(acl.AccessControlList.Grants, Grant{
+		Grantee:    Grantee{Type: GranteeCanonicalUser, ID: id, DisplayName: id},
+		Permission: permission,
+	})
+}
+
+func (acl *AccessControlPolicy) grantGroup(uri, permission string) {
+	acl.AccessControlList.Grants = append(acl.AccessControlList.Grants, Grant{
+		Grantee:    Grantee{Type: GranteeGroup, URI: uri},
+		Permission: permission,
+	})
+}
@@ -0,0 +1,98 @@
+package s3acl
+
+import (
+	"encoding/xml"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAndValidate(t *testing.T) {
+
+	input := `<AccessControlPolicy xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Owner><ID>admin</ID></Owner>
+  <AccessControlList>
+    <Grant>
+      <Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser"><ID>admin</ID></Grantee>
+      <Permission>FULL_CONTROL</Permission>
+    </Grant>
+    <Grant>
+      <Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="Group"><URI>http://acs.amazonaws.com/groups/global/AllUsers</URI></Grantee>
+      <Permission>READ</Permission>
+    </Grant>
+  </AccessControlList>
+</AccessControlPolicy>`
+
+	acl, err := Parse([]byte(input))
+	assert.Nil(t, err)
+	assert.Nil(t, acl.Validate())
+	assert.Equal(t, "admin", acl.Owner.ID)
+	assert.Equal(t, GranteeGroup, acl.AccessControlList.Grants[1].Grantee.Type)
+	assert.True(t, acl.IsPublic())
+
+	// the grantee type survives a round trip
+	data, err := xml.Marshal(acl)
+	assert.Nil(t, err)
+	acl, err = Parse(data)
+	assert.Nil(t, err)
+	assert.Equal(t, GranteeCanonicalUser, acl.AccessControlList.Grants[0].Grantee.Type)
+	assert.Equal(t, AllUsersUri, acl.AccessControlList.Grants[1].Grantee.URI)
+
+	acl.AccessControlList.Grants[1].Permission = "READ_WRITE"
+	assert.Equal(t, ErrInvalidPermission, acl.Validate())
+
+	acl.AccessControlList.Grants[1].Permission = PermissionRead
+	acl.AccessControlList.Grants[1].Grantee = Grantee{Type: GranteeEmail, EmailAddress: "a@example.com"}
+	assert.Equal(t, ErrUnsupportedGrantee, acl.Validate())
+}
+
+func TestGrants(t *testing.T) {
+
+	acl, err := Canned(CannedPublicRead, "user1", "")
+	assert.Nil(t, err)
+	assert.True(t, acl.Grants("user1", PermissionWrite))
+	assert.True(t, acl.Grants("", PermissionRead))
+	assert.False(t, acl.Grants("", PermissionWrite))
+	assert.False(t, acl.Grants("user2", PermissionReadAcp))
+
+	acl, _ = Canned(CannedAuthenticatedRead, "user1", "")
+	assert.False(t, acl.Grants("", PermissionRead))
+	assert.True(t, acl.Grants("user2", PermissionRead))
+
+	acl, _ = Canned(CannedBucketOwnerFullControl, "user1", "owner")
+	assert.True(t, acl.Grants("owner", PermissionWriteAcp))
+	assert.False(t, acl.Grants("user2", PermissionRead))
+
+	_, err = Canned("public", "user1", "")
+	assert.Equal(t, ErrUnsupportedCannedAcl, err)
+}
+
+func TestFromHeaders(t *testing.T) {
+
+	acl, err := FromHeaders(http.Header{}, "user1", "")
+	assert.Nil(t, err)
+	assert.Nil(t, acl)
+
+	header := http.Header{}
+	header.Set("x-amz-grant-read", `id="user2", uri="http://acs.amazonaws.com/groups/global/AuthenticatedUsers"`)
+	header.Set("x-amz-grant-full-control", `id="user1"`)
+	acl, err = FromHeaders(header, "user1", "")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(acl.AccessControlList.Grants))
+	assert.True(t, acl.Grants("user3", PermissionRead))
+	assert.False(t, acl.Grants("user3", PermissionWrite))
+
+	header.Set("x-amz-acl", "private")
+	_, err = FromHeaders(header, "user1", "")
+	assert.Equal(t, ErrCannedAclAndGrants, err)
+
+	header = http.Header{}
+	header.Set("x-amz-grant-write", `emailAddress="a@example.com"`)
+	_, err = FromHeaders(header, "user1", "")
+	assert.Equal(t, ErrUnsupportedGrantee, err)
+
+	header.Set("x-amz-grant-write", `user2`)
+	_, err = FromHeaders(header, "user1", "")
+	assert.Equal(t, ErrInvalidGrantHeader, err)
+}
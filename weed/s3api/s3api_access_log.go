@@ -0,0 +1,257 @@
+package s3api
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3logging"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// the access logs are buffered, and written into the target buckets at least once per interval
+const accessLogFlushInterval = time.Minute
+
+// at most this much of an error response is kept to find its error code
+const maxErrorBodyLength = 1024
+
+// accessLogRecorder records the response of a request for the access log
+type accessLogRecorder struct {
+	http.ResponseWriter
+	status    int
+	bytesSent int64
+	firstByte time.Time
+	errorBody []byte
+}
+
+func (r *accessLogRecorder) WriteHeader(status int) {
+	if r.firstByte.IsZero() {
+		r.firstByte = time.Now()
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *accessLogRecorder) Write(p []byte) (int, error) {
+	if r.firstByte.IsZero() {
+		r.firstByte = time.Now()
+	}
+	if r.status >= 400 && len(r.errorBody) < maxErrorBodyLength {
+		r.errorBody = append(r.errorBody, p...)
+	}
+	n, err := r.ResponseWriter.Write(p)
+	r.bytesSent += int64(n)
+	return n, err
+}
+
+func (r *accessLogRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (r *accessLogRecorder) errorCode() string {
+	if len(r.errorBody) == 0 {
+		return ""
+	}
+	var errorResponse struct {
+		Code string `xml:"Code"`
+	}
+	xml.Unmarshal(r.errorBody, &errorResponse)
+	return errorResponse.Code
+}
+
+// accessLog records the requests to the buckets with access logging turned on by PutBucketLogging
+func (s3a *S3ApiServer) accessLog(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &accessLogRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		// the bucket is renamed to the bucket in the filer by the authentication
+		bucket, object := getBucketAndObject(r)
+		if bucket == "" {
+			return
+		}
+		entry, err := s3a.getBucketEntry(bucket)
+		if err != nil || entry == nil || len(entry.Extended[xhttp.AmzBucketLogging]) == 0 {
+			return
+		}
+		status, err := s3logging.Parse(entry.Extended[xhttp.AmzBucketLogging])
+		if err != nil {
+			glog.Errorf("parse logging status of bucket %s: %v", bucket, err)
+			return
+		}
+		if status.LoggingEnabled == nil {
+			return
+		}
+
+		logEntry := &s3logging.Entry{
+			BucketOwner: string(entry.Extended[xhttp.AmzIdentityId]),
+			Bucket:      relativeBucket(bucketAccount(bucket), bucket),
+			Time:        start,
+			RemoteIP:    remoteIP(r),
+			Requester:   r.Header.Get(xhttp.AmzIdentityId),
+			RequestID:   recorder.Header().Get("x-amz-request-id"),
+			Operation:   s3logging.Operation(r, object),
+			Key:         strings.TrimPrefix(object, "/"),
+			RequestURI:  fmt.Sprintf("%s %s %s", r.Method, r.RequestURI, r.Proto),
+			HTTPStatus:  recorder.status,
+			ErrorCode:   recorder.errorCode(),
+			BytesSent:   recorder.bytesSent,
+			ObjectSize:  accessLogObjectSize(r, recorder, object),
+			TotalTime:   time.Since(start),
+			Referer:     r.Referer(),
+			UserAgent:   r.UserAgent(),
+			VersionId:   r.URL.Query().Get("versionId"),
+			HostHeader:  r.Host,
+		}
+		if !recorder.firstByte.IsZero() {
+			logEntry.TurnAroundTime = recorder.firstByte.Sub(start)
+		}
+		logEntry.SignatureVersion, logEntry.AuthenticationType = accessLogAuthType(r)
+		if r.TLS != nil {
+			logEntry.CipherSuite = tls.CipherSuiteName(r.TLS.CipherSuite)
+			logEntry.TLSVersion = tlsVersionName(r.TLS.Version)
+		}
+
+		// the target bucket and prefix go in front of the log line, to be grouped when flushed
+		target := accountBucket(bucketAccount(bucket), status.LoggingEnabled.TargetBucket) + "/" + status.LoggingEnabled.TargetPrefix
+		s3a.accessLogBuffer.AddToBuffer([]byte(target), []byte(target+"\n"+logEntry.String()), 0)
+	})
+}
+
+// flushAccessLogs writes the buffered log lines as one object for each target bucket and prefix
+func (s3a *S3ApiServer) flushAccessLogs(startTime, stopTime time.Time, buf []byte) {
+
+	logs := make(map[string][]byte)
+	var targets []string
+	for pos := 0; pos+4 < len(buf); {
+		size := int(util.BytesToUint32(buf[pos : pos+4]))
+		if pos+4+size > len(buf) {
+			glog.Errorf("access log buffer is truncated at %d of %d", pos, len(buf))
+			break
+		}
+		logEntry := &filer_pb.LogEntry{}
+		if err := proto.Unmarshal(buf[pos+4:pos+4+size], logEntry); err != nil {
+			glog.Errorf("unexpected unmarshal access log entry: %v", err)
+			break
+		}
+		pos += 4 + size
+
+		parts := strings.SplitN(string(logEntry.Data), "\n", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		if _, found := logs[parts[0]]; !found {
+			targets = append(targets, parts[0])
+		}
+		logs[parts[0]] = append(logs[parts[0]], parts[1]+"\n"...)
+	}
+
+	for _, target := range targets {
+		parts := strings.SplitN(target, "/", 2)
+		bucket, key := parts[0], s3logging.ObjectKey(parts[1], startTime, util.BytesToUint64(util.RandomBytes(8)))
+		uploadUrl := fmt.Sprintf("http://%s%s/%s/%s", s3a.option.Filer, s3a.option.BucketsPath, bucket, urlPathEscape(key))
+		if err := writeAccessLog(uploadUrl, logs[target]); err != nil {
+			glog.Errorf("write access log %s/%s: %v", bucket, key, err)
+		}
+	}
+
+}
+
+func writeAccessLog(uploadUrl string, data []byte) error {
+	req, err := http.NewRequest("PUT", uploadUrl, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain")
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s: %s", uploadUrl, resp.Status)
+	}
+	return nil
+}
+
+func accessLogObjectSize(r *http.Request, recorder *accessLogRecorder, object string) int64 {
+	if object == "" {
+		return -1
+	}
+	switch r.Method {
+	case http.MethodPut, http.MethodPost:
+		if r.URL.Query().Get("uploadId") != "" && r.URL.Query().Get("partNumber") == "" {
+			return -1
+		}
+		if size := requestContentLength(r); size >= 0 {
+			return size
+		}
+	case http.MethodGet, http.MethodHead:
+		if recorder.status >= 300 {
+			return -1
+		}
+		header := recorder.Header()
+		if contentRange := header.Get("Content-Range"); contentRange != "" {
+			if i := strings.LastIndex(contentRange, "/"); i >= 0 {
+				if size, err := strconv.ParseInt(contentRange[i+1:], 10, 64); err == nil {
+					return size
+				}
+			}
+		}
+		if size, err := strconv.ParseInt(header.Get("Content-Length"), 10, 64); err == nil {
+			return size
+		}
+	}
+	return -1
+}
+
+func accessLogAuthType(r *http.Request) (signatureVersion, authenticationType string) {
+	switch getRequestAuthType(r) {
+	case authTypeSigned, authTypeStreamingSigned:
+		return "SigV4", "AuthHeader"
+	case authTypePresigned:
+		return "SigV4", "QueryString"
+	case authTypeSignedV2:
+		return "SigV2", "AuthHeader"
+	case authTypePresignedV2:
+		return "SigV2", "QueryString"
+	case authTypePostPolicy:
+		return "SigV4", "AuthHeader"
+	}
+	return "", ""
+}
+
+func remoteIP(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+func tlsVersionName(version uint16) string {
+	switch version {
+	case tls.VersionTLS10:
+		return "TLSv1"
+	case tls.VersionTLS11:
+		return "TLSv1.1"
+	case tls.VersionTLS12:
+		return "TLSv1.2"
+	case tls.VersionTLS13:
+		return "TLSv1.3"
+	}
+	return ""
+}
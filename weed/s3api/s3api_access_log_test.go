@@ -0,0 +1,78 @@
+package s3api
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func TestAccessLogErrorCode(t *testing.T) {
+
+	recorder := &accessLogRecorder{ResponseWriter: httptest.NewRecorder(), status: http.StatusOK}
+	writeErrorResponse(recorder, s3err.ErrNoSuchKey, &url.URL{Path: "/bucket/a.txt"})
+
+	assert.Equal(t, http.StatusNotFound, recorder.status)
+	assert.Equal(t, "NoSuchKey", recorder.errorCode())
+	assert.False(t, recorder.firstByte.IsZero())
+
+}
+
+func TestFlushAccessLogs(t *testing.T) {
+
+	var lock sync.Mutex
+	objects := make(map[string]string)
+	filer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		lock.Lock()
+		objects[r.URL.Path] = string(data)
+		lock.Unlock()
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer filer.Close()
+
+	s3a := &S3ApiServer{option: &S3ApiServerOption{
+		Filer:       strings.TrimPrefix(filer.URL, "http://"),
+		BucketsPath: "/buckets",
+	}}
+
+	var buf []byte
+	for _, data := range []string{
+		"logs/photos/\nline 1",
+		"acme@logs/\nline 2",
+		"logs/photos/\nline 3",
+	} {
+		logEntry, _ := proto.Marshal(&filer_pb.LogEntry{Data: []byte(data)})
+		size := make([]byte, 4)
+		util.Uint32toBytes(size, uint32(len(logEntry)))
+		buf = append(buf, size...)
+		buf = append(buf, logEntry...)
+	}
+
+	startTime := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	s3a.flushAccessLogs(startTime, startTime, buf)
+
+	assert.Equal(t, 2, len(objects))
+	for path, data := range objects {
+		switch {
+		case strings.HasPrefix(path, "/buckets/logs/photos/2021-03-04-05-06-07-"):
+			assert.Equal(t, "line 1\nline 3\n", data)
+		case strings.HasPrefix(path, "/buckets/acme@logs/2021-03-04-05-06-07-"):
+			assert.Equal(t, "line 2\n", data)
+		default:
+			t.Errorf("unexpected log object %s", path)
+		}
+	}
+
+}
@@ -0,0 +1,221 @@
+package s3api
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3acl"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// GetObjectAclHandler Get object ACL
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectAcl.html
+func (s3a *S3ApiServer) GetObjectAclHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, object := getBucketAndObject(r)
+
+	target := util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object))
+	dir, name := target.DirAndName()
+
+	entry, err := s3a.getEntry(dir, name)
+	if err != nil {
+		glog.Errorf("GetObjectAclHandler %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	if entry == nil {
+		writeErrorResponse(w, s3err.ErrNoSuchKey, r.URL)
+		return
+	}
+
+	acl, errCode := s3a.storedOrDefaultAcl(r, bucket, entry.Extended[xhttp.SeaweedAcl])
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	writeSuccessResponseXML(w, encodeResponse(acl))
+
+}
+
+// PutObjectAclHandler Put object ACL
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectAcl.html
+func (s3a *S3ApiServer) PutObjectAclHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, object := getBucketAndObject(r)
+
+	target := util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object))
+	dir, name := target.DirAndName()
+
+	entry, err := s3a.getEntry(dir, name)
+	if err != nil {
+		glog.Errorf("PutObjectAclHandler %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	if entry == nil {
+		writeErrorResponse(w, s3err.ErrNoSuchKey, r.URL)
+		return
+	}
+
+	// the acl can not change the owner of the object
+	current, errCode := s3a.storedOrDefaultAcl(r, bucket, entry.Extended[xhttp.SeaweedAcl])
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+	acl, errCode := s3a.aclFromRequest(r, bucket, current.Owner.ID)
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	if err = s3a.setObjectAcl(dir, name, acl); err != nil {
+		if err == filer_pb.ErrNotFound {
+			writeErrorResponse(w, s3err.ErrNoSuchKey, r.URL)
+		} else {
+			glog.Errorf("PutObjectAclHandler %s: %v", r.URL, err)
+			writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		}
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+
+}
+
+// GetBucketAclHandler Get bucket ACL
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketAcl.html
+func (s3a *S3ApiServer) GetBucketAclHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	entry, errCode := s3a.checkBucketAcl(r, bucket, s3acl.PermissionReadAcp)
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	acl, errCode := s3a.storedOrDefaultAcl(r, bucket, entry.Extended[xhttp.AmzBucketAcl])
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	writeSuccessResponseXML(w, encodeResponse(acl))
+
+}
+
+// PutBucketAclHandler Put bucket ACL
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketAcl.html
+func (s3a *S3ApiServer) PutBucketAclHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	entry, errCode := s3a.checkBucketAcl(r, bucket, s3acl.PermissionWriteAcp)
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	owner := string(entry.Extended[xhttp.AmzIdentityId])
+	if owner == "" {
+		owner = r.Header.Get(xhttp.AmzIdentityId)
+	}
+	acl, errCode := s3a.aclFromRequest(r, bucket, owner)
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	if err := s3a.setBucketExtended(bucket, xhttp.AmzBucketAcl, encodeResponse(acl)); err != nil {
+		glog.Errorf("PutBucketAclHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+
+}
+
+// checkBucketAcl is the same as checkBucket, but also allows the grantees of the permission
+func (s3a *S3ApiServer) checkBucketAcl(r *http.Request, bucket string, permission string) (*filer_pb.Entry, s3err.ErrorCode) {
+	entry, err := s3a.getBucketEntry(bucket)
+	if entry == nil || err == filer_pb.ErrNotFound {
+		return nil, s3err.ErrNoSuchBucket
+	}
+	if !s3a.hasAccess(r, entry) && !hasAclAccess(r, entry, permission) {
+		return nil, s3err.ErrAccessDenied
+	}
+	return entry, s3err.ErrNone
+}
+
+// storedOrDefaultAcl parses the stored acl. Without one, only the bucket owner has full control.
+func (s3a *S3ApiServer) storedOrDefaultAcl(r *http.Request, bucket string, data []byte) (*s3acl.AccessControlPolicy, s3err.ErrorCode) {
+	if len(data) > 0 {
+		acl, err := s3acl.Parse(data)
+		if err != nil {
+			glog.Errorf("parse acl in bucket %s: %v", bucket, err)
+			return nil, s3err.ErrInternalError
+		}
+		return acl, s3err.ErrNone
+	}
+	owner, err := s3a.getBucketOwner(bucket)
+	if err != nil {
+		glog.Errorf("get owner of bucket %s: %v", bucket, err)
+		return nil, s3err.ErrInternalError
+	}
+	if owner == "" {
+		owner = r.Header.Get(xhttp.AmzIdentityId)
+	}
+	return s3acl.Private(owner), s3err.ErrNone
+}
+
+// aclFromRequest reads the acl either from the request body, or from the x-amz-acl or x-amz-grant-* headers
+func (s3a *S3ApiServer) aclFromRequest(r *http.Request, bucket, owner string) (*s3acl.AccessControlPolicy, s3err.ErrorCode) {
+
+	input, err := ioutil.ReadAll(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		glog.Errorf("read acl %s: %v", r.URL, err)
+		return nil, s3err.ErrInternalError
+	}
+
+	bucketOwner := ""
+	if s3acl.IsBucketOwnerCanned(r.Header.Get(xhttp.AmzAcl)) {
+		if bucketOwner, err = s3a.getBucketOwner(bucket); err != nil {
+			glog.Errorf("get owner of bucket %s: %v", bucket, err)
+			return nil, s3err.ErrInternalError
+		}
+	}
+	acl, err := s3acl.FromHeaders(r.Header, owner, bucketOwner)
+	if err != nil {
+		glog.V(1).Infof("acl headers %s: %v", r.URL, err)
+		return nil, s3err.ErrUnsupportedAcl
+	}
+
+	if len(input) > 0 {
+		if acl != nil {
+			return nil, s3err.ErrUnsupportedAcl
+		}
+		if acl, err = s3acl.Parse(input); err != nil {
+			glog.V(1).Infof("parse acl %s: %v", r.URL, err)
+			return nil, s3err.ErrMalformedACLError
+		}
+		acl.Owner = s3acl.Owner{ID: owner, DisplayName: owner}
+		if err = acl.Validate(); err != nil {
+			glog.V(1).Infof("acl %s: %v", r.URL, err)
+			return nil, s3err.ErrMalformedACLError
+		}
+	}
+
+	if acl == nil {
+		return nil, s3err.ErrMalformedACLError
+	}
+	return acl, s3err.ErrNone
+}
@@ -0,0 +1,77 @@
+package s3api
+
+import (
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+// The bucket entries keep the bucket settings in their extended attributes, which are read by most requests,
+// for the CORS rules, the bucket policy, the ACL, the object lock defaults and the access logging.
+// The entries are cached, and removed from the cache by the metadata events of the buckets,
+// or right after the bucket is changed by this gateway.
+
+type bucketConfigCache struct {
+	sync.Mutex
+	entries map[string]*filer_pb.Entry
+	// changed by every removal, so an entry read before the removal is not cached
+	version int64
+}
+
+func (c *bucketConfigCache) get(bucket string) (entry *filer_pb.Entry, found bool, version int64) {
+	c.Lock()
+	defer c.Unlock()
+	entry, found = c.entries[bucket]
+	return entry, found, c.version
+}
+
+func (c *bucketConfigCache) set(bucket string, entry *filer_pb.Entry, version int64) {
+	c.Lock()
+	defer c.Unlock()
+	if c.version != version {
+		return
+	}
+	if c.entries == nil {
+		c.entries = make(map[string]*filer_pb.Entry)
+	}
+	c.entries[bucket] = entry
+}
+
+func (c *bucketConfigCache) remove(bucket string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.entries, bucket)
+	c.version++
+}
+
+// getBucketEntry reads the bucket entry, which is shared by the requests and must not be changed.
+// The entry is nil if the bucket does not exist.
+func (s3a *S3ApiServer) getBucketEntry(bucket string) (*filer_pb.Entry, error) {
+	entry, found, version := s3a.bucketConfigs.get(bucket)
+	if found {
+		return entry, nil
+	}
+	entry, err := s3a.getEntry(s3a.option.BucketsPath, bucket)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+	s3a.bucketConfigs.set(bucket, entry, version)
+	return entry, nil
+}
+
+// onBucketMetadataChange removes the created, updated, renamed or deleted bucket entries from the cache
+func (s3a *S3ApiServer) onBucketMetadataChange(resp *filer_pb.SubscribeMetadataResponse) {
+	message := resp.EventNotification
+	if message.OldEntry != nil && resp.Directory == s3a.option.BucketsPath {
+		s3a.bucketConfigs.remove(message.OldEntry.Name)
+	}
+	if message.NewEntry != nil {
+		dir := resp.Directory
+		if message.NewParentPath != "" {
+			dir = message.NewParentPath
+		}
+		if dir == s3a.option.BucketsPath {
+			s3a.bucketConfigs.remove(message.NewEntry.Name)
+		}
+	}
+}
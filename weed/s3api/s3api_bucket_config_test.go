@@ -0,0 +1,51 @@
+package s3api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func TestBucketConfigCache(t *testing.T) {
+
+	s3a := &S3ApiServer{option: &S3ApiServerOption{BucketsPath: "/buckets"}}
+	cache := &s3a.bucketConfigs
+	isCached := func(bucket string) bool {
+		_, found, _ := cache.get(bucket)
+		return found
+	}
+	event := func(dir string, oldEntry, newEntry *filer_pb.Entry, newParentPath string) *filer_pb.SubscribeMetadataResponse {
+		return &filer_pb.SubscribeMetadataResponse{
+			Directory: dir,
+			EventNotification: &filer_pb.EventNotification{
+				OldEntry:      oldEntry,
+				NewEntry:      newEntry,
+				NewParentPath: newParentPath,
+			},
+		}
+	}
+
+	_, _, version := cache.get("b1")
+	cache.set("b1", &filer_pb.Entry{Name: "b1"}, version)
+	cache.set("b2", &filer_pb.Entry{Name: "b2"}, version)
+	assert.True(t, isCached("b1"))
+
+	// the objects in the bucket do not change the bucket settings
+	s3a.onBucketMetadataChange(event("/buckets/b1", nil, &filer_pb.Entry{Name: "object"}, ""))
+	assert.True(t, isCached("b1"))
+
+	// the bucket entry is updated, or renamed
+	s3a.onBucketMetadataChange(event("/buckets", &filer_pb.Entry{Name: "b1"}, &filer_pb.Entry{Name: "b1"}, ""))
+	assert.False(t, isCached("b1"))
+	s3a.onBucketMetadataChange(event("/tmp", &filer_pb.Entry{Name: "x"}, &filer_pb.Entry{Name: "b2"}, "/buckets"))
+	assert.False(t, isCached("b2"))
+
+	// the entry read before a change is not cached after the change
+	_, _, version = cache.get("b1")
+	s3a.onBucketMetadataChange(event("/buckets", &filer_pb.Entry{Name: "b1"}, nil, ""))
+	cache.set("b1", &filer_pb.Entry{Name: "b1"}, version)
+	assert.False(t, isCached("b1"))
+
+}
@@ -0,0 +1,185 @@
+package s3api
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/s3api/cors"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+// GetBucketCorsHandler Get Bucket CORS configuration
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketCors.html
+func (s3a *S3ApiServer) GetBucketCorsHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	data, err := s3a.getBucketExtended(bucket, xhttp.AmzBucketCors)
+	if err != nil {
+		glog.Errorf("GetBucketCorsHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	if len(data) == 0 {
+		writeErrorResponse(w, s3err.ErrNoSuchCORSConfiguration, r.URL)
+		return
+	}
+
+	writeSuccessResponseXML(w, data)
+
+}
+
+// PutBucketCorsHandler Put Bucket CORS configuration
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketCors.html
+func (s3a *S3ApiServer) PutBucketCorsHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	input, err := ioutil.ReadAll(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		glog.Errorf("PutBucketCorsHandler read input %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	config, err := cors.Parse(input)
+	if err != nil {
+		glog.Errorf("PutBucketCorsHandler Unmarshal %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+	if err = config.Validate(); err != nil {
+		glog.V(1).Infof("PutBucketCorsHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+
+	if err = s3a.setBucketExtended(bucket, xhttp.AmzBucketCors, encodeResponse(config)); err != nil {
+		glog.Errorf("PutBucketCorsHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+
+}
+
+// DeleteBucketCorsHandler Delete Bucket CORS configuration
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteBucketCors.html
+func (s3a *S3ApiServer) DeleteBucketCorsHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	if err := s3a.setBucketExtended(bucket, xhttp.AmzBucketCors, nil); err != nil {
+		glog.Errorf("DeleteBucketCorsHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+}
+
+// PreflightHandler answers the browser CORS preflight OPTIONS requests
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/RESTOPTIONSobject.html
+func (s3a *S3ApiServer) PreflightHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	origin := r.Header.Get("Origin")
+	method := r.Header.Get("Access-Control-Request-Method")
+	if origin == "" || method == "" {
+		writeErrorResponse(w, s3err.ErrInvalidRequest, r.URL)
+		return
+	}
+
+	var requestHeaders []string
+	for _, header := range strings.Split(r.Header.Get("Access-Control-Request-Headers"), ",") {
+		if header = strings.TrimSpace(header); header != "" {
+			requestHeaders = append(requestHeaders, header)
+		}
+	}
+
+	rule := s3a.matchCorsRule(bucket, origin, method, requestHeaders)
+	if rule == nil {
+		writeErrorResponse(w, s3err.ErrCORSForbidden, r.URL)
+		return
+	}
+
+	setCorsHeaders(w, rule, origin)
+	w.Header().Set("Access-Control-Allow-Methods", strings.Join(rule.AllowedMethods, ", "))
+	if len(requestHeaders) > 0 {
+		w.Header().Set("Access-Control-Allow-Headers", strings.Join(requestHeaders, ", "))
+	}
+	if rule.MaxAgeSeconds > 0 {
+		w.Header().Set("Access-Control-Max-Age", strconv.Itoa(rule.MaxAgeSeconds))
+	}
+	w.Header().Add("Vary", "Access-Control-Request-Headers")
+	w.Header().Add("Vary", "Access-Control-Request-Method")
+
+	writeSuccessResponseEmpty(w)
+
+}
+
+// cors adds the Access-Control-* headers to the response of a cross-origin request,
+// if the bucket CORS configuration allows it. The request is processed either way.
+func (s3a *S3ApiServer) cors(f http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if origin := r.Header.Get("Origin"); origin != "" {
+			bucket, _ := getBucketAndObject(r)
+			if rule := s3a.matchCorsRule(bucket, origin, r.Method, nil); rule != nil {
+				setCorsHeaders(w, rule, origin)
+			}
+		}
+		f(w, r)
+	}
+}
+
+func (s3a *S3ApiServer) matchCorsRule(bucket, origin, method string, requestHeaders []string) *cors.CORSRule {
+	data, err := s3a.getBucketExtended(bucket, xhttp.AmzBucketCors)
+	if err != nil {
+		glog.Errorf("read CORS configuration of %s: %v", bucket, err)
+		return nil
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	config, err := cors.Parse(data)
+	if err != nil {
+		glog.Errorf("parse CORS configuration of %s: %v", bucket, err)
+		return nil
+	}
+	return config.Match(origin, method, requestHeaders)
+}
+
+func setCorsHeaders(w http.ResponseWriter, rule *cors.CORSRule, origin string) {
+	if rule.AllowsAnyOrigin() {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	} else {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+	if len(rule.ExposeHeaders) > 0 {
+		w.Header().Set("Access-Control-Expose-Headers", strings.Join(rule.ExposeHeaders, ", "))
+	}
+	w.Header().Add("Vary", "Origin")
+}
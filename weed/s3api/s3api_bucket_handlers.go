@@ -7,14 +7,17 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
 	"math"
 	"net/http"
+	"strings"
 	"time"
 
 	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3acl"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 
+	"github.com/chrislusf/seaweedfs/weed/filer"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 )
@@ -48,14 +51,21 @@ func (s3a *S3ApiServer) ListBucketsHandler(w http.ResponseWriter, r *http.Reques
 
 	identityId := r.Header.Get(xhttp.AmzIdentityId)
 
+	// only the buckets in the namespace of the account
+	account := identity.account()
+
 	var buckets []*s3.Bucket
 	for _, entry := range entries {
 		if entry.IsDirectory {
-			if identity != nil && !identity.canDo(s3_constants.ACTION_LIST, entry.Name) {
+			if bucketAccount(entry.Name) != account {
+				continue
+			}
+			name := relativeBucket(account, entry.Name)
+			if identity != nil && !identity.canDo(s3_constants.ACTION_LIST, name) {
 				continue
 			}
 			buckets = append(buckets, &s3.Bucket{
-				Name:         aws.String(entry.Name),
+				Name:         aws.String(name),
 				CreationDate: aws.Time(time.Unix(entry.Attributes.Crtime, 0).UTC()),
 			})
 		}
@@ -106,6 +116,16 @@ func (s3a *S3ApiServer) PutBucketHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	withObjectLock := strings.EqualFold(r.Header.Get(xhttp.AmzBucketObjectLockEnabled), "true")
+	objectLockConfig, _ := xml.Marshal(&ObjectLockConfiguration{ObjectLockEnabled: objectLockEnabled})
+
+	acl, err := s3acl.FromHeaders(r.Header, r.Header.Get(xhttp.AmzIdentityId), "")
+	if err != nil {
+		glog.V(1).Infof("PutBucketHandler acl %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrUnsupportedAcl, r.URL)
+		return
+	}
+
 	fn := func(entry *filer_pb.Entry) {
 		if identityId := r.Header.Get(xhttp.AmzIdentityId); identityId != "" {
 			if entry.Extended == nil {
@@ -113,6 +133,20 @@ func (s3a *S3ApiServer) PutBucketHandler(w http.ResponseWriter, r *http.Request)
 			}
 			entry.Extended[xhttp.AmzIdentityId] = []byte(identityId)
 		}
+		if withObjectLock {
+			if entry.Extended == nil {
+				entry.Extended = make(map[string][]byte)
+			}
+			// object lock works on object versions, so versioning is always enabled
+			entry.Extended[xhttp.AmzBucketVersioning] = []byte(s3_constants.VersioningEnabled)
+			entry.Extended[filer.BucketObjectLockKey] = objectLockConfig
+		}
+		if acl != nil {
+			if entry.Extended == nil {
+				entry.Extended = make(map[string][]byte)
+			}
+			entry.Extended[xhttp.AmzBucketAcl] = encodeResponse(acl)
+		}
 	}
 
 	// create the folder for bucket, but lazily create actual collection
@@ -149,8 +183,15 @@ func (s3a *S3ApiServer) DeleteBucketHandler(w http.ResponseWriter, r *http.Reque
 		return nil
 	})
 
-	err = s3a.rm(s3a.option.BucketsPath, bucket, false, true)
+	if err == nil || !strings.Contains(err.Error(), filer.ErrSnapshotsExist.Error()) {
+		err = s3a.rm(s3a.option.BucketsPath, bucket, false, true)
+		s3a.bucketConfigs.remove(bucket)
+	}
 
+	if err != nil && strings.Contains(err.Error(), filer.ErrSnapshotsExist.Error()) {
+		writeErrorResponse(w, s3err.ErrBucketNotEmpty, r.URL)
+		return
+	}
 	if err != nil {
 		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
 		return
@@ -172,7 +213,7 @@ func (s3a *S3ApiServer) HeadBucketHandler(w http.ResponseWriter, r *http.Request
 }
 
 func (s3a *S3ApiServer) checkBucket(r *http.Request, bucket string) s3err.ErrorCode {
-	entry, err := s3a.getEntry(s3a.option.BucketsPath, bucket)
+	entry, err := s3a.getBucketEntry(bucket)
 	if entry == nil || err == filer_pb.ErrNotFound {
 		return s3err.ErrNoSuchBucket
 	}
@@ -195,7 +236,7 @@ func (s3a *S3ApiServer) hasAccess(r *http.Request, entry *filer_pb.Entry) bool {
 	identityId := r.Header.Get(xhttp.AmzIdentityId)
 	if id, ok := entry.Extended[xhttp.AmzIdentityId]; ok {
 		if identityId != string(id) {
-			return false
+			return hasAclAccess(r, entry, s3acl.PermissionFullControl)
 		}
 	}
 	return true
@@ -0,0 +1,98 @@
+package s3api
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/lifecycle"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+// GetBucketLifecycleConfigurationHandler Get Bucket Lifecycle configuration
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketLifecycleConfiguration.html
+func (s3a *S3ApiServer) GetBucketLifecycleConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	data, err := s3a.getBucketExtended(bucket, xhttp.AmzBucketLifecycle)
+	if err != nil {
+		glog.Errorf("GetBucketLifecycleConfigurationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	if len(data) == 0 {
+		writeErrorResponse(w, s3err.ErrNoSuchLifecycleConfiguration, r.URL)
+		return
+	}
+
+	writeSuccessResponseXML(w, data)
+
+}
+
+// PutBucketLifecycleConfigurationHandler Put Bucket Lifecycle configuration
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLifecycleConfiguration.html
+func (s3a *S3ApiServer) PutBucketLifecycleConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	input, err := ioutil.ReadAll(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		glog.Errorf("PutBucketLifecycleConfigurationHandler read input %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	config, err := lifecycle.Parse(input)
+	if err != nil {
+		glog.Errorf("PutBucketLifecycleConfigurationHandler Unmarshal %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+	if err = config.Validate(); err != nil {
+		glog.V(1).Infof("PutBucketLifecycleConfigurationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+
+	if err = s3a.setBucketExtended(bucket, xhttp.AmzBucketLifecycle, encodeResponse(config)); err != nil {
+		glog.Errorf("PutBucketLifecycleConfigurationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+
+}
+
+// DeleteBucketLifecycleHandler Delete Bucket Lifecycle
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteBucketLifecycle.html
+func (s3a *S3ApiServer) DeleteBucketLifecycleHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	if err := s3a.setBucketExtended(bucket, xhttp.AmzBucketLifecycle, nil); err != nil {
+		glog.Errorf("DeleteBucketLifecycleHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+}
@@ -0,0 +1,89 @@
+package s3api
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3logging"
+)
+
+// GetBucketLoggingHandler Get Bucket logging status
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketLogging.html
+func (s3a *S3ApiServer) GetBucketLoggingHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	data, err := s3a.getBucketExtended(bucket, xhttp.AmzBucketLogging)
+	if err != nil {
+		glog.Errorf("GetBucketLoggingHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	if len(data) == 0 {
+		// the access logging is off
+		data = encodeResponse(s3logging.BucketLoggingStatus{})
+	}
+
+	writeSuccessResponseXML(w, data)
+
+}
+
+// PutBucketLoggingHandler Put Bucket logging status
+// A status without LoggingEnabled turns off the access logging of the bucket.
+// The target bucket must be in the same account, and owned by the requester.
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLogging.html
+func (s3a *S3ApiServer) PutBucketLoggingHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	input, err := ioutil.ReadAll(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		glog.Errorf("PutBucketLoggingHandler read input %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	status, err := s3logging.Parse(input)
+	if err != nil {
+		glog.Errorf("PutBucketLoggingHandler Unmarshal %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+	if err = status.Validate(); err != nil {
+		glog.V(1).Infof("PutBucketLoggingHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+
+	var data []byte
+	if status.LoggingEnabled != nil {
+		targetBucket := accountBucket(bucketAccount(bucket), status.LoggingEnabled.TargetBucket)
+		if errCode := s3a.checkBucket(r, targetBucket); errCode != s3err.ErrNone {
+			glog.V(1).Infof("PutBucketLoggingHandler %s target %s: %v", bucket, targetBucket, errCode)
+			writeErrorResponse(w, s3err.ErrInvalidTargetBucketForLogging, r.URL)
+			return
+		}
+		data = encodeResponse(status)
+	}
+	if err = s3a.setBucketExtended(bucket, xhttp.AmzBucketLogging, data); err != nil {
+		glog.Errorf("PutBucketLoggingHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+
+}
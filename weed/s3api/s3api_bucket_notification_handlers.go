@@ -0,0 +1,82 @@
+package s3api
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3event"
+)
+
+// GetBucketNotificationConfigurationHandler Get Bucket Notification configuration
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketNotificationConfiguration.html
+func (s3a *S3ApiServer) GetBucketNotificationConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	data, err := s3a.getBucketExtended(bucket, xhttp.AmzBucketNotification)
+	if err != nil {
+		glog.Errorf("GetBucketNotificationConfigurationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	if len(data) == 0 {
+		// no notifications are configured
+		data = encodeResponse(s3event.NotificationConfiguration{})
+	}
+
+	writeSuccessResponseXML(w, data)
+
+}
+
+// PutBucketNotificationConfigurationHandler Put Bucket Notification configuration
+// An empty configuration turns off the notifications of the bucket.
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketNotificationConfiguration.html
+func (s3a *S3ApiServer) PutBucketNotificationConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	input, err := ioutil.ReadAll(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		glog.Errorf("PutBucketNotificationConfigurationHandler read input %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	config, err := s3event.Parse(input)
+	if err != nil {
+		glog.Errorf("PutBucketNotificationConfigurationHandler Unmarshal %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+	if err = config.Validate(); err != nil {
+		glog.V(1).Infof("PutBucketNotificationConfigurationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInvalidNotificationConfiguration, r.URL)
+		return
+	}
+
+	var data []byte
+	if len(config.TopicConfigurations)+len(config.QueueConfigurations)+len(config.CloudFunctionConfigurations) > 0 {
+		data = encodeResponse(config)
+	}
+	if err = s3a.setBucketExtended(bucket, xhttp.AmzBucketNotification, data); err != nil {
+		glog.Errorf("PutBucketNotificationConfigurationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+
+}
@@ -0,0 +1,108 @@
+package s3api
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/s3api/bucketpolicy"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+// GetBucketPolicyHandler Get bucket Policy
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketPolicy.html
+func (s3a *S3ApiServer) GetBucketPolicyHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	data, err := s3a.getBucketExtended(bucket, xhttp.AmzBucketPolicy)
+	if err != nil {
+		glog.Errorf("GetBucketPolicyHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	if len(data) == 0 {
+		writeErrorResponse(w, s3err.ErrNoSuchBucketPolicy, r.URL)
+		return
+	}
+
+	writeResponse(w, http.StatusOK, data, mimeJSON)
+
+}
+
+// PutBucketPolicyHandler Put bucket Policy
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketPolicy.html
+func (s3a *S3ApiServer) PutBucketPolicyHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	input, err := ioutil.ReadAll(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		glog.Errorf("PutBucketPolicyHandler read input %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	policy, err := bucketpolicy.Parse(input)
+	if err != nil {
+		glog.V(1).Infof("PutBucketPolicyHandler Unmarshal %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrMalformedPolicy, r.URL)
+		return
+	}
+	if err = policy.Validate(bucket); err != nil {
+		glog.V(1).Infof("PutBucketPolicyHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrMalformedPolicy, r.URL)
+		return
+	}
+
+	data, _ := json.Marshal(policy)
+	if err = s3a.setBucketExtended(bucket, xhttp.AmzBucketPolicy, data); err != nil {
+		glog.Errorf("PutBucketPolicyHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+}
+
+// DeleteBucketPolicyHandler Delete bucket Policy
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteBucketPolicy.html
+func (s3a *S3ApiServer) DeleteBucketPolicyHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	if err := s3a.setBucketExtended(bucket, xhttp.AmzBucketPolicy, nil); err != nil {
+		glog.Errorf("DeleteBucketPolicyHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+}
+
+func (s3a *S3ApiServer) getBucketPolicy(bucket string) (*bucketpolicy.Policy, error) {
+	data, err := s3a.getBucketExtended(bucket, xhttp.AmzBucketPolicy)
+	if err != nil || len(data) == 0 {
+		return nil, err
+	}
+	return bucketpolicy.Parse(data)
+}
@@ -0,0 +1,108 @@
+package s3api
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3replication"
+)
+
+// GetBucketReplicationHandler Get Bucket replication configuration
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketReplication.html
+func (s3a *S3ApiServer) GetBucketReplicationHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	data, err := s3a.getBucketExtended(bucket, xhttp.AmzBucketReplication)
+	if err != nil {
+		glog.Errorf("GetBucketReplicationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	if len(data) == 0 {
+		writeErrorResponse(w, s3err.ErrReplicationConfigurationNotFound, r.URL)
+		return
+	}
+
+	writeSuccessResponseXML(w, data)
+
+}
+
+// PutBucketReplicationHandler Put Bucket replication configuration
+// The objects are replicated by the filers, to the targets configured in their notification.toml.
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketReplication.html
+func (s3a *S3ApiServer) PutBucketReplicationHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	input, err := ioutil.ReadAll(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		glog.Errorf("PutBucketReplicationHandler read input %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	config, err := s3replication.Parse(input)
+	if err != nil {
+		glog.Errorf("PutBucketReplicationHandler Unmarshal %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+	if err = config.Validate(); err != nil {
+		glog.V(1).Infof("PutBucketReplicationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+
+	if err = s3a.setBucketExtended(bucket, xhttp.AmzBucketReplication, encodeResponse(config)); err != nil {
+		glog.Errorf("PutBucketReplicationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+
+}
+
+// DeleteBucketReplicationHandler Delete Bucket replication configuration
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteBucketReplication.html
+func (s3a *S3ApiServer) DeleteBucketReplicationHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	if err := s3a.setBucketExtended(bucket, xhttp.AmzBucketReplication, nil); err != nil {
+		glog.Errorf("DeleteBucketReplicationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+}
+
+// canMarkReplica tells whether the object written is a replica, written by the bucket replication of another cluster.
+// The replicas are not replicated again, so the header is only trusted from the admins.
+func (s3a *S3ApiServer) canMarkReplica(r *http.Request) bool {
+	if r.Header.Get(xhttp.AmzReplicationStatus) != s3replication.StatusReplica {
+		return false
+	}
+	return !s3a.iam.isEnabled() || r.Header.Get(xhttp.AmzIsAdmin) != ""
+}
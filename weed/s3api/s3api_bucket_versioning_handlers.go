@@ -0,0 +1,98 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+type VersioningConfig struct {
+	XMLName   xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ VersioningConfiguration"`
+	Status    string   `xml:"Status,omitempty"`
+	MfaDelete string   `xml:"MfaDelete,omitempty"`
+}
+
+// GetBucketVersioningHandler Get bucket versioning
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetBucketVersioning.html
+func (s3a *S3ApiServer) GetBucketVersioningHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	status, err := s3a.getBucketVersioning(bucket)
+	if err != nil {
+		glog.Errorf("GetBucketVersioningHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	writeSuccessResponseXML(w, encodeResponse(&VersioningConfig{
+		Status: status,
+	}))
+
+}
+
+// PutBucketVersioningHandler Put bucket versioning
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketVersioning.html
+func (s3a *S3ApiServer) PutBucketVersioningHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	input, err := ioutil.ReadAll(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		glog.Errorf("PutBucketVersioningHandler read input %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	config := &VersioningConfig{}
+	if err = xml.Unmarshal(input, config); err != nil {
+		glog.Errorf("PutBucketVersioningHandler Unmarshal %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+	if config.Status != s3_constants.VersioningEnabled && config.Status != s3_constants.VersioningSuspended {
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+	if config.MfaDelete == "Enabled" {
+		writeErrorResponse(w, s3err.ErrNotImplemented, r.URL)
+		return
+	}
+
+	if config.Status == s3_constants.VersioningSuspended {
+		// object lock works on object versions, so versioning can not be suspended
+		lockConfig, err := s3a.getBucketObjectLock(bucket)
+		if err != nil {
+			glog.Errorf("PutBucketVersioningHandler object lock %s: %v", bucket, err)
+			writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+			return
+		}
+		if lockConfig != nil {
+			writeErrorResponse(w, s3err.ErrInvalidBucketState, r.URL)
+			return
+		}
+	}
+
+	if err = s3a.setBucketVersioning(bucket, config.Status); err != nil {
+		glog.Errorf("PutBucketVersioningHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+
+}
@@ -0,0 +1,85 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersioningConfigXML(t *testing.T) {
+
+	input := `<?xml version="1.0" encoding="UTF-8"?>
+<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+   <Status>Enabled</Status>
+</VersioningConfiguration>
+`
+
+	config := &VersioningConfig{}
+	assert.Nil(t, xml.Unmarshal([]byte(input), config))
+	assert.Equal(t, s3_constants.VersioningEnabled, config.Status)
+
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<VersioningConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>Suspended</Status></VersioningConfiguration>`
+	assert.Equal(t, expected, string(encodeResponse(&VersioningConfig{Status: s3_constants.VersioningSuspended})))
+
+}
+
+func TestGenerateVersionId(t *testing.T) {
+
+	older := s3_constants.NewVersionId()
+	time.Sleep(time.Millisecond)
+	newer := s3_constants.NewVersionId()
+
+	assert.True(t, newer < older, "newer version %s should sort before %s", newer, older)
+
+}
+
+func TestParseCopySource(t *testing.T) {
+
+	cpSrcPath, versionId := parseCopySource("/bucket/dir/a%20b.txt?versionId=abc")
+	assert.Equal(t, "/bucket/dir/a b.txt", cpSrcPath)
+	assert.Equal(t, "abc", versionId)
+
+	cpSrcPath, versionId = parseCopySource("bucket/key")
+	assert.Equal(t, "bucket/key", cpSrcPath)
+	assert.Equal(t, "", versionId)
+
+}
+
+func TestVersionsFolderKey(t *testing.T) {
+
+	assert.True(t, isVersionsFolderKey("/.versions"))
+	assert.True(t, isVersionsFolderKey("/.versions/dir/a.txt/6720c39eef1990ce64fcd28b"))
+	assert.True(t, isVersionsFolderKey(".versions/a.txt"))
+	assert.False(t, isVersionsFolderKey("/.versionsx"))
+	assert.False(t, isVersionsFolderKey("/dir/.versions/a.txt"))
+
+}
+
+func TestLockObject(t *testing.T) {
+
+	s3a := &S3ApiServer{}
+	unlock := s3a.lockObject("logs", "/a.txt")
+	s3a.lockObject("logs", "/b.txt")()
+
+	locked := make(chan struct{})
+	go func() {
+		s3a.lockObject("logs", "/a.txt")()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		t.Fatal("the same object is locked twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	<-locked
+
+	s3a.objectLocks.Lock()
+	defer s3a.objectLocks.Unlock()
+	assert.Empty(t, s3a.objectLocks.locks)
+
+}
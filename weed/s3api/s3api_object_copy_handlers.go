@@ -2,7 +2,10 @@ package s3api
 
 import (
 	"fmt"
+	"github.com/chrislusf/seaweedfs/weed/filer"
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
 	weed_server "github.com/chrislusf/seaweedfs/weed/server"
 	"net/http"
@@ -19,13 +22,10 @@ func (s3a *S3ApiServer) CopyObjectHandler(w http.ResponseWriter, r *http.Request
 	dstBucket, dstObject := getBucketAndObject(r)
 
 	// Copy source path.
-	cpSrcPath, err := url.QueryUnescape(r.Header.Get("X-Amz-Copy-Source"))
-	if err != nil {
-		// Save unescaped string as is.
-		cpSrcPath = r.Header.Get("X-Amz-Copy-Source")
-	}
+	cpSrcPath, srcVersionId := parseCopySource(r.Header.Get("X-Amz-Copy-Source"))
 
 	srcBucket, srcObject := pathToBucketAndObject(cpSrcPath)
+	srcBucket = accountBucket(r.Header.Get(xhttp.AmzAccountId), srcBucket)
 
 	if (srcBucket == dstBucket && srcObject == dstObject || cpSrcPath == "") && isReplace(r) {
 		fullPath := util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, dstBucket, dstObject))
@@ -59,25 +59,69 @@ func (s3a *S3ApiServer) CopyObjectHandler(w http.ResponseWriter, r *http.Request
 
 	dstUrl := fmt.Sprintf("http://%s%s/%s%s?collection=%s",
 		s3a.option.Filer, s3a.option.BucketsPath, dstBucket, dstObject, dstBucket)
-	srcUrl := fmt.Sprintf("http://%s%s/%s%s",
-		s3a.option.Filer, s3a.option.BucketsPath, srcBucket, srcObject)
+	srcPath, srcEntry, errCode := s3a.getCopySource(srcBucket, srcObject, srcVersionId)
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+	// the copy source range is only for UploadPartCopy
+	r.Header.Del(xhttp.AmzCopySourceRange)
 
-	_, _, resp, err := util.DownloadFile(srcUrl)
+	lockAttributes, errCode := s3a.objectLockAttributes(r, dstBucket)
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	if errCode = validateSseHeaders(r.Header); errCode == s3err.ErrNone {
+		errCode = validateCopySourceSseHeaders(r.Header)
+	}
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	if errCode = s3a.checkIdentityQuota(r, int64(filer.FileSize(srcEntry))); errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	if errCode = s3a.setObjectAclHeader(r, dstBucket); errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	defer s3a.lockObject(dstBucket, dstObject)()
+
+	versionId, err := s3a.prepareObjectOverwrite(dstBucket, dstObject)
 	if err != nil {
-		writeErrorResponse(w, s3err.ErrInvalidCopySource, r.URL)
+		glog.Errorf("CopyObjectHandler prepare versioning %s%s: %v", dstBucket, dstObject, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
 		return
 	}
-	defer util.CloseResponse(resp)
+	setObjectVersionHeader(r, versionId)
+	setObjectLockHeaders(r, lockAttributes)
 
-	glog.V(2).Infof("copy from %s to %s", srcUrl, dstUrl)
-	etag, errCode := s3a.putToFiler(r, dstUrl, resp.Body)
+	glog.V(2).Infof("copy from %s to %s", srcPath, dstUrl)
+	etag, errCode := s3a.copyToFiler(r, dstUrl, srcPath)
 
 	if errCode != s3err.ErrNone {
+		if err := s3a.restoreLatestVersion(dstBucket, dstObject); err != nil {
+			glog.Errorf("CopyObjectHandler restore %s%s: %v", dstBucket, dstObject, err)
+		}
 		writeErrorResponse(w, errCode, r.URL)
 		return
 	}
+	if err := s3a.finishObjectOverwrite(dstBucket, dstObject, versionId); err != nil {
+		glog.Errorf("CopyObjectHandler remove replaced version of %s%s: %v", dstBucket, dstObject, err)
+	}
 
 	setEtag(w, etag)
+	setVersionId(w, versionId)
+	setSseResponseHeaders(w, r.Header)
+	if srcVersionId != "" {
+		w.Header().Set(xhttp.AmzCopySourceVersionId, srcVersionId)
+	}
 
 	response := CopyObjectResult{
 		ETag:         etag,
@@ -88,6 +132,40 @@ func (s3a *S3ApiServer) CopyObjectHandler(w http.ResponseWriter, r *http.Request
 
 }
 
+// parseCopySource splits the X-Amz-Copy-Source header into the source path and the optional version id
+func parseCopySource(copySource string) (cpSrcPath, versionId string) {
+	if index := strings.Index(copySource, "?versionId="); index >= 0 {
+		copySource, versionId = copySource[:index], copySource[index+len("?versionId="):]
+	}
+	cpSrcPath, err := url.QueryUnescape(copySource)
+	if err != nil {
+		// Save unescaped string as is.
+		cpSrcPath = copySource
+	}
+	return
+}
+
+// getCopySource returns the path and the entry of the copy source object, or of its version
+func (s3a *S3ApiServer) getCopySource(srcBucket, srcObject, srcVersionId string) (srcPath util.FullPath, srcEntry *filer_pb.Entry, errCode s3err.ErrorCode) {
+	if isVersionsFolderKey(srcObject) {
+		return "", nil, s3err.ErrReservedObjectKey
+	}
+	if srcVersionId != "" {
+		srcPath, srcEntry, errCode = s3a.getObjectVersion(srcBucket, srcObject, srcVersionId)
+		if errCode == s3err.ErrMethodNotAllowed {
+			return "", nil, s3err.ErrInvalidCopySource
+		}
+		return
+	}
+	srcPath = util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, srcBucket, srcObject))
+	dir, name := srcPath.DirAndName()
+	srcEntry, err := s3a.getEntry(dir, name)
+	if err != nil || srcEntry == nil || srcEntry.IsDirectory {
+		return "", nil, s3err.ErrInvalidCopySource
+	}
+	return srcPath, srcEntry, s3err.ErrNone
+}
+
 func pathToBucketAndObject(path string) (bucket, object string) {
 	path = strings.TrimPrefix(path, "/")
 	parts := strings.SplitN(path, "/", 2)
@@ -108,13 +186,10 @@ func (s3a *S3ApiServer) CopyObjectPartHandler(w http.ResponseWriter, r *http.Req
 	dstBucket, _ := getBucketAndObject(r)
 
 	// Copy source path.
-	cpSrcPath, err := url.QueryUnescape(r.Header.Get("X-Amz-Copy-Source"))
-	if err != nil {
-		// Save unescaped string as is.
-		cpSrcPath = r.Header.Get("X-Amz-Copy-Source")
-	}
+	cpSrcPath, srcVersionId := parseCopySource(r.Header.Get("X-Amz-Copy-Source"))
 
 	srcBucket, srcObject := pathToBucketAndObject(cpSrcPath)
+	srcBucket = accountBucket(r.Header.Get(xhttp.AmzAccountId), srcBucket)
 	// If source object is empty or bucket is empty, reply back invalid copy source.
 	if srcObject == "" || srcBucket == "" {
 		writeErrorResponse(w, s3err.ErrInvalidCopySource, r.URL)
@@ -136,22 +211,47 @@ func (s3a *S3ApiServer) CopyObjectPartHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	rangeHeader := r.Header.Get("x-amz-copy-source-range")
+	uploadEntry, err := s3a.getEntry(s3a.genUploadsFolder(dstBucket), uploadID)
+	if err != nil || uploadEntry == nil || !uploadEntry.IsDirectory {
+		writeErrorResponse(w, s3err.ErrNoSuchUpload, r.URL)
+		return
+	}
+
+	errCode := validateSseHeaders(r.Header)
+	if errCode == s3err.ErrNone {
+		errCode = validateCopySourceSseHeaders(r.Header)
+	}
+	if errCode == s3err.ErrNone {
+		errCode = setUploadPartSseHeaders(r, uploadEntry.Extended)
+	}
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
 
 	dstUrl := fmt.Sprintf("http://%s%s/%s/%04d.part?collection=%s",
 		s3a.option.Filer, s3a.genUploadsFolder(dstBucket), uploadID, partID, dstBucket)
-	srcUrl := fmt.Sprintf("http://%s%s/%s%s",
-		s3a.option.Filer, s3a.option.BucketsPath, srcBucket, srcObject)
+	srcPath, srcEntry, errCode := s3a.getCopySource(srcBucket, srcObject, srcVersionId)
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
 
-	dataReader, err := util.ReadUrlAsReaderCloser(srcUrl, rangeHeader)
-	if err != nil {
-		writeErrorResponse(w, s3err.ErrInvalidCopySource, r.URL)
+	size := int64(filer.FileSize(srcEntry))
+	if rangeHeader := r.Header.Get(xhttp.AmzCopySourceRange); rangeHeader != "" {
+		if _, size, err = weed_server.ParseCopySourceRange(rangeHeader, size); err != nil {
+			writeErrorResponse(w, s3err.ErrInvalidRange, r.URL)
+			return
+		}
+	}
+
+	if errCode = s3a.checkIdentityQuota(r, size); errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
 		return
 	}
-	defer dataReader.Close()
 
-	glog.V(2).Infof("copy from %s to %s", srcUrl, dstUrl)
-	etag, errCode := s3a.putToFiler(r, dstUrl, dataReader)
+	glog.V(2).Infof("copy from %s to %s", srcPath, dstUrl)
+	etag, errCode := s3a.copyToFiler(r, dstUrl, srcPath)
 
 	if errCode != s3err.ErrNone {
 		writeErrorResponse(w, errCode, r.URL)
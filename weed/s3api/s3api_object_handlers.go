@@ -15,6 +15,8 @@ import (
 	"strings"
 	"time"
 
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
 
 	"github.com/gorilla/mux"
@@ -62,6 +64,16 @@ func (s3a *S3ApiServer) PutObjectHandler(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
+	if errCode := validateSseHeaders(r.Header); errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	if err = weed_server.ValidateChecksumHeaders(r.Header); err != nil {
+		writeErrorResponse(w, s3err.ErrInvalidChecksum, r.URL)
+		return
+	}
+
 	dataReader := r.Body
 	if s3a.iam.isEnabled() {
 		rAuthType := getRequestAuthType(r)
@@ -95,14 +107,62 @@ func (s3a *S3ApiServer) PutObjectHandler(w http.ResponseWriter, r *http.Request)
 	} else {
 		uploadUrl := fmt.Sprintf("http://%s%s/%s%s", s3a.option.Filer, s3a.option.BucketsPath, bucket, urlPathEscape(object))
 
+		if errCode := s3a.checkIdentityQuota(r, requestContentLength(r)); errCode != s3err.ErrNone {
+			writeErrorResponse(w, errCode, r.URL)
+			return
+		}
+
+		lockAttributes, errCode := s3a.objectLockAttributes(r, bucket)
+		if errCode != s3err.ErrNone {
+			writeErrorResponse(w, errCode, r.URL)
+			return
+		}
+
+		if errCode = s3a.setObjectAclHeader(r, bucket); errCode != s3err.ErrNone {
+			writeErrorResponse(w, errCode, r.URL)
+			return
+		}
+
+		if errCode = s3a.checkCreateOnly(r, bucket, object); errCode != s3err.ErrNone {
+			writeErrorResponse(w, errCode, r.URL)
+			return
+		}
+
+		defer s3a.lockObject(bucket, object)()
+
+		var versionId string
+		if isCreateOnly(r) {
+			// nothing to keep, and the current object written meanwhile fails the exclusive create in the filer
+			var status string
+			status, err = s3a.getBucketVersioning(bucket)
+			versionId = newObjectVersionId(status)
+		} else {
+			versionId, err = s3a.prepareObjectOverwrite(bucket, object)
+		}
+		if err != nil {
+			glog.Errorf("PutObjectHandler prepare versioning %s%s: %v", bucket, object, err)
+			writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+			return
+		}
+		setObjectVersionHeader(r, versionId)
+		setObjectLockHeaders(r, lockAttributes)
+
 		etag, errCode := s3a.putToFiler(r, uploadUrl, dataReader)
 
 		if errCode != s3err.ErrNone {
+			if err := s3a.restoreLatestVersion(bucket, object); err != nil {
+				glog.Errorf("PutObjectHandler restore %s%s: %v", bucket, object, err)
+			}
 			writeErrorResponse(w, errCode, r.URL)
 			return
 		}
+		if err := s3a.finishObjectOverwrite(bucket, object, versionId); err != nil {
+			glog.Errorf("PutObjectHandler remove replaced version of %s%s: %v", bucket, object, err)
+		}
 
 		setEtag(w, etag)
+		setVersionId(w, versionId)
+		setSseResponseHeaders(w, r.Header)
 	}
 
 	writeSuccessResponseEmpty(w)
@@ -125,8 +185,16 @@ func (s3a *S3ApiServer) GetObjectHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	destUrl := fmt.Sprintf("http://%s%s/%s%s",
-		s3a.option.Filer, s3a.option.BucketsPath, bucket, urlPathEscape(object))
+	if errCode := validateSseHeaders(r.Header); errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	destUrl, errCode := s3a.getObjectUrl(w, r, bucket, object)
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
 
 	s3a.proxyToFiler(w, r, destUrl, passThroughResponse)
 
@@ -136,17 +204,72 @@ func (s3a *S3ApiServer) HeadObjectHandler(w http.ResponseWriter, r *http.Request
 
 	bucket, object := getBucketAndObject(r)
 
-	destUrl := fmt.Sprintf("http://%s%s/%s%s",
-		s3a.option.Filer, s3a.option.BucketsPath, bucket, urlPathEscape(object))
+	if errCode := validateSseHeaders(r.Header); errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	destUrl, errCode := s3a.getObjectUrl(w, r, bucket, object)
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
 
 	s3a.proxyToFiler(w, r, destUrl, passThroughResponse)
 
 }
 
+// getObjectUrl resolves the filer url of the object, or of the version specified by the versionId query parameter
+func (s3a *S3ApiServer) getObjectUrl(w http.ResponseWriter, r *http.Request, bucket, object string) (destUrl string, errCode s3err.ErrorCode) {
+
+	versionId := r.URL.Query().Get("versionId")
+	if versionId == "" {
+		return fmt.Sprintf("http://%s%s/%s%s",
+			s3a.option.Filer, s3a.option.BucketsPath, bucket, urlPathEscape(object)), s3err.ErrNone
+	}
+
+	fullPath, _, errCode := s3a.getObjectVersion(bucket, object, versionId)
+	if errCode == s3err.ErrMethodNotAllowed {
+		w.Header().Set(xhttp.AmzDeleteMarker, "true")
+		setVersionId(w, versionId)
+	}
+	if errCode != s3err.ErrNone {
+		return "", errCode
+	}
+
+	return fmt.Sprintf("http://%s%s", s3a.option.Filer, urlPathEscape(string(fullPath))), s3err.ErrNone
+}
+
 func (s3a *S3ApiServer) DeleteObjectHandler(w http.ResponseWriter, r *http.Request) {
 
 	bucket, object := getBucketAndObject(r)
 
+	versionId := r.URL.Query().Get("versionId")
+	versioning, err := s3a.getBucketVersioning(bucket)
+	if err != nil {
+		glog.Errorf("DeleteObjectHandler versioning %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	if versioning != "" || versionId != "" {
+		resultVersionId, deleteMarker, err := s3a.deleteVersionedObject(bucket, object, versionId, s3a.canBypassGovernanceRetention(r))
+		if err != nil {
+			glog.Errorf("DeleteObjectHandler %s%s version %s: %v", bucket, object, versionId, err)
+			if isObjectLockedError(err) {
+				writeErrorResponse(w, s3err.ErrObjectLocked, r.URL)
+				return
+			}
+			writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+			return
+		}
+		setVersionId(w, resultVersionId)
+		if deleteMarker {
+			w.Header().Set(xhttp.AmzDeleteMarker, "true")
+		}
+		writeResponse(w, http.StatusNoContent, nil, mimeNone)
+		return
+	}
+
 	destUrl := fmt.Sprintf("http://%s%s/%s%s?recursive=true",
 		s3a.option.Filer, s3a.option.BucketsPath, bucket, urlPathEscape(object))
 
@@ -160,7 +283,10 @@ func (s3a *S3ApiServer) DeleteObjectHandler(w http.ResponseWriter, r *http.Reque
 
 // / ObjectIdentifier carries key name for the object to delete.
 type ObjectIdentifier struct {
-	ObjectName string `xml:"Key"`
+	ObjectName            string `xml:"Key"`
+	VersionId             string `xml:"VersionId,omitempty"`
+	DeleteMarker          bool   `xml:"DeleteMarker,omitempty"`
+	DeleteMarkerVersionId string `xml:"DeleteMarkerVersionId,omitempty"`
 }
 
 // DeleteObjectsRequest - xml carrying the object key names which needs to be deleted.
@@ -178,6 +304,15 @@ type DeleteError struct {
 	Key     string
 }
 
+func newDeleteError(key string, errCode s3err.ErrorCode) DeleteError {
+	apiErr := s3err.GetAPIError(errCode)
+	return DeleteError{
+		Code:    apiErr.Code,
+		Message: apiErr.Description,
+		Key:     key,
+	}
+}
+
 // DeleteObjectsResponse container for multiple object deletes.
 type DeleteObjectsResponse struct {
 	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ DeleteResult" json:"-"`
@@ -211,11 +346,44 @@ func (s3a *S3ApiServer) DeleteMultipleObjectsHandler(w http.ResponseWriter, r *h
 
 	directoriesWithDeletion := make(map[string]int)
 
+	versioning, err := s3a.getBucketVersioning(bucket)
+	if err != nil {
+		glog.Errorf("DeleteMultipleObjectsHandler versioning %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	bypassGovernance := s3a.canBypassGovernanceRetention(r)
+
 	s3a.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
 
 		// delete file entries
 		for _, object := range deleteObjects.Objects {
 
+			errCode := s3err.ErrReservedObjectKey
+			if !isVersionsFolderKey(object.ObjectName) {
+				errCode = s3a.authorizeDeleteObject(r, bucket, object)
+			}
+			if errCode != s3err.ErrNone {
+				deleteErrors = append(deleteErrors, newDeleteError(object.ObjectName, errCode))
+				continue
+			}
+
+			if versioning != "" || object.VersionId != "" {
+				versionId, deleteMarker, err := s3a.deleteVersionedObject(bucket, "/"+strings.TrimPrefix(object.ObjectName, "/"), object.VersionId, bypassGovernance)
+				if err != nil {
+					glog.Errorf("DeleteMultipleObjectsHandler %s/%s version %s: %v", bucket, object.ObjectName, object.VersionId, err)
+					deleteErrors = append(deleteErrors, newDeleteError(object.ObjectName, filerErrorToS3Error(err.Error())))
+					continue
+				}
+				if deleteMarker {
+					object.DeleteMarker = true
+					object.DeleteMarkerVersionId = versionId
+				}
+				deletedObjects = append(deletedObjects, object)
+				continue
+			}
+
 			lastSeparator := strings.LastIndex(object.ObjectName, "/")
 			parentDirectoryPath, entryName, isDeleteData, isRecursive := "", object.ObjectName, true, false
 			if lastSeparator > 0 && lastSeparator+1 < len(object.ObjectName) {
@@ -232,11 +400,8 @@ func (s3a *S3ApiServer) DeleteMultipleObjectsHandler(w http.ResponseWriter, r *h
 				deletedObjects = append(deletedObjects, object)
 			} else {
 				delete(directoriesWithDeletion, parentDirectoryPath)
-				deleteErrors = append(deleteErrors, DeleteError{
-					Code:    "",
-					Message: err.Error(),
-					Key:     object.ObjectName,
-				})
+				glog.Errorf("DeleteMultipleObjectsHandler %s/%s: %v", bucket, object.ObjectName, err)
+				deleteErrors = append(deleteErrors, newDeleteError(object.ObjectName, filerErrorToS3Error(err.Error())))
 			}
 		}
 
@@ -258,6 +423,22 @@ func (s3a *S3ApiServer) DeleteMultipleObjectsHandler(w http.ResponseWriter, r *h
 
 }
 
+// authorizeDeleteObject checks each key of DeleteObjects as a DeleteObject or DeleteObjectVersion request,
+// while the request itself is only authorized on the bucket
+func (s3a *S3ApiServer) authorizeDeleteObject(r *http.Request, bucket string, object ObjectIdentifier) s3err.ErrorCode {
+	if !s3a.iam.isEnabled() {
+		return s3err.ErrNone
+	}
+	deleteRequest := r.Clone(r.Context())
+	deleteRequest.Method = http.MethodDelete
+	query := url.Values{}
+	if object.VersionId != "" {
+		query.Set("versionId", object.VersionId)
+	}
+	deleteRequest.URL.RawQuery = query.Encode()
+	return s3a.iam.authorize(deleteRequest, requestIdentity(r), s3_constants.ACTION_WRITE, bucket, "/"+strings.TrimPrefix(object.ObjectName, "/"))
+}
+
 func (s3a *S3ApiServer) doDeleteEmptyDirectories(client filer_pb.SeaweedFilerClient, directoriesWithDeletion map[string]int) (newDirectoriesWithDeletion map[string]int) {
 	var allDirs []string
 	for dir, _ := range directoriesWithDeletion {
@@ -338,6 +519,13 @@ func (s3a *S3ApiServer) proxyToFiler(w http.ResponseWriter, r *http.Request, des
 		return
 	}
 
+	if r.Method == "GET" || r.Method == "HEAD" {
+		if errCode := sseReadStatusToS3Error(resp.StatusCode); errCode != s3err.ErrNone {
+			writeErrorResponse(w, errCode, r.URL)
+			return
+		}
+	}
+
 	if (resp.ContentLength == -1 || resp.StatusCode == 404) && resp.StatusCode != 304 {
 		if r.Method != "DELETE" {
 			writeErrorResponse(w, s3err.ErrNoSuchKey, r.URL)
@@ -353,6 +541,19 @@ func passThroughResponse(proxyResponse *http.Response, w http.ResponseWriter) {
 	for k, v := range proxyResponse.Header {
 		w.Header()[k] = v
 	}
+	if versionId := proxyResponse.Header.Get(xhttp.SeaweedVersionId); versionId != "" {
+		w.Header().Del(xhttp.SeaweedVersionId)
+		setVersionId(w, versionId)
+	}
+	w.Header().Del(xhttp.SeaweedAcl)
+	for _, headers := range []map[string]string{objectLockHeaders, sseHeaders} {
+		for seaweedHeader, amzHeader := range headers {
+			if value := proxyResponse.Header.Get(seaweedHeader); value != "" {
+				w.Header().Del(seaweedHeader)
+				w.Header().Set(amzHeader, value)
+			}
+		}
+	}
 	if proxyResponse.Header.Get("Content-Range") != "" && proxyResponse.StatusCode == 200 {
 		w.WriteHeader(http.StatusPartialContent)
 	} else {
@@ -366,11 +567,32 @@ func (s3a *S3ApiServer) putToFiler(r *http.Request, uploadUrl string, dataReader
 	hash := md5.New()
 	var body = io.TeeReader(dataReader, hash)
 
+	if _, code = s3a.writeToFiler(r, uploadUrl, body, ""); code != s3err.ErrNone {
+		return "", code
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), s3err.ErrNone
+}
+
+// copyToFiler writes the object by copying the copy source, or its x-amz-copy-source-range, in the filer,
+// so the data is not read through the s3 gateway
+func (s3a *S3ApiServer) copyToFiler(r *http.Request, uploadUrl string, srcPath util.FullPath) (etag string, code s3err.ErrorCode) {
+
+	ret, code := s3a.writeToFiler(r, uploadUrl, nil, srcPath)
+	if code != s3err.ErrNone {
+		return "", code
+	}
+
+	return ret.ETag, s3err.ErrNone
+}
+
+func (s3a *S3ApiServer) writeToFiler(r *http.Request, uploadUrl string, body io.Reader, srcPath util.FullPath) (ret *weed_server.FilerPostResult, code s3err.ErrorCode) {
+
 	proxyReq, err := http.NewRequest("PUT", uploadUrl, body)
 
 	if err != nil {
 		glog.Errorf("NewRequest %s: %v", uploadUrl, err)
-		return "", s3err.ErrInternalError
+		return nil, s3err.ErrInternalError
 	}
 
 	proxyReq.Header.Set("Host", s3a.option.Filer)
@@ -382,33 +604,43 @@ func (s3a *S3ApiServer) putToFiler(r *http.Request, uploadUrl string, dataReader
 		}
 	}
 
+	// the copy source is only set by the s3 gateway, after checking the access to it
+	proxyReq.Header.Del(xhttp.AmzCopySourcePath)
+	if srcPath != "" {
+		proxyReq.Header.Set(xhttp.AmzCopySourcePath, string(srcPath))
+	}
+
+	// only the bucket replication of the source marks the replicas, with the admin credentials of this gateway
+	proxyReq.Header.Del(xhttp.AmzReplicationStatus)
+	if s3a.canMarkReplica(r) {
+		proxyReq.Header.Set(xhttp.AmzReplicationStatus, r.Header.Get(xhttp.AmzReplicationStatus))
+	}
+
 	resp, postErr := client.Do(proxyReq)
 
 	if postErr != nil {
 		glog.Errorf("post to filer: %v", postErr)
-		return "", s3err.ErrInternalError
+		return nil, s3err.ErrInternalError
 	}
 	defer resp.Body.Close()
 
-	etag = fmt.Sprintf("%x", hash.Sum(nil))
-
 	resp_body, ra_err := ioutil.ReadAll(resp.Body)
 	if ra_err != nil {
 		glog.Errorf("upload to filer response read %d: %v", resp.StatusCode, ra_err)
-		return etag, s3err.ErrInternalError
+		return nil, s3err.ErrInternalError
 	}
-	var ret weed_server.FilerPostResult
-	unmarshal_err := json.Unmarshal(resp_body, &ret)
+	ret = &weed_server.FilerPostResult{}
+	unmarshal_err := json.Unmarshal(resp_body, ret)
 	if unmarshal_err != nil {
 		glog.Errorf("failing to read upload to %s : %v", uploadUrl, string(resp_body))
-		return "", s3err.ErrInternalError
+		return nil, s3err.ErrInternalError
 	}
 	if ret.Error != "" {
 		glog.Errorf("upload to filer error: %v", ret.Error)
-		return "", filerErrorToS3Error(ret.Error)
+		return nil, filerErrorToS3Error(ret.Error)
 	}
 
-	return etag, s3err.ErrNone
+	return ret, s3err.ErrNone
 }
 
 func setEtag(w http.ResponseWriter, etag string) {
@@ -421,6 +653,21 @@ func setEtag(w http.ResponseWriter, etag string) {
 	}
 }
 
+func setVersionId(w http.ResponseWriter, versionId string) {
+	if versionId != "" {
+		w.Header().Set(xhttp.AmzVersionId, versionId)
+	}
+}
+
+// setObjectVersionHeader passes the version id to the filer, and drops any version attributes sent by the client
+func setObjectVersionHeader(r *http.Request, versionId string) {
+	r.Header.Del(xhttp.SeaweedVersionId)
+	r.Header.Del(xhttp.SeaweedDeleteMarker)
+	if versionId != "" {
+		r.Header.Set(xhttp.SeaweedVersionId, versionId)
+	}
+}
+
 func getBucketAndObject(r *http.Request) (bucket, object string) {
 	vars := mux.Vars(r)
 	bucket = vars["bucket"]
@@ -432,9 +679,65 @@ func getBucketAndObject(r *http.Request) (bucket, object string) {
 	return
 }
 
+func isCreateOnly(r *http.Request) bool {
+	return r.Header.Get("If-None-Match") == "*"
+}
+
+// checkCreateOnly fails the conditional write "If-None-Match: *" if the object exists.
+// The filer checks it again atomically, so the current object is not moved to the versions for the write.
+func (s3a *S3ApiServer) checkCreateOnly(r *http.Request, bucket, object string) s3err.ErrorCode {
+	ifNoneMatch := r.Header.Get("If-None-Match")
+	if ifNoneMatch == "" {
+		return s3err.ErrNone
+	}
+	if ifNoneMatch != "*" {
+		return s3err.ErrNotImplemented
+	}
+	dir, name := util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object)).DirAndName()
+	entry, err := s3a.getEntry(dir, name)
+	if err != nil {
+		glog.Errorf("check %s%s exists: %v", bucket, object, err)
+		return s3err.ErrInternalError
+	}
+	if entry != nil {
+		return s3err.ErrPreconditionFailed
+	}
+	return s3err.ErrNone
+}
+
 func filerErrorToS3Error(errString string) s3err.ErrorCode {
 	if strings.HasPrefix(errString, "existing ") && strings.HasSuffix(errString, "is a directory") {
 		return s3err.ErrExistingObjectIsDirectory
 	}
+	if strings.Contains(errString, filer.ErrObjectLocked.Error()) {
+		return s3err.ErrObjectLocked
+	}
+	if strings.Contains(errString, filer.ErrQuotaExceeded.Error()) {
+		return s3err.ErrQuotaExceeded
+	}
+	if strings.Contains(errString, filer.ErrSnapshotReadOnly.Error()) {
+		return s3err.ErrAccessDenied
+	}
+	if strings.HasPrefix(errString, filer.ErrEntryExists.Error()) {
+		return s3err.ErrPreconditionFailed
+	}
+	if strings.Contains(errString, weed_server.ErrChecksumMismatch.Error()) {
+		return s3err.ErrChecksumMismatch
+	}
+	if strings.Contains(errString, weed_server.ErrChecksumInvalid.Error()) {
+		return s3err.ErrInvalidChecksum
+	}
+	if strings.Contains(errString, weed_server.ErrCopySourceNotFound.Error()) {
+		return s3err.ErrInvalidCopySource
+	}
+	if strings.Contains(errString, weed_server.ErrCopySourcePrecondition.Error()) {
+		return s3err.ErrPreconditionFailed
+	}
+	if strings.Contains(errString, weed_server.ErrCopySourceRange.Error()) {
+		return s3err.ErrInvalidRange
+	}
+	if errCode := sseErrorToS3Error(errString); errCode != s3err.ErrNone {
+		return errCode
+	}
 	return s3err.ErrInternalError
 }
@@ -0,0 +1,323 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+const objectLockEnabled = "Enabled"
+
+type ObjectLockConfiguration struct {
+	XMLName           xml.Name        `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ObjectLockConfiguration"`
+	ObjectLockEnabled string          `xml:"ObjectLockEnabled,omitempty"`
+	Rule              *ObjectLockRule `xml:"Rule,omitempty"`
+}
+
+type ObjectLockRule struct {
+	DefaultRetention *DefaultRetention `xml:"DefaultRetention,omitempty"`
+}
+
+type DefaultRetention struct {
+	Mode  string `xml:"Mode,omitempty"`
+	Days  int    `xml:"Days,omitempty"`
+	Years int    `xml:"Years,omitempty"`
+}
+
+type ObjectRetention struct {
+	XMLName         xml.Name   `xml:"http://s3.amazonaws.com/doc/2006-03-01/ Retention"`
+	Mode            string     `xml:"Mode,omitempty"`
+	RetainUntilDate *time.Time `xml:"RetainUntilDate,omitempty"`
+}
+
+type ObjectLegalHold struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LegalHold"`
+	Status  string   `xml:"Status,omitempty"`
+}
+
+func (c *ObjectLockConfiguration) validate() error {
+	if c.ObjectLockEnabled != objectLockEnabled {
+		return fmt.Errorf("ObjectLockEnabled must be %s", objectLockEnabled)
+	}
+	if c.Rule == nil {
+		return nil
+	}
+	retention := c.Rule.DefaultRetention
+	if retention == nil {
+		return fmt.Errorf("Rule without DefaultRetention")
+	}
+	if retention.Mode != filer.ObjectLockGovernance && retention.Mode != filer.ObjectLockCompliance {
+		return fmt.Errorf("unknown retention mode %s", retention.Mode)
+	}
+	if (retention.Days > 0) == (retention.Years > 0) || retention.Days < 0 || retention.Years < 0 {
+		return fmt.Errorf("DefaultRetention needs either positive Days or Years")
+	}
+	return nil
+}
+
+// defaultRetention returns the retention of a new object, or an empty mode if there is no default retention
+func (c *ObjectLockConfiguration) defaultRetention(now time.Time) (mode string, retainUntil time.Time) {
+	if c.Rule == nil || c.Rule.DefaultRetention == nil {
+		return "", time.Time{}
+	}
+	retention := c.Rule.DefaultRetention
+	return retention.Mode, now.AddDate(retention.Years, 0, retention.Days)
+}
+
+// GetObjectLockConfigurationHandler Get object lock configuration
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectLockConfiguration.html
+func (s3a *S3ApiServer) GetObjectLockConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	config, err := s3a.getBucketObjectLock(bucket)
+	if err != nil {
+		glog.Errorf("GetObjectLockConfigurationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	if config == nil {
+		writeErrorResponse(w, s3err.ErrObjectLockConfigurationNotFound, r.URL)
+		return
+	}
+
+	writeSuccessResponseXML(w, encodeResponse(config))
+
+}
+
+// PutObjectLockConfigurationHandler Put object lock configuration
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLockConfiguration.html
+func (s3a *S3ApiServer) PutObjectLockConfigurationHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, _ := getBucketAndObject(r)
+
+	if err := s3a.checkBucket(r, bucket); err != s3err.ErrNone {
+		writeErrorResponse(w, err, r.URL)
+		return
+	}
+
+	input, err := ioutil.ReadAll(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		glog.Errorf("PutObjectLockConfigurationHandler read input %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	config := &ObjectLockConfiguration{}
+	if err = xml.Unmarshal(input, config); err != nil {
+		glog.V(1).Infof("PutObjectLockConfigurationHandler Unmarshal %s: %v", r.URL, err)
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+	if err = config.validate(); err != nil {
+		glog.V(1).Infof("PutObjectLockConfigurationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+
+	// object lock works on object versions
+	versioning, err := s3a.getBucketVersioning(bucket)
+	if err != nil {
+		glog.Errorf("PutObjectLockConfigurationHandler versioning %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	if versioning != s3_constants.VersioningEnabled {
+		writeErrorResponse(w, s3err.ErrInvalidBucketState, r.URL)
+		return
+	}
+
+	data, _ := xml.Marshal(config)
+	if err = s3a.setBucketExtended(bucket, filer.BucketObjectLockKey, data); err != nil {
+		glog.Errorf("PutObjectLockConfigurationHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+
+}
+
+// GetObjectRetentionHandler Get object retention
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectRetention.html
+func (s3a *S3ApiServer) GetObjectRetentionHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, object := getBucketAndObject(r)
+
+	_, entry, code := s3a.getLockedObject(bucket, object, r.URL.Query().Get("versionId"))
+	if code != s3err.ErrNone {
+		writeErrorResponse(w, code, r.URL)
+		return
+	}
+
+	mode, retainUntil := filer.ObjectRetention(entry.Extended)
+	if mode == "" {
+		writeErrorResponse(w, s3err.ErrNoSuchObjectLockConfiguration, r.URL)
+		return
+	}
+
+	writeSuccessResponseXML(w, encodeResponse(&ObjectRetention{
+		Mode:            mode,
+		RetainUntilDate: &retainUntil,
+	}))
+
+}
+
+// PutObjectRetentionHandler Put object retention
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectRetention.html
+func (s3a *S3ApiServer) PutObjectRetentionHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, object := getBucketAndObject(r)
+
+	fullPath, entry, code := s3a.getLockedObject(bucket, object, r.URL.Query().Get("versionId"))
+	if code != s3err.ErrNone {
+		writeErrorResponse(w, code, r.URL)
+		return
+	}
+
+	retention := &ObjectRetention{}
+	if code = readObjectLockInput(r, retention); code != s3err.ErrNone {
+		writeErrorResponse(w, code, r.URL)
+		return
+	}
+	if retention.RetainUntilDate == nil || !isValidRetention(retention.Mode, *retention.RetainUntilDate) {
+		writeErrorResponse(w, s3err.ErrInvalidRetentionPeriod, r.URL)
+		return
+	}
+
+	entry.Extended[filer.ObjectLockModeKey] = []byte(retention.Mode)
+	entry.Extended[filer.ObjectLockRetainUntilDateKey] = []byte(retention.RetainUntilDate.UTC().Format(time.RFC3339))
+
+	if err := s3a.updateObjectLock(fullPath, entry, s3a.canBypassGovernanceRetention(r), false); err != nil {
+		glog.V(1).Infof("PutObjectRetentionHandler %s: %v", fullPath, err)
+		if isObjectLockedError(err) {
+			writeErrorResponse(w, s3err.ErrObjectLocked, r.URL)
+			return
+		}
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+
+}
+
+// GetObjectLegalHoldHandler Get object legal hold
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_GetObjectLegalHold.html
+func (s3a *S3ApiServer) GetObjectLegalHoldHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, object := getBucketAndObject(r)
+
+	_, entry, code := s3a.getLockedObject(bucket, object, r.URL.Query().Get("versionId"))
+	if code != s3err.ErrNone {
+		writeErrorResponse(w, code, r.URL)
+		return
+	}
+
+	status := string(entry.Extended[filer.ObjectLockLegalHoldKey])
+	if status == "" {
+		writeErrorResponse(w, s3err.ErrNoSuchObjectLockConfiguration, r.URL)
+		return
+	}
+
+	writeSuccessResponseXML(w, encodeResponse(&ObjectLegalHold{
+		Status: status,
+	}))
+
+}
+
+// PutObjectLegalHoldHandler Put object legal hold
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutObjectLegalHold.html
+func (s3a *S3ApiServer) PutObjectLegalHoldHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, object := getBucketAndObject(r)
+
+	fullPath, entry, code := s3a.getLockedObject(bucket, object, r.URL.Query().Get("versionId"))
+	if code != s3err.ErrNone {
+		writeErrorResponse(w, code, r.URL)
+		return
+	}
+
+	legalHold := &ObjectLegalHold{}
+	if code = readObjectLockInput(r, legalHold); code != s3err.ErrNone {
+		writeErrorResponse(w, code, r.URL)
+		return
+	}
+	if legalHold.Status != filer.LegalHoldOn && legalHold.Status != filer.LegalHoldOff {
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+
+	entry.Extended[filer.ObjectLockLegalHoldKey] = []byte(legalHold.Status)
+
+	if err := s3a.updateObjectLock(fullPath, entry, false, true); err != nil {
+		glog.Errorf("PutObjectLegalHoldHandler %s: %v", fullPath, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+
+	writeSuccessResponseEmpty(w)
+
+}
+
+// getLockedObject looks up the object, or one version of it, on a bucket with object lock enabled
+func (s3a *S3ApiServer) getLockedObject(bucket, object, versionId string) (fullPath util.FullPath, entry *filer_pb.Entry, code s3err.ErrorCode) {
+
+	config, err := s3a.getBucketObjectLock(bucket)
+	if err != nil {
+		glog.Errorf("object lock configuration of %s: %v", bucket, err)
+		return "", nil, s3err.ErrInternalError
+	}
+	if config == nil {
+		return "", nil, s3err.ErrInvalidRequest
+	}
+
+	if versionId != "" {
+		fullPath, entry, code = s3a.getObjectVersion(bucket, object, versionId)
+	} else {
+		fullPath = util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object))
+		entry, err = s3a.getEntry(fullPath.DirAndName())
+		if err != nil && err != filer_pb.ErrNotFound {
+			return "", nil, s3err.ErrInternalError
+		}
+		code = s3err.ErrNone
+		if entry == nil || entry.IsDirectory {
+			code = s3err.ErrNoSuchKey
+		}
+	}
+	if code != s3err.ErrNone {
+		return "", nil, code
+	}
+
+	if entry.Extended == nil {
+		entry.Extended = make(map[string][]byte)
+	}
+	return fullPath, entry, s3err.ErrNone
+}
+
+func readObjectLockInput(r *http.Request, v interface{}) s3err.ErrorCode {
+	input, err := ioutil.ReadAll(io.LimitReader(r.Body, r.ContentLength))
+	if err != nil {
+		glog.Errorf("read object lock input %s: %v", r.URL, err)
+		return s3err.ErrInternalError
+	}
+	if err = xml.Unmarshal(input, v); err != nil {
+		glog.V(1).Infof("unmarshal object lock input %s: %v", r.URL, err)
+		return s3err.ErrMalformedXML
+	}
+	return s3err.ErrNone
+}
@@ -0,0 +1,48 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectLockConfiguration(t *testing.T) {
+
+	input := `<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <ObjectLockEnabled>Enabled</ObjectLockEnabled>
+  <Rule>
+    <DefaultRetention>
+      <Mode>GOVERNANCE</Mode>
+      <Days>30</Days>
+    </DefaultRetention>
+  </Rule>
+</ObjectLockConfiguration>`
+
+	config := &ObjectLockConfiguration{}
+	assert.Nil(t, xml.Unmarshal([]byte(input), config))
+	assert.Nil(t, config.validate())
+
+	now := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+	mode, retainUntil := config.defaultRetention(now)
+	assert.Equal(t, "GOVERNANCE", mode)
+	assert.Equal(t, time.Date(2021, 3, 31, 0, 0, 0, 0, time.UTC), retainUntil)
+
+	config.Rule.DefaultRetention.Years = 1
+	assert.NotNil(t, config.validate())
+
+	config.Rule.DefaultRetention = &DefaultRetention{Mode: "COMPLIANCE", Years: 7}
+	assert.Nil(t, config.validate())
+	_, retainUntil = config.defaultRetention(now)
+	assert.Equal(t, time.Date(2028, 3, 1, 0, 0, 0, 0, time.UTC), retainUntil)
+
+	config.Rule.DefaultRetention.Mode = "LEGAL"
+	assert.NotNil(t, config.validate())
+
+	config = &ObjectLockConfiguration{ObjectLockEnabled: "Enabled"}
+	assert.Nil(t, config.validate())
+	mode, _ = config.defaultRetention(now)
+	assert.Equal(t, "", mode)
+
+}
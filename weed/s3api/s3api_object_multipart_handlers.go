@@ -2,7 +2,9 @@ package s3api
 
 import (
 	"fmt"
+	"github.com/chrislusf/seaweedfs/weed/filer"
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
 	"net/http"
 	"net/url"
@@ -24,10 +26,36 @@ const (
 func (s3a *S3ApiServer) NewMultipartUploadHandler(w http.ResponseWriter, r *http.Request) {
 	bucket, object := getBucketAndObject(r)
 
-	response, errCode := s3a.createMultipartUpload(&s3.CreateMultipartUploadInput{
+	lockAttributes, errCode := s3a.objectLockAttributes(r, bucket)
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	if errCode := validateSseHeaders(r.Header); errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	input := &s3.CreateMultipartUploadInput{
 		Bucket: aws.String(bucket),
 		Key:    objectKey(aws.String(object)),
-	})
+	}
+	if mode, retainUntil := filer.ObjectRetention(lockAttributes); mode != "" {
+		input.ObjectLockMode = aws.String(mode)
+		input.ObjectLockRetainUntilDate = aws.Time(retainUntil)
+	}
+	if legalHold, found := lockAttributes[filer.ObjectLockLegalHoldKey]; found {
+		input.ObjectLockLegalHoldStatus = aws.String(string(legalHold))
+	}
+	if algorithm := r.Header.Get(xhttp.AmzServerSideEncryptionCustomerAlgorithm); algorithm != "" {
+		input.SSECustomerAlgorithm = aws.String(algorithm)
+		input.SSECustomerKeyMD5 = aws.String(r.Header.Get(xhttp.AmzServerSideEncryptionCustomerKeyMD5))
+	} else if sse := r.Header.Get(xhttp.AmzServerSideEncryption); sse != "" {
+		input.ServerSideEncryption = aws.String(sse)
+	}
+
+	response, errCode := s3a.createMultipartUpload(input)
 
 	glog.V(2).Info("NewMultipartUploadHandler", string(encodeResponse(response)), errCode)
 
@@ -36,6 +64,8 @@ func (s3a *S3ApiServer) NewMultipartUploadHandler(w http.ResponseWriter, r *http
 		return
 	}
 
+	response.Bucket = aws.String(requestBucket(r, bucket))
+	setSseResponseHeaders(w, r.Header)
 	writeSuccessResponseXML(w, encodeResponse(response))
 
 }
@@ -60,6 +90,11 @@ func (s3a *S3ApiServer) CompleteMultipartUploadHandler(w http.ResponseWriter, r
 		return
 	}
 
+	response.Bucket = aws.String(requestBucket(r, bucket))
+	if response.VersionId != nil {
+		setVersionId(w, *response.VersionId)
+	}
+
 	writeSuccessResponseXML(w, encodeResponse(response))
 
 }
@@ -124,6 +159,7 @@ func (s3a *S3ApiServer) ListMultipartUploadsHandler(w http.ResponseWriter, r *ht
 
 	// TODO handle encodingType
 
+	response.Bucket = aws.String(requestBucket(r, bucket))
 	writeSuccessResponseXML(w, encodeResponse(response))
 }
 
@@ -156,6 +192,8 @@ func (s3a *S3ApiServer) ListObjectPartsHandler(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	response.Bucket = aws.String(requestBucket(r, bucket))
+
 	writeSuccessResponseXML(w, encodeResponse(response))
 
 }
@@ -165,12 +203,21 @@ func (s3a *S3ApiServer) PutObjectPartHandler(w http.ResponseWriter, r *http.Requ
 	bucket, _ := getBucketAndObject(r)
 
 	uploadID := r.URL.Query().Get("uploadId")
-	exists, err := s3a.exists(s3a.genUploadsFolder(bucket), uploadID, true)
-	if !exists {
+	uploadEntry, err := s3a.getEntry(s3a.genUploadsFolder(bucket), uploadID)
+	if err != nil || uploadEntry == nil || !uploadEntry.IsDirectory {
 		writeErrorResponse(w, s3err.ErrNoSuchUpload, r.URL)
 		return
 	}
 
+	if errCode := validateSseHeaders(r.Header); errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+	if errCode := setUploadPartSseHeaders(r, uploadEntry.Extended); errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
 	partIDString := r.URL.Query().Get("partNumber")
 	partID, err := strconv.Atoi(partIDString)
 	if err != nil {
@@ -201,6 +248,11 @@ func (s3a *S3ApiServer) PutObjectPartHandler(w http.ResponseWriter, r *http.Requ
 	}
 	defer dataReader.Close()
 
+	if errCode := s3a.checkIdentityQuota(r, requestContentLength(r)); errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
 	uploadUrl := fmt.Sprintf("http://%s%s/%s/%04d.part?collection=%s",
 		s3a.option.Filer, s3a.genUploadsFolder(bucket), uploadID, partID, bucket)
 
@@ -212,6 +264,7 @@ func (s3a *S3ApiServer) PutObjectPartHandler(w http.ResponseWriter, r *http.Requ
 	}
 
 	setEtag(w, etag)
+	setSseResponseHeaders(w, r.Header)
 
 	writeSuccessResponseEmpty(w)
 
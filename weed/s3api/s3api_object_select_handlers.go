@@ -0,0 +1,250 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/query"
+	"github.com/chrislusf/seaweedfs/weed/query/csv"
+	query_json "github.com/chrislusf/seaweedfs/weed/query/json"
+	"github.com/chrislusf/seaweedfs/weed/query/sql"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	weed_server "github.com/chrislusf/seaweedfs/weed/server"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+type SelectObjectContentRequest struct {
+	XMLName             xml.Name                  `xml:"http://s3.amazonaws.com/doc/2006-03-01/ SelectObjectContentRequest"`
+	Expression          string                    `xml:"Expression"`
+	ExpressionType      string                    `xml:"ExpressionType"`
+	RequestProgress     *SelectRequestProgress    `xml:"RequestProgress,omitempty"`
+	InputSerialization  SelectInputSerialization  `xml:"InputSerialization"`
+	OutputSerialization SelectOutputSerialization `xml:"OutputSerialization"`
+	ScanRange           *SelectScanRange          `xml:"ScanRange,omitempty"`
+}
+
+type SelectRequestProgress struct {
+	Enabled bool `xml:"Enabled"`
+}
+
+type SelectInputSerialization struct {
+	CompressionType string          `xml:"CompressionType,omitempty"`
+	CSV             *SelectCSVInput `xml:"CSV,omitempty"`
+	JSON            *SelectJSON     `xml:"JSON,omitempty"`
+	Parquet         *struct{}       `xml:"Parquet,omitempty"`
+}
+
+type SelectCSVInput struct {
+	FileHeaderInfo             string `xml:"FileHeaderInfo,omitempty"`
+	Comments                   string `xml:"Comments,omitempty"`
+	QuoteEscapeCharacter       string `xml:"QuoteEscapeCharacter,omitempty"`
+	RecordDelimiter            string `xml:"RecordDelimiter,omitempty"`
+	FieldDelimiter             string `xml:"FieldDelimiter,omitempty"`
+	QuoteCharacter             string `xml:"QuoteCharacter,omitempty"`
+	AllowQuotedRecordDelimiter bool   `xml:"AllowQuotedRecordDelimiter,omitempty"`
+}
+
+type SelectJSON struct {
+	Type            string `xml:"Type,omitempty"`
+	RecordDelimiter string `xml:"RecordDelimiter,omitempty"`
+}
+
+type SelectOutputSerialization struct {
+	CSV  *SelectCSVOutput `xml:"CSV,omitempty"`
+	JSON *SelectJSON      `xml:"JSON,omitempty"`
+}
+
+type SelectCSVOutput struct {
+	QuoteFields          string `xml:"QuoteFields,omitempty"`
+	QuoteEscapeCharacter string `xml:"QuoteEscapeCharacter,omitempty"`
+	RecordDelimiter      string `xml:"RecordDelimiter,omitempty"`
+	FieldDelimiter       string `xml:"FieldDelimiter,omitempty"`
+	QuoteCharacter       string `xml:"QuoteCharacter,omitempty"`
+}
+
+type SelectScanRange struct {
+	Start *int64 `xml:"Start,omitempty"`
+	End   *int64 `xml:"End,omitempty"`
+}
+
+// toQueryInput converts the input serialization to the one of the volume server query
+func (input SelectInputSerialization) toQueryInput() *volume_server_pb.QueryRequest_InputSerialization {
+	serialization := &volume_server_pb.QueryRequest_InputSerialization{
+		CompressionType: input.CompressionType,
+	}
+	if input.CSV != nil {
+		serialization.CsvInput = &volume_server_pb.QueryRequest_InputSerialization_CSVInput{
+			FileHeaderInfo:             input.CSV.FileHeaderInfo,
+			RecordDelimiter:            input.CSV.RecordDelimiter,
+			FieldDelimiter:             input.CSV.FieldDelimiter,
+			QuoteCharactoer:            input.CSV.QuoteCharacter,
+			QuoteEscapeCharacter:       input.CSV.QuoteEscapeCharacter,
+			Comments:                   input.CSV.Comments,
+			AllowQuotedRecordDelimiter: input.CSV.AllowQuotedRecordDelimiter,
+		}
+	}
+	if input.JSON != nil {
+		serialization.JsonInput = &volume_server_pb.QueryRequest_InputSerialization_JSONInput{
+			Type: input.JSON.Type,
+		}
+	}
+	return serialization
+}
+
+// SelectObjectContentHandler filters the content of a CSV or JSON object by a SQL expression
+// https://docs.aws.amazon.com/AmazonS3/latest/API/API_SelectObjectContent.html
+func (s3a *S3ApiServer) SelectObjectContentHandler(w http.ResponseWriter, r *http.Request) {
+
+	bucket, object := getBucketAndObject(r)
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, 256*1024))
+	if err != nil {
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+	request := &SelectObjectContentRequest{}
+	if err = xml.Unmarshal(body, request); err != nil {
+		writeErrorResponse(w, s3err.ErrMalformedXML, r.URL)
+		return
+	}
+
+	if !strings.EqualFold(request.ExpressionType, "SQL") {
+		writeErrorResponse(w, s3err.ErrInvalidExpressionType, r.URL)
+		return
+	}
+	if request.InputSerialization.Parquet != nil || request.ScanRange != nil {
+		writeErrorResponse(w, s3err.ErrNotImplemented, r.URL)
+		return
+	}
+	switch strings.ToUpper(request.InputSerialization.CompressionType) {
+	case "", "NONE", "GZIP", "BZIP2":
+	default:
+		writeErrorResponse(w, s3err.ErrInvalidCompressionFormat, r.URL)
+		return
+	}
+	if errCode := validateSseHeaders(r.Header); errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	statement, err := sql.Parse(request.Expression)
+	if err != nil {
+		glog.V(1).Infof("select %s/%s: %v", bucket, object, err)
+		writeErrorResponse(w, s3err.ErrInvalidSelectQuery, r.URL)
+		return
+	}
+	serialization := request.InputSerialization.toQueryInput()
+	input, err := query.NewInput(serialization)
+	if err != nil {
+		glog.V(1).Infof("select %s/%s: %v", bucket, object, err)
+		writeErrorResponse(w, s3err.ErrInvalidInputSerialization, r.URL)
+		return
+	}
+
+	entry, destUrl, errCode := s3a.getSelectedObject(r, bucket, object)
+	if errCode != s3err.ErrNone {
+		writeErrorResponse(w, errCode, r.URL)
+		return
+	}
+
+	events := newSelectEventWriter(w)
+	q := newSelectQuery(statement, input, newSelectOutput(request.OutputSerialization), events)
+
+	compression := strings.ToUpper(request.InputSerialization.CompressionType)
+	if input.Splittable() && (compression == "" || compression == "NONE") && !weed_server.IsServerSideEncrypted(entry.Extended) {
+		err = s3a.selectFromChunks(r.Context(), q, serialization, entry)
+	} else {
+		err = s3a.selectFromStream(r, q, compression, destUrl)
+	}
+	if err == nil || err == errSelectLimitReached {
+		err = q.finish()
+	}
+	if err != nil {
+		glog.Errorf("select %s/%s: %v", bucket, object, err)
+		if selectErr, ok := err.(*selectError); ok && !events.started {
+			writeErrorResponse(w, selectErr.code, r.URL)
+			return
+		}
+		apiErr := s3err.GetAPIError(s3err.ErrInternalError)
+		events.writeError(apiErr.Code, err.Error())
+		return
+	}
+
+	if request.RequestProgress != nil && request.RequestProgress.Enabled {
+		events.writeProgress(q.bytesScanned, q.bytesScanned)
+	}
+	events.writeStats(q.bytesScanned, q.bytesScanned)
+	events.writeEnd()
+
+}
+
+// getSelectedObject locates the object entry, and the filer url to read the object if it can not be filtered by chunks
+func (s3a *S3ApiServer) getSelectedObject(r *http.Request, bucket, object string) (entry *filer_pb.Entry, destUrl string, errCode s3err.ErrorCode) {
+
+	versionId := r.URL.Query().Get("versionId")
+	if versionId != "" {
+		var fullPath util.FullPath
+		fullPath, entry, errCode = s3a.getObjectVersion(bucket, object, versionId)
+		if errCode != s3err.ErrNone {
+			return nil, "", errCode
+		}
+		return entry, fmt.Sprintf("http://%s%s", s3a.option.Filer, urlPathEscape(string(fullPath))), s3err.ErrNone
+	}
+
+	fullPath := util.FullPath(fmt.Sprintf("%s/%s%s", s3a.option.BucketsPath, bucket, object))
+	dir, name := fullPath.DirAndName()
+	entry, err := s3a.getEntry(dir, name)
+	if err != nil {
+		glog.Errorf("select %s: %v", fullPath, err)
+		return nil, "", s3err.ErrInternalError
+	}
+	if entry == nil || entry.IsDirectory || isDeleteMarker(entry) {
+		return nil, "", s3err.ErrNoSuchKey
+	}
+	return entry, fmt.Sprintf("http://%s%s", s3a.option.Filer, urlPathEscape(string(fullPath))), s3err.ErrNone
+}
+
+// selectOutput formats the selected values as CSV or JSON
+type selectOutput struct {
+	csv             *csv.Options
+	recordDelimiter string
+}
+
+func newSelectOutput(output SelectOutputSerialization) *selectOutput {
+	o := &selectOutput{recordDelimiter: "\n"}
+	if output.JSON != nil {
+		if output.JSON.RecordDelimiter != "" {
+			o.recordDelimiter = output.JSON.RecordDelimiter
+		}
+		return o
+	}
+	csvOutput := output.CSV
+	if csvOutput == nil {
+		csvOutput = &SelectCSVOutput{}
+	}
+	o.csv = &csv.Options{
+		FieldDelimiter:       csvOutput.FieldDelimiter,
+		QuoteCharacter:       csvOutput.QuoteCharacter,
+		QuoteEscapeCharacter: csvOutput.QuoteEscapeCharacter,
+		QuoteFields:          strings.ToUpper(csvOutput.QuoteFields),
+	}
+	if csvOutput.RecordDelimiter != "" {
+		o.recordDelimiter = csvOutput.RecordDelimiter
+	}
+	return o
+}
+
+func (o *selectOutput) appendRecord(buf []byte, names []string, values []sql.Value) []byte {
+	if o.csv != nil {
+		buf = o.csv.AppendRecord(buf, values)
+	} else {
+		buf = query_json.AppendRecord(buf, names, values)
+	}
+	return append(buf, o.recordDelimiter...)
+}
@@ -0,0 +1,353 @@
+package s3api
+
+import (
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/query"
+	"github.com/chrislusf/seaweedfs/weed/query/sql"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/chrislusf/seaweedfs/weed/wdclient"
+)
+
+// The records of an object are filtered by the WHERE clause on the volume servers holding each chunk.
+// A record can be split across two chunks, so each volume server returns the partial records at the
+// beginning and the end of its chunk, and the gateway joins and filters them.
+// The projections, aggregate functions, and LIMIT are evaluated by the gateway.
+//
+// The objects that can not be split by the record delimiter, i.e., compressed, encrypted with a customer key,
+// JSON documents, or CSV with quoted record delimiters, are read from the filer and filtered by the gateway.
+
+var errSelectLimitReached = errors.New("select limit reached")
+
+// the size of the records sent in one Records event
+const selectRecordsEventSize = 1024 * 1024
+
+// selectError fails the request with an S3 error if no event is written yet
+type selectError struct {
+	code s3err.ErrorCode
+	err  error
+}
+
+func (e *selectError) Error() string {
+	return e.err.Error()
+}
+
+type selectQuery struct {
+	statement   *sql.Statement
+	input       *query.Input
+	output      *selectOutput
+	events      *selectEventWriter
+	aggregation *sql.Aggregation
+	returned    int64
+	buf         []byte
+	// the partial record at the end of the previous chunk
+	pending      []byte
+	bytesScanned int64
+}
+
+func newSelectQuery(statement *sql.Statement, input *query.Input, output *selectOutput, events *selectEventWriter) *selectQuery {
+	q := &selectQuery{
+		statement: statement,
+		input:     input,
+		output:    output,
+		events:    events,
+	}
+	if statement.IsAggregate() {
+		q.aggregation = statement.NewAggregation()
+	}
+	return q
+}
+
+// addRecord evaluates one record without the record delimiter. The filtered records match the WHERE clause already.
+func (q *selectQuery) addRecord(raw []byte, filtered bool) error {
+	if q.input.NeedsHeader() {
+		q.input.ReadHeader(raw)
+		return nil
+	}
+	record := q.input.ParseRecord(raw)
+	if record == nil {
+		return nil
+	}
+	if !filtered && !q.statement.Match(record) {
+		return nil
+	}
+	if q.aggregation != nil {
+		q.aggregation.Add(record)
+		return nil
+	}
+	if q.statement.Limit >= 0 && q.returned >= q.statement.Limit {
+		return errSelectLimitReached
+	}
+	names, values := q.statement.Project(record)
+	q.buf = q.output.appendRecord(q.buf, names, values)
+	q.returned++
+	if len(q.buf) >= selectRecordsEventSize {
+		if err := q.flush(); err != nil {
+			return err
+		}
+	}
+	if q.statement.Limit >= 0 && q.returned >= q.statement.Limit {
+		return errSelectLimitReached
+	}
+	return nil
+}
+
+// addStripe joins the head of the stripe to the partial record of the previous chunk,
+// and keeps the tail to join with the next chunk
+func (q *selectQuery) addStripe(stripe *volume_server_pb.QueriedStripe) error {
+	delimiter := q.input.RecordDelimiter()
+	if len(stripe.Head) > 0 {
+		record := append(q.pending, stripe.Head...)
+		q.pending = nil
+		if err := q.addRecord(record[:len(record)-len(delimiter)], false); err != nil {
+			return err
+		}
+	}
+	for records := stripe.Records; len(records) > 0; {
+		i := bytes.Index(records, delimiter)
+		if i < 0 {
+			return fmt.Errorf("record without delimiter")
+		}
+		if err := q.addRecord(records[:i], true); err != nil {
+			return err
+		}
+		records = records[i+len(delimiter):]
+	}
+	q.pending = append(q.pending, stripe.Tail...)
+	return nil
+}
+
+// finish evaluates the last record, and returns the aggregate functions and the remaining records
+func (q *selectQuery) finish() error {
+	if len(q.pending) > 0 {
+		pending := q.pending
+		q.pending = nil
+		if err := q.addRecord(pending, false); err != nil && err != errSelectLimitReached {
+			return err
+		}
+	}
+	if q.aggregation != nil {
+		q.buf = q.output.appendRecord(q.buf, q.statement.ColumnNames(), q.aggregation.Result())
+	}
+	return q.flush()
+}
+
+func (q *selectQuery) flush() error {
+	if len(q.buf) == 0 {
+		return nil
+	}
+	err := q.events.writeRecords(q.buf)
+	q.buf = nil
+	return err
+}
+
+// selectFromChunks filters the chunks on the volume servers, or on the gateway if a chunk is encrypted or partially visible
+func (s3a *S3ApiServer) selectFromChunks(ctx context.Context, q *selectQuery, serialization *volume_server_pb.QueryRequest_InputSerialization, entry *filer_pb.Entry) error {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if len(entry.Content) > 0 {
+		q.bytesScanned = int64(len(entry.Content))
+		return q.filterLocally(entry.Content)
+	}
+
+	lookupFn := filer.LookupFn(s3a)
+	chunks, _, err := filer.ResolveChunkManifest(lookupFn, entry.Chunks)
+	if err != nil {
+		return err
+	}
+
+	var where string
+	if q.statement.Where != nil {
+		where = q.statement.Where.String()
+	}
+
+	for _, view := range filer.ViewFromChunks(lookupFn, chunks, 0, math.MaxInt64) {
+		q.bytesScanned += int64(view.Size)
+
+		// the chunks after an unfinished CSV header line need the column names
+		if view.IsFullChunk() && len(view.CipherKey) == 0 && (!q.input.NeedsHeader() || view.LogicOffset == 0) {
+			if serialization.CsvInput != nil {
+				serialization.CsvInput.ColumnNames = q.input.ColumnNames()
+			}
+			request := &volume_server_pb.QueryRequest{
+				FromFileIds:        []string{view.FileId},
+				InputSerialization: serialization,
+				Where:              where,
+				PartialHead:        !q.input.NeedsHeader(),
+			}
+			if err = s3a.queryChunk(ctx, lookupFn, request, q.addStripe); err != nil {
+				return err
+			}
+			continue
+		}
+
+		data, err := readChunkView(lookupFn, view)
+		if err != nil {
+			return err
+		}
+		if err = q.filterLocally(data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// filterLocally filters the data of one chunk on the gateway
+func (q *selectQuery) filterLocally(data []byte) error {
+	partialHead := true
+	if q.input.NeedsHeader() {
+		delimiter := q.input.RecordDelimiter()
+		i := bytes.Index(data, delimiter)
+		if i < 0 {
+			q.pending = append(q.pending, data...)
+			return nil
+		}
+		q.input.ReadHeader(append(q.pending, data[:i]...))
+		q.pending = nil
+		data, partialHead = data[i+len(delimiter):], false
+	}
+	return q.input.FilterRecords(data, partialHead, q.statement.Where, q.addStripe)
+}
+
+// queryChunk runs the query on one of the volume servers holding the chunk
+func (s3a *S3ApiServer) queryChunk(ctx context.Context, lookupFn wdclient.LookupFileIdFunctionType, request *volume_server_pb.QueryRequest, fn func(stripe *volume_server_pb.QueriedStripe) error) error {
+
+	urlStrings, err := lookupFn(request.FromFileIds[0])
+	if err != nil {
+		return err
+	}
+
+	for _, urlString := range urlStrings {
+		u, parseErr := url.Parse(urlString)
+		if parseErr != nil {
+			return parseErr
+		}
+		received := false
+		err = operation.WithVolumeServerClient(u.Host, s3a.option.GrpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			stream, err := client.Query(ctx, request)
+			if err != nil {
+				return err
+			}
+			for {
+				stripe, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				received = true
+				if err = fn(stripe); err != nil {
+					return err
+				}
+			}
+		})
+		if err == nil || received {
+			return err
+		}
+		glog.V(1).Infof("query %s on %s: %v", request.FromFileIds[0], u.Host, err)
+	}
+
+	return err
+}
+
+func readChunkView(lookupFn wdclient.LookupFileIdFunctionType, view *filer.ChunkView) (data []byte, err error) {
+	urlStrings, err := lookupFn(view.FileId)
+	if err != nil {
+		return nil, err
+	}
+	data = make([]byte, view.Size)
+	for _, urlString := range urlStrings {
+		var n int64
+		n, err = util.ReadUrl(urlString, view.CipherKey, view.IsGzipped, view.IsFullChunk(), view.Offset, int(view.Size), data)
+		if err == nil {
+			return data[:n], nil
+		}
+		glog.V(1).Infof("read %s: %v", urlString, err)
+	}
+	return nil, err
+}
+
+// selectFromStream reads the whole object from the filer, which decrypts the object encrypted with a customer key
+func (s3a *S3ApiServer) selectFromStream(r *http.Request, q *selectQuery, compression, destUrl string) error {
+
+	req, err := http.NewRequest("GET", destUrl, nil)
+	if err != nil {
+		return err
+	}
+	for _, header := range []string{
+		xhttp.AmzServerSideEncryptionCustomerAlgorithm,
+		xhttp.AmzServerSideEncryptionCustomerKey,
+		xhttp.AmzServerSideEncryptionCustomerKeyMD5,
+	} {
+		if value := r.Header.Get(header); value != "" {
+			req.Header.Set(header, value)
+		}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer util.CloseResponse(resp)
+	if resp.StatusCode >= 400 {
+		errCode := sseReadStatusToS3Error(resp.StatusCode)
+		if errCode == s3err.ErrNone {
+			errCode = s3err.ErrInternalError
+			if resp.StatusCode == http.StatusNotFound {
+				errCode = s3err.ErrNoSuchKey
+			}
+		}
+		return &selectError{code: errCode, err: fmt.Errorf("read %s: %s", destUrl, resp.Status)}
+	}
+
+	counter := &countingReader{reader: resp.Body}
+	var reader io.Reader = counter
+	switch compression {
+	case "GZIP":
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return &selectError{code: s3err.ErrInvalidCompressionFormat, err: err}
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	case "BZIP2":
+		reader = bzip2.NewReader(reader)
+	}
+
+	err = q.input.ReadRecords(reader, func(raw []byte) error {
+		return q.addRecord(raw, false)
+	})
+	q.bytesScanned = counter.n
+	return err
+}
+
+type countingReader struct {
+	reader io.Reader
+	n      int64
+}
+
+func (c *countingReader) Read(p []byte) (n int, err error) {
+	n, err = c.reader.Read(p)
+	c.n += int64(n)
+	return
+}
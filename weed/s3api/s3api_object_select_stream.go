@@ -0,0 +1,112 @@
+package s3api
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"net/http"
+)
+
+// The SelectObjectContent response is a stream of events in the AWS event stream encoding.
+// Each message has a prelude with the total length, the headers length, and the prelude CRC,
+// followed by the headers, the payload, and the message CRC.
+
+const eventHeaderTypeString = 7
+
+type eventHeader struct {
+	name  string
+	value string
+}
+
+func encodeEventMessage(headers []eventHeader, payload []byte) []byte {
+
+	var headerBytes []byte
+	for _, h := range headers {
+		headerBytes = append(headerBytes, byte(len(h.name)))
+		headerBytes = append(headerBytes, h.name...)
+		headerBytes = append(headerBytes, eventHeaderTypeString)
+		headerBytes = append(headerBytes, byte(len(h.value)>>8), byte(len(h.value)))
+		headerBytes = append(headerBytes, h.value...)
+	}
+
+	totalLength := 4 + 4 + 4 + len(headerBytes) + len(payload) + 4
+	message := make([]byte, 12, totalLength)
+	binary.BigEndian.PutUint32(message[0:4], uint32(totalLength))
+	binary.BigEndian.PutUint32(message[4:8], uint32(len(headerBytes)))
+	binary.BigEndian.PutUint32(message[8:12], crc32.ChecksumIEEE(message[0:8]))
+	message = append(message, headerBytes...)
+	message = append(message, payload...)
+	message = message[:totalLength]
+	binary.BigEndian.PutUint32(message[totalLength-4:], crc32.ChecksumIEEE(message[:totalLength-4]))
+	return message
+}
+
+// selectEventWriter writes the events of one SelectObjectContent response
+type selectEventWriter struct {
+	w             http.ResponseWriter
+	started       bool
+	bytesReturned int64
+}
+
+func newSelectEventWriter(w http.ResponseWriter) *selectEventWriter {
+	return &selectEventWriter{w: w}
+}
+
+func (ew *selectEventWriter) writeMessage(headers []eventHeader, payload []byte) error {
+	if !ew.started {
+		ew.started = true
+		setCommonHeaders(ew.w)
+		ew.w.Header().Set("Content-Type", "application/octet-stream")
+		ew.w.WriteHeader(http.StatusOK)
+	}
+	if _, err := ew.w.Write(encodeEventMessage(headers, payload)); err != nil {
+		return err
+	}
+	if flusher, ok := ew.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return nil
+}
+
+func (ew *selectEventWriter) writeRecords(payload []byte) error {
+	ew.bytesReturned += int64(len(payload))
+	return ew.writeMessage([]eventHeader{
+		{":event-type", "Records"},
+		{":content-type", "application/octet-stream"},
+		{":message-type", "event"},
+	}, payload)
+}
+
+func (ew *selectEventWriter) writeProgress(bytesScanned, bytesProcessed int64) error {
+	return ew.writeMessage([]eventHeader{
+		{":event-type", "Progress"},
+		{":content-type", "text/xml"},
+		{":message-type", "event"},
+	}, []byte(fmt.Sprintf("<Progress><BytesScanned>%d</BytesScanned><BytesProcessed>%d</BytesProcessed><BytesReturned>%d</BytesReturned></Progress>",
+		bytesScanned, bytesProcessed, ew.bytesReturned)))
+}
+
+func (ew *selectEventWriter) writeStats(bytesScanned, bytesProcessed int64) error {
+	return ew.writeMessage([]eventHeader{
+		{":event-type", "Stats"},
+		{":content-type", "text/xml"},
+		{":message-type", "event"},
+	}, []byte(fmt.Sprintf("<Stats><BytesScanned>%d</BytesScanned><BytesProcessed>%d</BytesProcessed><BytesReturned>%d</BytesReturned></Stats>",
+		bytesScanned, bytesProcessed, ew.bytesReturned)))
+}
+
+func (ew *selectEventWriter) writeEnd() error {
+	return ew.writeMessage([]eventHeader{
+		{":event-type", "End"},
+		{":message-type", "event"},
+	}, nil)
+}
+
+// writeError ends the response with an error, which can happen after some records are returned already
+func (ew *selectEventWriter) writeError(code, message string) error {
+	return ew.writeMessage([]eventHeader{
+		{":error-code", code},
+		{":error-message", message},
+		{":message-type", "error"},
+	}, nil)
+}
@@ -0,0 +1,102 @@
+package s3api
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/query"
+	"github.com/chrislusf/seaweedfs/weed/query/sql"
+	"github.com/stretchr/testify/assert"
+)
+
+// decodeEventMessages returns the event types and payloads in an event stream
+func decodeEventMessages(t *testing.T, data []byte) (eventTypes []string, payloads []string) {
+	for len(data) > 0 {
+		totalLength := binary.BigEndian.Uint32(data[0:4])
+		headersLength := binary.BigEndian.Uint32(data[4:8])
+		assert.Equal(t, crc32.ChecksumIEEE(data[0:8]), binary.BigEndian.Uint32(data[8:12]))
+		assert.Equal(t, crc32.ChecksumIEEE(data[:totalLength-4]), binary.BigEndian.Uint32(data[totalLength-4:totalLength]))
+
+		headers := data[12 : 12+headersLength]
+		for len(headers) > 0 {
+			nameLength := int(headers[0])
+			name := string(headers[1 : 1+nameLength])
+			assert.Equal(t, byte(eventHeaderTypeString), headers[1+nameLength])
+			valueLength := int(binary.BigEndian.Uint16(headers[2+nameLength:]))
+			value := string(headers[4+nameLength : 4+nameLength+valueLength])
+			if name == ":event-type" {
+				eventTypes = append(eventTypes, value)
+			}
+			headers = headers[4+nameLength+valueLength:]
+		}
+
+		payloads = append(payloads, string(data[12+headersLength:totalLength-4]))
+		data = data[totalLength:]
+	}
+	return
+}
+
+func newTestSelectQuery(t *testing.T, expression string, serialization *volume_server_pb.QueryRequest_InputSerialization, output SelectOutputSerialization) (*selectQuery, *httptest.ResponseRecorder) {
+	statement, err := sql.Parse(expression)
+	assert.NoError(t, err)
+	input, err := query.NewInput(serialization)
+	assert.NoError(t, err)
+	w := httptest.NewRecorder()
+	return newSelectQuery(statement, input, newSelectOutput(output), newSelectEventWriter(w)), w
+}
+
+func TestSelectAcrossChunks(t *testing.T) {
+	serialization := &volume_server_pb.QueryRequest_InputSerialization{
+		CsvInput: &volume_server_pb.QueryRequest_InputSerialization_CSVInput{FileHeaderInfo: "USE"},
+	}
+	q, w := newTestSelectQuery(t, "SELECT name FROM S3Object WHERE CAST(age AS INT) > 30", serialization, SelectOutputSerialization{
+		JSON: &SelectJSON{},
+	})
+
+	// the header and the records are split across the chunks
+	for _, chunk := range []string{"na", "me,age\njack,3", "1\njill,20\nann,", "50"} {
+		assert.NoError(t, q.filterLocally([]byte(chunk)))
+	}
+	assert.NoError(t, q.finish())
+	q.events.writeEnd()
+
+	eventTypes, payloads := decodeEventMessages(t, w.Body.Bytes())
+	assert.Equal(t, []string{"Records", "End"}, eventTypes)
+	assert.Equal(t, "{\"name\":\"jack\"}\n{\"name\":\"ann\"}\n", payloads[0])
+}
+
+func TestSelectStripesAndLimit(t *testing.T) {
+	serialization := &volume_server_pb.QueryRequest_InputSerialization{
+		JsonInput: &volume_server_pb.QueryRequest_InputSerialization_JSONInput{},
+	}
+	q, w := newTestSelectQuery(t, "SELECT s.a FROM S3Object s WHERE s.a > 1 LIMIT 2", serialization, SelectOutputSerialization{})
+
+	// the stripes as returned by the volume servers
+	stripes := []*volume_server_pb.QueriedStripe{
+		{Records: []byte("{\"a\":2}\n"), Tail: []byte("{\"a\"")},
+		{Head: []byte(":5}\n"), Records: []byte("{\"a\":3}\n")},
+	}
+	assert.NoError(t, q.addStripe(stripes[0]))
+	assert.Equal(t, errSelectLimitReached, q.addStripe(stripes[1]))
+	assert.NoError(t, q.finish())
+
+	_, payloads := decodeEventMessages(t, w.Body.Bytes())
+	assert.Equal(t, "2\n5\n", payloads[0])
+}
+
+func TestSelectAggregation(t *testing.T) {
+	serialization := &volume_server_pb.QueryRequest_InputSerialization{
+		CsvInput: &volume_server_pb.QueryRequest_InputSerialization_CSVInput{},
+	}
+	q, w := newTestSelectQuery(t, "SELECT COUNT(*), SUM(_2), AVG(_2) FROM S3Object WHERE _1 LIKE 'a%'", serialization, SelectOutputSerialization{
+		CSV: &SelectCSVOutput{},
+	})
+	assert.NoError(t, q.filterLocally([]byte("ab,1\nb,2\naa,5\n")))
+	assert.NoError(t, q.finish())
+
+	_, payloads := decodeEventMessages(t, w.Body.Bytes())
+	assert.Equal(t, "2,6,3\n", payloads[0])
+}
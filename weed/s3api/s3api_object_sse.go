@@ -0,0 +1,129 @@
+package s3api
+
+import (
+	"net/http"
+	"strings"
+
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	weed_server "github.com/chrislusf/seaweedfs/weed/server"
+)
+
+// The server side encryption is done by the filer, so the S3 gateway only validates the headers,
+// keeps multipart uploads encrypted the same way for all parts, and maps the filer errors to S3 errors.
+
+const sseAlgorithmAES256 = "AES256"
+
+// the server side encryption headers returned by the filer, and their S3 names
+var sseHeaders = map[string]string{
+	xhttp.SeaweedServerSideEncryption:                  xhttp.AmzServerSideEncryption,
+	xhttp.SeaweedServerSideEncryptionCustomerAlgorithm: xhttp.AmzServerSideEncryptionCustomerAlgorithm,
+	xhttp.SeaweedServerSideEncryptionCustomerKeyMD5:    xhttp.AmzServerSideEncryptionCustomerKeyMD5,
+}
+
+// sseCustomerKeySuffixes are shared by the SSE-C headers of the object and of the copy source
+var sseCustomerKeySuffixes = []string{"algorithm", "key", "key-MD5"}
+
+// validateSseHeaders checks the server side encryption headers of a request, before passing them to the filer
+func validateSseHeaders(header http.Header) s3err.ErrorCode {
+	algorithm := header.Get(xhttp.AmzServerSideEncryptionCustomerAlgorithm)
+	key := header.Get(xhttp.AmzServerSideEncryptionCustomerKey)
+	keyMD5 := header.Get(xhttp.AmzServerSideEncryptionCustomerKeyMD5)
+	if algorithm != "" || key != "" || keyMD5 != "" {
+		if header.Get(xhttp.AmzServerSideEncryption) != "" {
+			return s3err.ErrInvalidRequest
+		}
+		return validateSseCustomerKey(algorithm, key, keyMD5)
+	}
+	switch header.Get(xhttp.AmzServerSideEncryption) {
+	case "", sseAlgorithmAES256:
+		return s3err.ErrNone
+	case "aws:kms":
+		return s3err.ErrNotImplemented
+	}
+	return s3err.ErrInvalidEncryptionAlgorithm
+}
+
+func validateCopySourceSseHeaders(header http.Header) s3err.ErrorCode {
+	var values []string
+	for _, suffix := range sseCustomerKeySuffixes {
+		values = append(values, header.Get(xhttp.AmzCopySourceServerSideEncryptionCustomerPrefix+suffix))
+	}
+	if values[0] == "" && values[1] == "" && values[2] == "" {
+		return s3err.ErrNone
+	}
+	return validateSseCustomerKey(values[0], values[1], values[2])
+}
+
+func validateSseCustomerKey(algorithm, key, keyMD5 string) s3err.ErrorCode {
+	if _, err := weed_server.ParseSseCustomerKey(algorithm, key, keyMD5); err != nil {
+		return sseErrorToS3Error(err.Error())
+	}
+	return s3err.ErrNone
+}
+
+// sseErrorToS3Error maps the server side encryption errors of the filer, or returns ErrNone for other errors
+func sseErrorToS3Error(errString string) s3err.ErrorCode {
+	switch {
+	case strings.Contains(errString, weed_server.ErrSseInvalidAlgorithm.Error()):
+		return s3err.ErrInvalidEncryptionAlgorithm
+	case strings.Contains(errString, weed_server.ErrSseInvalidCustomerKey.Error()):
+		return s3err.ErrInvalidSseCustomerKey
+	case strings.Contains(errString, weed_server.ErrSseCustomerKeyMD5.Error()):
+		return s3err.ErrSseCustomerKeyMD5Mismatch
+	case strings.Contains(errString, weed_server.ErrSseCustomerKeyMissing.Error()):
+		return s3err.ErrSseCustomerKeyRequired
+	case strings.Contains(errString, weed_server.ErrSseCustomerKeyMismatch.Error()):
+		return s3err.ErrSseCustomerKeyMismatch
+	case strings.Contains(errString, weed_server.ErrSseNotConfigured.Error()):
+		return s3err.ErrSseNotConfigured
+	}
+	return s3err.ErrNone
+}
+
+// sseReadStatusToS3Error maps the status of reading an object from the filer, which has no error message for HEAD requests.
+// The SSE-C headers are validated already, so a bad request can only be a missing customer key.
+func sseReadStatusToS3Error(statusCode int) s3err.ErrorCode {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return s3err.ErrSseCustomerKeyRequired
+	case http.StatusForbidden:
+		return s3err.ErrSseCustomerKeyMismatch
+	case http.StatusNotImplemented:
+		return s3err.ErrSseNotConfigured
+	}
+	return s3err.ErrNone
+}
+
+// setSseResponseHeaders returns the server side encryption of a new object
+func setSseResponseHeaders(w http.ResponseWriter, header http.Header) {
+	if algorithm := header.Get(xhttp.AmzServerSideEncryptionCustomerAlgorithm); algorithm != "" {
+		w.Header().Set(xhttp.AmzServerSideEncryptionCustomerAlgorithm, algorithm)
+		w.Header().Set(xhttp.AmzServerSideEncryptionCustomerKeyMD5, header.Get(xhttp.AmzServerSideEncryptionCustomerKeyMD5))
+	} else if sse := header.Get(xhttp.AmzServerSideEncryption); sse != "" {
+		w.Header().Set(xhttp.AmzServerSideEncryption, sse)
+	}
+}
+
+// setUploadPartSseHeaders makes each part encrypted the same way as its multipart upload.
+// The SSE-C parts need the same customer key as the upload.
+func setUploadPartSseHeaders(r *http.Request, uploadExtended map[string][]byte) s3err.ErrorCode {
+	if keyMD5, found := uploadExtended[xhttp.SeaweedServerSideEncryptionCustomerKeyMD5]; found {
+		if r.Header.Get(xhttp.AmzServerSideEncryptionCustomerAlgorithm) == "" {
+			return s3err.ErrSseCustomerKeyRequired
+		}
+		if r.Header.Get(xhttp.AmzServerSideEncryptionCustomerKeyMD5) != string(keyMD5) {
+			return s3err.ErrSseCustomerKeyMismatch
+		}
+		return s3err.ErrNone
+	}
+	if r.Header.Get(xhttp.AmzServerSideEncryptionCustomerAlgorithm) != "" {
+		return s3err.ErrInvalidRequest
+	}
+	if sse, found := uploadExtended[xhttp.SeaweedServerSideEncryption]; found {
+		r.Header.Set(xhttp.AmzServerSideEncryption, string(sse))
+	} else {
+		r.Header.Del(xhttp.AmzServerSideEncryption)
+	}
+	return s3err.ErrNone
+}
@@ -0,0 +1,67 @@
+package s3api
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSseHeaders(t *testing.T) {
+
+	header := http.Header{}
+	assert.Equal(t, s3err.ErrNone, validateSseHeaders(header))
+
+	header.Set(xhttp.AmzServerSideEncryption, "AES256")
+	assert.Equal(t, s3err.ErrNone, validateSseHeaders(header))
+	header.Set(xhttp.AmzServerSideEncryption, "aws:kms")
+	assert.Equal(t, s3err.ErrNotImplemented, validateSseHeaders(header))
+	header.Set(xhttp.AmzServerSideEncryption, "DES")
+	assert.Equal(t, s3err.ErrInvalidEncryptionAlgorithm, validateSseHeaders(header))
+
+	key := util.GenCipherKey()
+	sum := md5.Sum(key)
+	header = http.Header{}
+	header.Set(xhttp.AmzServerSideEncryptionCustomerAlgorithm, "AES256")
+	header.Set(xhttp.AmzServerSideEncryptionCustomerKey, base64.StdEncoding.EncodeToString(key))
+	header.Set(xhttp.AmzServerSideEncryptionCustomerKeyMD5, base64.StdEncoding.EncodeToString(sum[:]))
+	assert.Equal(t, s3err.ErrNone, validateSseHeaders(header))
+
+	header.Set(xhttp.AmzServerSideEncryptionCustomerKeyMD5, base64.StdEncoding.EncodeToString(key[:16]))
+	assert.Equal(t, s3err.ErrSseCustomerKeyMD5Mismatch, validateSseHeaders(header))
+	header.Set(xhttp.AmzServerSideEncryptionCustomerKey, "short")
+	assert.Equal(t, s3err.ErrInvalidSseCustomerKey, validateSseHeaders(header))
+
+	header = http.Header{}
+	header.Set(xhttp.AmzCopySourceServerSideEncryptionCustomerPrefix+"algorithm", "AES256")
+	assert.Equal(t, s3err.ErrNone, validateSseHeaders(header))
+	assert.Equal(t, s3err.ErrInvalidSseCustomerKey, validateCopySourceSseHeaders(header))
+
+}
+
+func TestSetUploadPartSseHeaders(t *testing.T) {
+
+	r := &http.Request{Header: http.Header{}}
+	assert.Equal(t, s3err.ErrNone, setUploadPartSseHeaders(r, map[string][]byte{xhttp.SeaweedServerSideEncryption: []byte("AES256")}))
+	assert.Equal(t, "AES256", r.Header.Get(xhttp.AmzServerSideEncryption))
+
+	assert.Equal(t, s3err.ErrNone, setUploadPartSseHeaders(r, nil))
+	assert.Equal(t, "", r.Header.Get(xhttp.AmzServerSideEncryption))
+
+	uploadExtended := map[string][]byte{
+		xhttp.SeaweedServerSideEncryptionCustomerAlgorithm: []byte("AES256"),
+		xhttp.SeaweedServerSideEncryptionCustomerKeyMD5:    []byte("bWQ1"),
+	}
+	assert.Equal(t, s3err.ErrSseCustomerKeyRequired, setUploadPartSseHeaders(r, uploadExtended))
+	r.Header.Set(xhttp.AmzServerSideEncryptionCustomerAlgorithm, "AES256")
+	r.Header.Set(xhttp.AmzServerSideEncryptionCustomerKeyMD5, "b3RoZXI=")
+	assert.Equal(t, s3err.ErrSseCustomerKeyMismatch, setUploadPartSseHeaders(r, uploadExtended))
+	r.Header.Set(xhttp.AmzServerSideEncryptionCustomerKeyMD5, "bWQ1")
+	assert.Equal(t, s3err.ErrNone, setUploadPartSseHeaders(r, uploadExtended))
+
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/filer"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
 	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/util"
 )
 
 type ListBucketResultV2 struct {
@@ -63,6 +65,7 @@ func (s3a *S3ApiServer) ListObjectsV2Handler(w http.ResponseWriter, r *http.Requ
 		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
 		return
 	}
+	response.Name = requestBucket(r, bucket)
 
 	if len(response.Contents) == 0 {
 		if exists, existErr := s3a.exists(s3a.option.BucketsPath, bucket, true); existErr == nil && !exists {
@@ -113,6 +116,7 @@ func (s3a *S3ApiServer) ListObjectsV1Handler(w http.ResponseWriter, r *http.Requ
 		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
 		return
 	}
+	response.Name = requestBucket(r, bucket)
 
 	if len(response.Contents) == 0 {
 		if exists, existErr := s3a.exists(s3a.option.BucketsPath, bucket, true); existErr == nil && !exists {
@@ -260,7 +264,7 @@ func (s3a *S3ApiServer) doListFilerEntries(client filer_pb.SeaweedFilerClient, d
 		nextMarker = entry.Name
 		if entry.IsDirectory {
 			// println("ListEntries", dir, "dir:", entry.Name)
-			if entry.Name != ".uploads" { // FIXME no need to apply to all directories. this extra also affects maxKeys
+			if entry.Name != ".uploads" && entry.Name != ".versions" { // FIXME no need to apply to all directories. this extra also affects maxKeys
 				if delimiter != "/" {
 					eachEntryFn(dir, entry)
 					// println("doListFilerEntries2 dir", dir+"/"+entry.Name, "maxKeys", maxKeys-counter)
@@ -298,6 +302,296 @@ func (s3a *S3ApiServer) doListFilerEntries(client filer_pb.SeaweedFilerClient, d
 	return
 }
 
+type ListObjectVersionsResult struct {
+	XMLName             xml.Name            `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListVersionsResult"`
+	Name                string              `xml:"Name"`
+	Prefix              string              `xml:"Prefix"`
+	KeyMarker           string              `xml:"KeyMarker"`
+	VersionIdMarker     string              `xml:"VersionIdMarker"`
+	NextKeyMarker       string              `xml:"NextKeyMarker,omitempty"`
+	NextVersionIdMarker string              `xml:"NextVersionIdMarker,omitempty"`
+	MaxKeys             int                 `xml:"MaxKeys"`
+	Delimiter           string              `xml:"Delimiter,omitempty"`
+	IsTruncated         bool                `xml:"IsTruncated"`
+	Versions            []VersionEntry      `xml:"Version,omitempty"`
+	DeleteMarkers       []DeleteMarkerEntry `xml:"DeleteMarker,omitempty"`
+	CommonPrefixes      []PrefixEntry       `xml:"CommonPrefixes,omitempty"`
+}
+
+func (s3a *S3ApiServer) ListObjectVersionsHandler(w http.ResponseWriter, r *http.Request) {
+
+	// https://docs.aws.amazon.com/AmazonS3/latest/API/API_ListObjectVersions.html
+
+	// collect parameters
+	bucket, _ := getBucketAndObject(r)
+
+	prefix, keyMarker, versionIdMarker, delimiter, maxKeys := getListObjectVersionsArgs(r.URL.Query())
+
+	if maxKeys < 0 {
+		writeErrorResponse(w, s3err.ErrInvalidMaxKeys, r.URL)
+		return
+	}
+	if delimiter != "" && delimiter != "/" {
+		writeErrorResponse(w, s3err.ErrNotImplemented, r.URL)
+		return
+	}
+
+	if exists, existErr := s3a.exists(s3a.option.BucketsPath, bucket, true); existErr == nil && !exists {
+		writeErrorResponse(w, s3err.ErrNoSuchBucket, r.URL)
+		return
+	}
+
+	response, err := s3a.listObjectVersionEntries(bucket, prefix, keyMarker, versionIdMarker, delimiter, maxKeys)
+	if err != nil {
+		glog.Errorf("ListObjectVersionsHandler %s: %v", bucket, err)
+		writeErrorResponse(w, s3err.ErrInternalError, r.URL)
+		return
+	}
+	response.Name = requestBucket(r, bucket)
+
+	writeSuccessResponseXML(w, encodeResponse(response))
+}
+
+func (s3a *S3ApiServer) listObjectVersionEntries(bucket, prefix, keyMarker, versionIdMarker, delimiter string, maxKeys int) (response *ListObjectVersionsResult, err error) {
+
+	response = &ListObjectVersionsResult{
+		Name:            bucket,
+		Prefix:          prefix,
+		KeyMarker:       keyMarker,
+		VersionIdMarker: versionIdMarker,
+		MaxKeys:         maxKeys,
+		Delimiter:       delimiter,
+	}
+
+	// the keys are visited in the same order as the object listing, starting from the key marker
+	bucketDir := fmt.Sprintf("%s/%s", s3a.option.BucketsPath, bucket)
+	versionsDir := s3a.genVersionsFolder(bucket)
+	var counter int
+	_, err = s3a.walkObjectVersions(bucketDir, versionsDir, "", prefix, keyMarker, delimiter, func(key string, versions []*filer_pb.Entry, isCommonPrefix bool) bool {
+		if isCommonPrefix {
+			if counter >= maxKeys {
+				response.IsTruncated = true
+				return false
+			}
+			response.CommonPrefixes = append(response.CommonPrefixes, PrefixEntry{Prefix: key})
+			response.NextKeyMarker, response.NextVersionIdMarker = key, ""
+			counter++
+			return true
+		}
+		skipping := key == keyMarker
+		for i, entry := range versions {
+			versionId := getVersionId(entry)
+			if skipping {
+				skipping = versionId != versionIdMarker
+				continue
+			}
+			if counter >= maxKeys {
+				response.IsTruncated = true
+				return false
+			}
+			if isDeleteMarker(entry) {
+				response.DeleteMarkers = append(response.DeleteMarkers, DeleteMarkerEntry{
+					Key:          key,
+					VersionId:    versionId,
+					IsLatest:     i == 0,
+					LastModified: time.Unix(entry.Attributes.Mtime, 0).UTC(),
+					Owner: CanonicalUser{
+						ID:          fmt.Sprintf("%x", entry.Attributes.Uid),
+						DisplayName: entry.Attributes.UserName,
+					},
+				})
+			} else {
+				storageClass := "STANDARD"
+				if v, ok := entry.Extended[xhttp.AmzStorageClass]; ok {
+					storageClass = string(v)
+				}
+				response.Versions = append(response.Versions, VersionEntry{
+					Key:          key,
+					VersionId:    versionId,
+					IsLatest:     i == 0,
+					LastModified: time.Unix(entry.Attributes.Mtime, 0).UTC(),
+					ETag:         "\"" + filer.ETag(entry) + "\"",
+					Size:         int64(filer.FileSize(entry)),
+					Owner: CanonicalUser{
+						ID:          fmt.Sprintf("%x", entry.Attributes.Uid),
+						DisplayName: entry.Attributes.UserName,
+					},
+					StorageClass: StorageClass(storageClass),
+				})
+			}
+			response.NextKeyMarker, response.NextVersionIdMarker = key, versionId
+			counter++
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !response.IsTruncated {
+		response.NextKeyMarker, response.NextVersionIdMarker = "", ""
+	}
+
+	return
+}
+
+const versionsListPageSize = 1024
+
+// objectListCursor reads the entries of one folder page by page
+type objectListCursor struct {
+	s3a       *S3ApiServer
+	dir       string
+	prefix    string
+	startFrom string
+	inclusive bool
+	entries   []*filer_pb.Entry
+	isLast    bool
+}
+
+func (c *objectListCursor) peek() (*filer_pb.Entry, error) {
+	if len(c.entries) == 0 && !c.isLast {
+		entries, isLast, err := c.s3a.list(c.dir, c.prefix, c.startFrom, c.inclusive, versionsListPageSize)
+		if err != nil {
+			return nil, err
+		}
+		c.entries, c.isLast = entries, isLast
+		if len(entries) > 0 {
+			c.startFrom, c.inclusive = entries[len(entries)-1].Name, false
+		}
+	}
+	if len(c.entries) == 0 {
+		return nil, nil
+	}
+	return c.entries[0], nil
+}
+
+func (c *objectListCursor) next() {
+	c.entries = c.entries[1:]
+}
+
+// walkObjectVersions visits the keys under the relative folder dir, which is empty or ends with "/".
+// Each key comes with its current version from the bucket folder, followed by its older versions, the newest first.
+// Only the keys with the prefix, and from the key marker on, are visited, until fn returns false.
+// The prefix and the key marker are passed down to the filer listing, so only the folders on the way are read.
+// With the delimiter, the sub folders beyond the prefix are visited once as common prefixes.
+func (s3a *S3ApiServer) walkObjectVersions(bucketDir, versionsDir, dir, prefix, keyMarker, delimiter string, fn func(key string, versions []*filer_pb.Entry, isCommonPrefix bool) bool) (isDone bool, err error) {
+
+	// the names in this folder with the prefix
+	namePrefix, isExactName := "", false
+	if len(prefix) > len(dir) {
+		namePrefix = prefix[len(dir):]
+		if index := strings.Index(namePrefix, "/"); index >= 0 {
+			namePrefix, isExactName = namePrefix[:index], true
+		}
+	}
+	// the name in this folder to start from
+	startName, isMarkerInside := "", false
+	if len(keyMarker) > len(dir) {
+		startName = keyMarker[len(dir):]
+		if index := strings.Index(startName, "/"); index >= 0 {
+			startName, isMarkerInside = startName[:index], true
+		}
+	}
+
+	current := &objectListCursor{s3a: s3a, dir: strings.TrimSuffix(bucketDir+"/"+dir, "/"), prefix: namePrefix, startFrom: startName, inclusive: true}
+	older := &objectListCursor{s3a: s3a, dir: strings.TrimSuffix(versionsDir+"/"+dir, "/"), prefix: namePrefix, startFrom: startName, inclusive: true}
+	for {
+		currentEntry, err := current.peek()
+		if err != nil {
+			return false, err
+		}
+		olderEntry, err := older.peek()
+		if err != nil {
+			return false, err
+		}
+		if currentEntry == nil && olderEntry == nil {
+			return false, nil
+		}
+
+		// merge the two folders by the names
+		var name string
+		if olderEntry == nil || currentEntry != nil && currentEntry.Name <= olderEntry.Name {
+			name = currentEntry.Name
+		} else {
+			name = olderEntry.Name
+		}
+		if currentEntry != nil && currentEntry.Name == name {
+			current.next()
+		} else {
+			currentEntry = nil
+		}
+		if olderEntry != nil && olderEntry.Name == name {
+			older.next()
+		} else {
+			olderEntry = nil
+		}
+		// the files in the versions folder are the older versions of the parent folder
+		if olderEntry != nil && !olderEntry.IsDirectory {
+			olderEntry = nil
+		}
+		if dir == "" && currentEntry != nil && (name == ".uploads" || name == s3_constants.VersionsFolder) {
+			currentEntry = nil
+		}
+		if currentEntry == nil && olderEntry == nil || isExactName && name != namePrefix {
+			continue
+		}
+
+		key := dir + name
+		hasSubFolder := currentEntry != nil && currentEntry.IsDirectory
+		var olderVersions []*filer_pb.Entry
+		if olderEntry != nil {
+			var hasOlderSubFolder bool
+			if olderVersions, hasOlderSubFolder, err = s3a.readVersionsFolder(versionsDir + "/" + key); err != nil {
+				return false, err
+			}
+			hasSubFolder = hasSubFolder || hasOlderSubFolder
+		}
+
+		isBeforeMarker := name == startName && isMarkerInside
+		if strings.HasPrefix(key, prefix) && !isBeforeMarker {
+			var versions []*filer_pb.Entry
+			if currentEntry != nil && !currentEntry.IsDirectory {
+				versions = append(versions, currentEntry)
+			}
+			versions = append(versions, olderVersions...)
+			if len(versions) > 0 && !fn(key, versions, false) {
+				return true, nil
+			}
+		}
+
+		if !hasSubFolder || !strings.HasPrefix(key+"/", prefix) && !strings.HasPrefix(prefix, key+"/") {
+			continue
+		}
+		if delimiter == "/" && len(key) >= len(prefix) {
+			if !isBeforeMarker && !fn(key+"/", nil, true) {
+				return true, nil
+			}
+			continue
+		}
+		subKeyMarker := ""
+		if isBeforeMarker {
+			subKeyMarker = keyMarker
+		}
+		if isDone, err = s3a.walkObjectVersions(bucketDir, versionsDir, key+"/", prefix, subKeyMarker, delimiter, fn); err != nil || isDone {
+			return isDone, err
+		}
+	}
+}
+
+// readVersionsFolder returns the older versions in the versions folder of one object, the newest first,
+// and whether the folder also has the versions folders of the objects under it
+func (s3a *S3ApiServer) readVersionsFolder(dir string) (versions []*filer_pb.Entry, hasSubFolders bool, err error) {
+	err = filer_pb.ReadDirAllEntries(s3a, util.FullPath(dir), "", func(entry *filer_pb.Entry, isLast bool) error {
+		if entry.IsDirectory {
+			hasSubFolders = true
+		} else {
+			versions = append(versions, entry)
+		}
+		return nil
+	})
+	sortVersions(versions)
+	return
+}
+
 func getListObjectsV2Args(values url.Values) (prefix, token, startAfter, delimiter string, fetchOwner bool, maxkeys int) {
 	prefix = values.Get("prefix")
 	token = values.Get("continuation-token")
@@ -312,6 +606,19 @@ func getListObjectsV2Args(values url.Values) (prefix, token, startAfter, delimit
 	return
 }
 
+func getListObjectVersionsArgs(values url.Values) (prefix, keyMarker, versionIdMarker, delimiter string, maxkeys int) {
+	prefix = values.Get("prefix")
+	keyMarker = values.Get("key-marker")
+	versionIdMarker = values.Get("version-id-marker")
+	delimiter = values.Get("delimiter")
+	if values.Get("max-keys") != "" {
+		maxkeys, _ = strconv.Atoi(values.Get("max-keys"))
+	} else {
+		maxkeys = maxObjectListSizeLimit
+	}
+	return
+}
+
 func getListObjectsV1Args(values url.Values) (prefix, marker, delimiter string, maxkeys int) {
 	prefix = values.Get("prefix")
 	marker = values.Get("marker")
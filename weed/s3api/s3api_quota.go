@@ -0,0 +1,120 @@
+package s3api
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+)
+
+// the usage of the buckets owned by an identity is only refreshed after this interval
+const identityUsageCacheDuration = 10 * time.Second
+
+type identityUsage struct {
+	bytes     int64
+	objects   int64
+	checkedAt time.Time
+}
+
+type identityUsageCache struct {
+	sync.Mutex
+	usages map[string]*identityUsage
+}
+
+// requestContentLength returns the object size, also for the aws-chunked uploads
+func requestContentLength(r *http.Request) int64 {
+	if decodedLength := r.Header.Get("X-Amz-Decoded-Content-Length"); decodedLength != "" {
+		if size, err := strconv.ParseInt(decodedLength, 10, 64); err == nil {
+			return size
+		}
+	}
+	return r.ContentLength
+}
+
+// checkIdentityQuota checks the quota of the requesting identity before adding an object of the size
+func (s3a *S3ApiServer) checkIdentityQuota(r *http.Request, size int64) s3err.ErrorCode {
+	identityId := r.Header.Get(xhttp.AmzIdentityId)
+	if identityId == "" {
+		return s3err.ErrNone
+	}
+	identity, found := s3a.iam.lookupByName(identityId)
+	if !found || identity.QuotaBytes == 0 && identity.QuotaObjects == 0 {
+		return s3err.ErrNone
+	}
+
+	usage, err := s3a.getIdentityUsage(identityId)
+	if err != nil {
+		glog.Errorf("usage of identity %s: %v", identityId, err)
+		return s3err.ErrInternalError
+	}
+	if size < 0 {
+		size = 0
+	}
+	if identity.QuotaBytes > 0 && uint64(usage.bytes+size) > identity.QuotaBytes {
+		glog.V(1).Infof("identity %s uses %d of %d bytes", identityId, usage.bytes, identity.QuotaBytes)
+		return s3err.ErrQuotaExceeded
+	}
+	if identity.QuotaObjects > 0 && uint64(usage.objects+1) > identity.QuotaObjects {
+		glog.V(1).Infof("identity %s has %d of %d objects", identityId, usage.objects, identity.QuotaObjects)
+		return s3err.ErrQuotaExceeded
+	}
+	return s3err.ErrNone
+}
+
+// getIdentityUsage sums up the usage of all the buckets owned by the identity
+func (s3a *S3ApiServer) getIdentityUsage(identityId string) (identityUsage, error) {
+	cache := &s3a.identityUsages
+	cache.Lock()
+	if usage, found := cache.usages[identityId]; found && time.Since(usage.checkedAt) < identityUsageCacheDuration {
+		cache.Unlock()
+		return *usage, nil
+	}
+	cache.Unlock()
+
+	entries, _, err := s3a.list(s3a.option.BucketsPath, "", "", false, math.MaxInt32)
+	if err != nil {
+		return identityUsage{}, err
+	}
+	request := &filer_pb.GetDirectoryUsageRequest{}
+	for _, entry := range entries {
+		if !entry.IsDirectory || entry.Extended == nil {
+			continue
+		}
+		if owner, ok := entry.Extended[xhttp.AmzIdentityId]; ok && string(owner) == identityId {
+			request.LocationPrefixes = append(request.LocationPrefixes, s3a.option.BucketsPath+"/"+entry.Name+"/")
+		}
+	}
+
+	usage := &identityUsage{checkedAt: time.Now()}
+	if len(request.LocationPrefixes) > 0 {
+		err = s3a.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+			resp, err := client.GetDirectoryUsage(context.Background(), request)
+			if err != nil {
+				return err
+			}
+			for _, directoryUsage := range resp.Usages {
+				usage.bytes += directoryUsage.UsedBytes
+				usage.objects += directoryUsage.UsedObjects
+			}
+			return nil
+		})
+		if err != nil {
+			return identityUsage{}, err
+		}
+	}
+
+	cache.Lock()
+	if cache.usages == nil {
+		cache.usages = make(map[string]*identityUsage)
+	}
+	cache.usages[identityId] = usage
+	cache.Unlock()
+	return *usage, nil
+}
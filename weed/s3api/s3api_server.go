@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"github.com/chrislusf/seaweedfs/weed/filer"
 	. "github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/chrislusf/seaweedfs/weed/util/log_buffer"
 	"net/http"
 	"strings"
 	"time"
@@ -21,11 +25,21 @@ type S3ApiServerOption struct {
 	BucketsPath      string
 	GrpcDialOption   grpc.DialOption
 	AllowEmptyFolder bool
+	// the website endpoint serves the bucket "b" as "b.<WebsiteDomainName>"
+	WebsiteDomainName string
 }
 
 type S3ApiServer struct {
-	option *S3ApiServerOption
-	iam    *IdentityAccessManagement
+	option         *S3ApiServerOption
+	iam            *IdentityAccessManagement
+	identityUsages identityUsageCache
+	bucketConfigs  bucketConfigCache
+	objectLocks    objectLocks
+	// the access logs of the buckets, flushed into the target buckets
+	accessLogBuffer *log_buffer.LogBuffer
+	// signs the requests to bypass the GOVERNANCE retention
+	filerSigningKey             security.SigningKey
+	filerSigningExpiresAfterSec int
 }
 
 func NewS3ApiServer(router *mux.Router, option *S3ApiServerOption) (s3ApiServer *S3ApiServer, err error) {
@@ -34,9 +48,18 @@ func NewS3ApiServer(router *mux.Router, option *S3ApiServerOption) (s3ApiServer
 		iam:    NewIdentityAccessManagement(option),
 	}
 
+	v := util.GetViper()
+	s3ApiServer.filerSigningKey = security.SigningKey(v.GetString("jwt.filer_signing.key"))
+	v.SetDefault("jwt.filer_signing.expires_after_seconds", 10)
+	s3ApiServer.filerSigningExpiresAfterSec = v.GetInt("jwt.filer_signing.expires_after_seconds")
+
+	s3ApiServer.iam.loadBucketPolicy = s3ApiServer.getBucketPolicy
+	s3ApiServer.iam.loadAcl = s3ApiServer.getAcl
+	s3ApiServer.accessLogBuffer = log_buffer.NewLogBuffer(accessLogFlushInterval, s3ApiServer.flushAccessLogs, nil)
+
 	s3ApiServer.registerRouter(router)
 
-	go s3ApiServer.subscribeMetaEvents("s3", filer.IamConfigDirecotry+"/"+filer.IamIdentityFile, time.Now().UnixNano())
+	go s3ApiServer.subscribeMetaEvents("s3", filer.IamConfigDirecotry+"/", []string{option.BucketsPath}, time.Now().UnixNano())
 
 	return s3ApiServer, nil
 }
@@ -44,6 +67,7 @@ func NewS3ApiServer(router *mux.Router, option *S3ApiServerOption) (s3ApiServer
 func (s3a *S3ApiServer) registerRouter(router *mux.Router) {
 	// API Router
 	apiRouter := router.PathPrefix("/").Subrouter()
+	apiRouter.Use(s3a.accessLog)
 	var routers []*mux.Router
 	if s3a.option.DomainName != "" {
 		domainNames := strings.Split(s3a.option.DomainName, ",")
@@ -58,76 +82,154 @@ func (s3a *S3ApiServer) registerRouter(router *mux.Router) {
 
 	for _, bucket := range routers {
 
+		bucket.Use(rejectVersionsFolderKeys)
+
+		// PreflightObject, the CORS preflight requests are not signed
+		bucket.Methods("OPTIONS").Path("/{object:.+}").HandlerFunc(track(s3a.PreflightHandler, "OPTIONS"))
+		// PreflightBucket
+		bucket.Methods("OPTIONS").HandlerFunc(track(s3a.PreflightHandler, "OPTIONS"))
+
 		// HeadObject
-		bucket.Methods("HEAD").Path("/{object:.+}").HandlerFunc(track(s3a.iam.Auth(s3a.HeadObjectHandler, ACTION_READ), "GET"))
+		bucket.Methods("HEAD").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.HeadObjectHandler, ACTION_READ)), "GET"))
 		// HeadBucket
-		bucket.Methods("HEAD").HandlerFunc(track(s3a.iam.Auth(s3a.HeadBucketHandler, ACTION_ADMIN), "GET"))
+		bucket.Methods("HEAD").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.HeadBucketHandler, ACTION_ADMIN)), "GET"))
 
 		// CopyObjectPart
-		bucket.Methods("PUT").Path("/{object:.+}").HeadersRegexp("X-Amz-Copy-Source", `.*?(\/|%2F).*?`).HandlerFunc(track(s3a.iam.Auth(s3a.CopyObjectPartHandler, ACTION_WRITE), "PUT")).Queries("partNumber", "{partNumber:[0-9]+}", "uploadId", "{uploadId:.*}")
+		bucket.Methods("PUT").Path("/{object:.+}").HeadersRegexp("X-Amz-Copy-Source", `.*?(\/|%2F).*?`).HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.CopyObjectPartHandler, ACTION_WRITE)), "PUT")).Queries("partNumber", "{partNumber:[0-9]+}", "uploadId", "{uploadId:.*}")
 		// PutObjectPart
-		bucket.Methods("PUT").Path("/{object:.+}").HandlerFunc(track(s3a.iam.Auth(s3a.PutObjectPartHandler, ACTION_WRITE), "PUT")).Queries("partNumber", "{partNumber:[0-9]+}", "uploadId", "{uploadId:.*}")
+		bucket.Methods("PUT").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutObjectPartHandler, ACTION_WRITE)), "PUT")).Queries("partNumber", "{partNumber:[0-9]+}", "uploadId", "{uploadId:.*}")
+		// SelectObjectContent
+		bucket.Methods("POST").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.SelectObjectContentHandler, ACTION_READ)), "POST")).Queries("select", "", "select-type", "2")
 		// CompleteMultipartUpload
-		bucket.Methods("POST").Path("/{object:.+}").HandlerFunc(track(s3a.iam.Auth(s3a.CompleteMultipartUploadHandler, ACTION_WRITE), "POST")).Queries("uploadId", "{uploadId:.*}")
+		bucket.Methods("POST").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.CompleteMultipartUploadHandler, ACTION_WRITE)), "POST")).Queries("uploadId", "{uploadId:.*}")
 		// NewMultipartUpload
-		bucket.Methods("POST").Path("/{object:.+}").HandlerFunc(track(s3a.iam.Auth(s3a.NewMultipartUploadHandler, ACTION_WRITE), "POST")).Queries("uploads", "")
+		bucket.Methods("POST").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.NewMultipartUploadHandler, ACTION_WRITE)), "POST")).Queries("uploads", "")
 		// AbortMultipartUpload
-		bucket.Methods("DELETE").Path("/{object:.+}").HandlerFunc(track(s3a.iam.Auth(s3a.AbortMultipartUploadHandler, ACTION_WRITE), "DELETE")).Queries("uploadId", "{uploadId:.*}")
+		bucket.Methods("DELETE").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.AbortMultipartUploadHandler, ACTION_WRITE)), "DELETE")).Queries("uploadId", "{uploadId:.*}")
 		// ListObjectParts
-		bucket.Methods("GET").Path("/{object:.+}").HandlerFunc(track(s3a.iam.Auth(s3a.ListObjectPartsHandler, ACTION_READ), "GET")).Queries("uploadId", "{uploadId:.*}")
+		bucket.Methods("GET").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.ListObjectPartsHandler, ACTION_READ)), "GET")).Queries("uploadId", "{uploadId:.*}")
 		// ListMultipartUploads
-		bucket.Methods("GET").HandlerFunc(track(s3a.iam.Auth(s3a.ListMultipartUploadsHandler, ACTION_READ), "GET")).Queries("uploads", "")
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.ListMultipartUploadsHandler, ACTION_READ)), "GET")).Queries("uploads", "")
 
 		// GetObjectTagging
-		bucket.Methods("GET").Path("/{object:.+}").HandlerFunc(track(s3a.iam.Auth(s3a.GetObjectTaggingHandler, ACTION_READ), "GET")).Queries("tagging", "")
+		bucket.Methods("GET").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetObjectTaggingHandler, ACTION_READ)), "GET")).Queries("tagging", "")
 		// PutObjectTagging
-		bucket.Methods("PUT").Path("/{object:.+}").HandlerFunc(track(s3a.iam.Auth(s3a.PutObjectTaggingHandler, ACTION_TAGGING), "PUT")).Queries("tagging", "")
+		bucket.Methods("PUT").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutObjectTaggingHandler, ACTION_TAGGING)), "PUT")).Queries("tagging", "")
 		// DeleteObjectTagging
-		bucket.Methods("DELETE").Path("/{object:.+}").HandlerFunc(track(s3a.iam.Auth(s3a.DeleteObjectTaggingHandler, ACTION_TAGGING), "DELETE")).Queries("tagging", "")
+		bucket.Methods("DELETE").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.DeleteObjectTaggingHandler, ACTION_TAGGING)), "DELETE")).Queries("tagging", "")
+
+		// GetObjectAcl
+		bucket.Methods("GET").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetObjectAclHandler, ACTION_READ)), "GET")).Queries("acl", "")
+		// PutObjectAcl
+		bucket.Methods("PUT").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutObjectAclHandler, ACTION_WRITE)), "PUT")).Queries("acl", "")
+
+		// GetObjectRetention
+		bucket.Methods("GET").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetObjectRetentionHandler, ACTION_READ)), "GET")).Queries("retention", "")
+		// PutObjectRetention
+		bucket.Methods("PUT").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutObjectRetentionHandler, ACTION_WRITE)), "PUT")).Queries("retention", "")
+		// GetObjectLegalHold
+		bucket.Methods("GET").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetObjectLegalHoldHandler, ACTION_READ)), "GET")).Queries("legal-hold", "")
+		// PutObjectLegalHold
+		bucket.Methods("PUT").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutObjectLegalHoldHandler, ACTION_WRITE)), "PUT")).Queries("legal-hold", "")
+
+		// GetObjectLockConfiguration
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetObjectLockConfigurationHandler, ACTION_READ)), "GET")).Queries("object-lock", "")
+		// PutObjectLockConfiguration
+		bucket.Methods("PUT").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutObjectLockConfigurationHandler, ACTION_ADMIN)), "PUT")).Queries("object-lock", "")
+
+		// GetBucketVersioning
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetBucketVersioningHandler, ACTION_READ)), "GET")).Queries("versioning", "")
+		// PutBucketVersioning
+		bucket.Methods("PUT").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutBucketVersioningHandler, ACTION_ADMIN)), "PUT")).Queries("versioning", "")
+		// ListObjectVersions
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.ListObjectVersionsHandler, ACTION_LIST)), "LIST")).Queries("versions", "")
+
+		// GetBucketPolicy
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetBucketPolicyHandler, ACTION_ADMIN)), "GET")).Queries("policy", "")
+		// PutBucketPolicy
+		bucket.Methods("PUT").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutBucketPolicyHandler, ACTION_ADMIN)), "PUT")).Queries("policy", "")
+		// DeleteBucketPolicy
+		bucket.Methods("DELETE").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.DeleteBucketPolicyHandler, ACTION_ADMIN)), "DELETE")).Queries("policy", "")
+
+		// GetBucketCors
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetBucketCorsHandler, ACTION_READ)), "GET")).Queries("cors", "")
+		// PutBucketCors
+		bucket.Methods("PUT").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutBucketCorsHandler, ACTION_ADMIN)), "PUT")).Queries("cors", "")
+		// DeleteBucketCors
+		bucket.Methods("DELETE").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.DeleteBucketCorsHandler, ACTION_ADMIN)), "DELETE")).Queries("cors", "")
+
+		// GetBucketWebsite
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetBucketWebsiteHandler, ACTION_READ)), "GET")).Queries("website", "")
+		// PutBucketWebsite
+		bucket.Methods("PUT").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutBucketWebsiteHandler, ACTION_ADMIN)), "PUT")).Queries("website", "")
+		// DeleteBucketWebsite
+		bucket.Methods("DELETE").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.DeleteBucketWebsiteHandler, ACTION_ADMIN)), "DELETE")).Queries("website", "")
+
+		// GetBucketLogging
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetBucketLoggingHandler, ACTION_READ)), "GET")).Queries("logging", "")
+		// PutBucketLogging
+		bucket.Methods("PUT").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutBucketLoggingHandler, ACTION_ADMIN)), "PUT")).Queries("logging", "")
+
+		// GetBucketReplication
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetBucketReplicationHandler, ACTION_READ)), "GET")).Queries("replication", "")
+		// PutBucketReplication
+		bucket.Methods("PUT").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutBucketReplicationHandler, ACTION_ADMIN)), "PUT")).Queries("replication", "")
+		// DeleteBucketReplication
+		bucket.Methods("DELETE").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.DeleteBucketReplicationHandler, ACTION_ADMIN)), "DELETE")).Queries("replication", "")
+
+		// GetBucketLifecycleConfiguration
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetBucketLifecycleConfigurationHandler, ACTION_READ)), "GET")).Queries("lifecycle", "")
+		// PutBucketLifecycleConfiguration
+		bucket.Methods("PUT").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutBucketLifecycleConfigurationHandler, ACTION_ADMIN)), "PUT")).Queries("lifecycle", "")
+		// DeleteBucketLifecycle
+		bucket.Methods("DELETE").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.DeleteBucketLifecycleHandler, ACTION_ADMIN)), "DELETE")).Queries("lifecycle", "")
+
+		// GetBucketNotificationConfiguration
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetBucketNotificationConfigurationHandler, ACTION_READ)), "GET")).Queries("notification", "")
+		// PutBucketNotificationConfiguration
+		bucket.Methods("PUT").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutBucketNotificationConfigurationHandler, ACTION_ADMIN)), "PUT")).Queries("notification", "")
+
+		// GetBucketAcl
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetBucketAclHandler, ACTION_READ)), "GET")).Queries("acl", "")
+		// PutBucketAcl
+		bucket.Methods("PUT").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutBucketAclHandler, ACTION_ADMIN)), "PUT")).Queries("acl", "")
 
 		// CopyObject
-		bucket.Methods("PUT").Path("/{object:.+}").HeadersRegexp("X-Amz-Copy-Source", ".*?(\\/|%2F).*?").HandlerFunc(track(s3a.iam.Auth(s3a.CopyObjectHandler, ACTION_WRITE), "COPY"))
+		bucket.Methods("PUT").Path("/{object:.+}").HeadersRegexp("X-Amz-Copy-Source", ".*?(\\/|%2F).*?").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.CopyObjectHandler, ACTION_WRITE)), "COPY"))
 		// PutObject
-		bucket.Methods("PUT").Path("/{object:.+}").HandlerFunc(track(s3a.iam.Auth(s3a.PutObjectHandler, ACTION_WRITE), "PUT"))
+		bucket.Methods("PUT").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutObjectHandler, ACTION_WRITE)), "PUT"))
 		// PutBucket
-		bucket.Methods("PUT").HandlerFunc(track(s3a.iam.Auth(s3a.PutBucketHandler, ACTION_ADMIN), "PUT"))
+		bucket.Methods("PUT").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PutBucketHandler, ACTION_ADMIN)), "PUT"))
 
 		// DeleteObject
-		bucket.Methods("DELETE").Path("/{object:.+}").HandlerFunc(track(s3a.iam.Auth(s3a.DeleteObjectHandler, ACTION_WRITE), "DELETE"))
+		bucket.Methods("DELETE").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.DeleteObjectHandler, ACTION_WRITE)), "DELETE"))
 		// DeleteBucket
-		bucket.Methods("DELETE").HandlerFunc(track(s3a.iam.Auth(s3a.DeleteBucketHandler, ACTION_WRITE), "DELETE"))
+		bucket.Methods("DELETE").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.DeleteBucketHandler, ACTION_WRITE)), "DELETE"))
 
 		// ListObjectsV2
-		bucket.Methods("GET").HandlerFunc(track(s3a.iam.Auth(s3a.ListObjectsV2Handler, ACTION_LIST), "LIST")).Queries("list-type", "2")
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.ListObjectsV2Handler, ACTION_LIST)), "LIST")).Queries("list-type", "2")
 		// GetObject, but directory listing is not supported
-		bucket.Methods("GET").Path("/{object:.+}").HandlerFunc(track(s3a.iam.Auth(s3a.GetObjectHandler, ACTION_READ), "GET"))
+		bucket.Methods("GET").Path("/{object:.+}").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.GetObjectHandler, ACTION_READ)), "GET"))
 		// ListObjectsV1 (Legacy)
-		bucket.Methods("GET").HandlerFunc(track(s3a.iam.Auth(s3a.ListObjectsV1Handler, ACTION_LIST), "LIST"))
+		bucket.Methods("GET").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.ListObjectsV1Handler, ACTION_LIST)), "LIST"))
 
 		// PostPolicy
-		bucket.Methods("POST").HeadersRegexp("Content-Type", "multipart/form-data*").HandlerFunc(track(s3a.iam.Auth(s3a.PostPolicyBucketHandler, ACTION_WRITE), "POST"))
+		bucket.Methods("POST").HeadersRegexp("Content-Type", "multipart/form-data*").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.PostPolicyBucketHandler, ACTION_WRITE)), "POST"))
 
 		// DeleteMultipleObjects
-		bucket.Methods("POST").HandlerFunc(track(s3a.iam.Auth(s3a.DeleteMultipleObjectsHandler, ACTION_WRITE), "DELETE")).Queries("delete", "")
+		bucket.Methods("POST").HandlerFunc(track(s3a.cors(s3a.iam.Auth(s3a.DeleteMultipleObjectsHandler, ACTION_WRITE)), "DELETE")).Queries("delete", "")
 		/*
 
 			// not implemented
 			// GetBucketLocation
 			bucket.Methods("GET").HandlerFunc(s3a.GetBucketLocationHandler).Queries("location", "")
-			// GetBucketPolicy
-			bucket.Methods("GET").HandlerFunc(s3a.GetBucketPolicyHandler).Queries("policy", "")
-			// GetObjectACL
-			bucket.Methods("GET").Path("/{object:.+}").HandlerFunc(s3a.GetObjectACLHandler).Queries("acl", "")
-			// GetBucketACL
-			bucket.Methods("GET").HandlerFunc(s3a.GetBucketACLHandler).Queries("acl", "")
-			// PutBucketPolicy
-			bucket.Methods("PUT").HandlerFunc(s3a.PutBucketPolicyHandler).Queries("policy", "")
-			// DeleteBucketPolicy
-			bucket.Methods("DELETE").HandlerFunc(s3a.DeleteBucketPolicyHandler).Queries("policy", "")
 		*/
 
 	}
 
+	// STS AssumeRole, AssumeRoleWithWebIdentity, GetSessionToken
+	apiRouter.Methods("POST").Path("/").HeadersRegexp("Content-Type", "application/x-www-form-urlencoded").HandlerFunc(track(s3a.StsHandler, "STS"))
+
 	// ListBuckets
 	apiRouter.Methods("GET").Path("/").HandlerFunc(track(s3a.ListBucketsHandler, "LIST"))
 
@@ -135,3 +237,14 @@ func (s3a *S3ApiServer) registerRouter(router *mux.Router) {
 	apiRouter.NotFoundHandler = http.HandlerFunc(notFoundHandler)
 
 }
+
+// rejectVersionsFolderKeys refuses the object keys under .versions/, where the older versions of the objects are kept
+func rejectVersionsFolderKeys(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, object := getBucketAndObject(r); isVersionsFolderKey(object) {
+			writeErrorResponse(w, s3err.ErrReservedObjectKey, r.URL)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,366 @@
+package s3api
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/s3api/bucketpolicy"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3sts"
+)
+
+// The STS api shares the port with the s3 api, as POST / with the form encoded parameters
+// API reference: https://docs.aws.amazon.com/STS/latest/APIReference/welcome.html
+
+const (
+	maxStsRequestSize        = 64 * 1024
+	maxSessionPolicySize     = 2048
+	minSessionDuration       = 15 * time.Minute
+	defaultSessionDuration   = 12 * time.Hour
+	maxSessionTokenDuration  = 36 * time.Hour
+	assumedRoleArnPrefix     = "arn:aws:sts:::assumed-role/"
+	sessionCredentialsFormat = "2006-01-02T15:04:05Z"
+)
+
+var roleSessionNameRegexp = regexp.MustCompile(`^[\w+=,.@-]{2,64}$`)
+
+type StsCredentials struct {
+	AccessKeyId     string `xml:"AccessKeyId"`
+	SecretAccessKey string `xml:"SecretAccessKey"`
+	SessionToken    string `xml:"SessionToken"`
+	Expiration      string `xml:"Expiration"`
+}
+
+type AssumedRoleUser struct {
+	Arn           string `xml:"Arn"`
+	AssumedRoleId string `xml:"AssumedRoleId"`
+}
+
+type StsResponseMetadata struct {
+	RequestId string `xml:"RequestId"`
+}
+
+type AssumeRoleResponse struct {
+	XMLName          xml.Name `xml:"https://sts.amazonaws.com/doc/2011-06-15/ AssumeRoleResponse"`
+	AssumeRoleResult struct {
+		Credentials     StsCredentials  `xml:"Credentials"`
+		AssumedRoleUser AssumedRoleUser `xml:"AssumedRoleUser"`
+	} `xml:"AssumeRoleResult"`
+	ResponseMetadata StsResponseMetadata `xml:"ResponseMetadata"`
+}
+
+type AssumeRoleWithWebIdentityResponse struct {
+	XMLName                         xml.Name `xml:"https://sts.amazonaws.com/doc/2011-06-15/ AssumeRoleWithWebIdentityResponse"`
+	AssumeRoleWithWebIdentityResult struct {
+		Credentials                 StsCredentials  `xml:"Credentials"`
+		AssumedRoleUser             AssumedRoleUser `xml:"AssumedRoleUser"`
+		SubjectFromWebIdentityToken string          `xml:"SubjectFromWebIdentityToken"`
+		Audience                    string          `xml:"Audience"`
+		Provider                    string          `xml:"Provider"`
+	} `xml:"AssumeRoleWithWebIdentityResult"`
+	ResponseMetadata StsResponseMetadata `xml:"ResponseMetadata"`
+}
+
+type GetSessionTokenResponse struct {
+	XMLName               xml.Name `xml:"https://sts.amazonaws.com/doc/2011-06-15/ GetSessionTokenResponse"`
+	GetSessionTokenResult struct {
+		Credentials StsCredentials `xml:"Credentials"`
+	} `xml:"GetSessionTokenResult"`
+	ResponseMetadata StsResponseMetadata `xml:"ResponseMetadata"`
+}
+
+type StsErrorResponse struct {
+	XMLName xml.Name `xml:"https://sts.amazonaws.com/doc/2011-06-15/ ErrorResponse"`
+	Error   struct {
+		Type    string `xml:"Type"`
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	} `xml:"Error"`
+	RequestId string `xml:"RequestId"`
+}
+
+type stsError struct {
+	code       string
+	message    string
+	statusCode int
+}
+
+func (e *stsError) Error() string {
+	return e.code + ": " + e.message
+}
+
+func newStsError(statusCode int, code, format string, args ...interface{}) *stsError {
+	return &stsError{code: code, message: fmt.Sprintf(format, args...), statusCode: statusCode}
+}
+
+// StsHandler serves AssumeRole, AssumeRoleWithWebIdentity, and GetSessionToken
+func (s3a *S3ApiServer) StsHandler(w http.ResponseWriter, r *http.Request) {
+
+	if len(s3a.iam.sessionSigningKey) == 0 {
+		writeStsErrorResponse(w, newStsError(http.StatusNotImplemented, "NotImplemented", "the STS api needs the session_signing_key in the s3 configuration"))
+		return
+	}
+
+	// keep the body for verifying the signature
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxStsRequestSize))
+	if err != nil {
+		writeStsErrorResponse(w, newStsError(http.StatusBadRequest, "InvalidRequest", "read request: %v", err))
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		writeStsErrorResponse(w, newStsError(http.StatusBadRequest, "InvalidRequest", "parse request: %v", err))
+		return
+	}
+
+	var response interface{}
+	var stsErr *stsError
+	switch action := values.Get("Action"); action {
+	case "AssumeRole":
+		response, stsErr = s3a.assumeRole(r, values)
+	case "AssumeRoleWithWebIdentity":
+		response, stsErr = s3a.assumeRoleWithWebIdentity(values)
+	case "GetSessionToken":
+		response, stsErr = s3a.getSessionToken(r, values)
+	default:
+		stsErr = newStsError(http.StatusBadRequest, "InvalidAction", "action %s is not supported", action)
+	}
+	if stsErr != nil {
+		glog.V(1).Infof("sts %s: %v", values.Get("Action"), stsErr)
+		writeStsErrorResponse(w, stsErr)
+		return
+	}
+
+	writeSuccessResponseXML(w, encodeResponse(response))
+}
+
+func (s3a *S3ApiServer) assumeRole(r *http.Request, values url.Values) (*AssumeRoleResponse, *stsError) {
+
+	caller, stsErr := s3a.authStsCaller(r)
+	if stsErr != nil {
+		return nil, stsErr
+	}
+
+	role, sessionName, stsErr := s3a.lookupAssumedRole(values)
+	if stsErr != nil {
+		return nil, stsErr
+	}
+	if !role.trustsIdentity(caller.Name) {
+		return nil, newStsError(http.StatusForbidden, "AccessDenied", "%s is not allowed to assume role %s", caller.Name, role.Name)
+	}
+
+	creds, stsErr := s3a.newRoleSession(role, sessionName, values)
+	if stsErr != nil {
+		return nil, stsErr
+	}
+
+	response := &AssumeRoleResponse{}
+	response.AssumeRoleResult.Credentials = toStsCredentials(creds)
+	response.AssumeRoleResult.AssumedRoleUser = assumedRoleUser(role, sessionName, creds)
+	response.ResponseMetadata.RequestId = newRequestId()
+	return response, nil
+}
+
+func (s3a *S3ApiServer) assumeRoleWithWebIdentity(values url.Values) (*AssumeRoleWithWebIdentityResponse, *stsError) {
+
+	token := values.Get("WebIdentityToken")
+	if token == "" {
+		return nil, newStsError(http.StatusBadRequest, "MissingParameter", "WebIdentityToken is required")
+	}
+
+	role, sessionName, stsErr := s3a.lookupAssumedRole(values)
+	if stsErr != nil {
+		return nil, stsErr
+	}
+
+	// try the providers trusted by the role
+	var webIdentity *s3sts.WebIdentity
+	var verifyErr error
+	for _, name := range role.TrustedProviders {
+		provider, found := s3a.iam.webIdentityProviders[name]
+		if !found {
+			continue
+		}
+		if webIdentity, verifyErr = provider.Verify(token); verifyErr == nil {
+			break
+		}
+	}
+	if webIdentity == nil {
+		glog.V(1).Infof("web identity token for role %s: %v", role.Name, verifyErr)
+		return nil, newStsError(http.StatusBadRequest, "InvalidIdentityToken", "the web identity token is not valid for role %s", role.Name)
+	}
+	if !role.trustsWebIdentity(webIdentity) {
+		return nil, newStsError(http.StatusForbidden, "AccessDenied", "%s is not allowed to assume role %s", webIdentity.Subject, role.Name)
+	}
+
+	creds, stsErr := s3a.newRoleSession(role, sessionName, values)
+	if stsErr != nil {
+		return nil, stsErr
+	}
+
+	response := &AssumeRoleWithWebIdentityResponse{}
+	result := &response.AssumeRoleWithWebIdentityResult
+	result.Credentials = toStsCredentials(creds)
+	result.AssumedRoleUser = assumedRoleUser(role, sessionName, creds)
+	result.SubjectFromWebIdentityToken = webIdentity.Subject
+	result.Audience = webIdentity.Audience
+	result.Provider = webIdentity.Provider
+	response.ResponseMetadata.RequestId = newRequestId()
+	return response, nil
+}
+
+func (s3a *S3ApiServer) getSessionToken(r *http.Request, values url.Values) (*GetSessionTokenResponse, *stsError) {
+
+	caller, stsErr := s3a.authStsCaller(r)
+	if stsErr != nil {
+		return nil, stsErr
+	}
+
+	duration, stsErr := parseSessionDuration(values, defaultSessionDuration, maxSessionTokenDuration)
+	if stsErr != nil {
+		return nil, stsErr
+	}
+
+	creds, err := s3sts.NewSession(s3a.iam.sessionSigningKey, &s3sts.SessionClaims{
+		Parent: caller.Name,
+	}, duration)
+	if err != nil {
+		glog.Errorf("new session of %s: %v", caller.Name, err)
+		return nil, newStsError(http.StatusInternalServerError, "InternalError", "can not create the session")
+	}
+
+	response := &GetSessionTokenResponse{}
+	response.GetSessionTokenResult.Credentials = toStsCredentials(creds)
+	response.ResponseMetadata.RequestId = newRequestId()
+	return response, nil
+}
+
+// authStsCaller verifies the signature of the request with long-lived credentials
+func (s3a *S3ApiServer) authStsCaller(r *http.Request) (*Identity, *stsError) {
+	if r.Header.Get(xhttp.AmzSecurityToken) != "" || r.URL.Query().Get(xhttp.AmzSecurityToken) != "" {
+		return nil, newStsError(http.StatusForbidden, "AccessDenied", "temporary credentials can not request other temporary credentials")
+	}
+	identity, errCode := s3a.iam.authUser(r)
+	if errCode != s3err.ErrNone || identity == nil || identity.Name == "anonymous" {
+		apiErr := s3err.GetAPIError(s3err.ErrAccessDenied)
+		if errCode != s3err.ErrNone {
+			apiErr = s3err.GetAPIError(errCode)
+		}
+		return nil, newStsError(http.StatusForbidden, "AccessDenied", "%s", apiErr.Description)
+	}
+	return identity, nil
+}
+
+func (s3a *S3ApiServer) lookupAssumedRole(values url.Values) (*Role, string, *stsError) {
+	roleArn := values.Get("RoleArn")
+	if roleArn == "" {
+		return nil, "", newStsError(http.StatusBadRequest, "MissingParameter", "RoleArn is required")
+	}
+	// arn:aws:iam::<account>:role/<name>, or just the name
+	roleName := roleArn
+	if i := strings.LastIndex(roleArn, ":role/"); i >= 0 {
+		roleName = roleArn[i+len(":role/"):]
+	}
+	role, found := s3a.iam.lookupRole(roleName)
+	if !found {
+		return nil, "", newStsError(http.StatusForbidden, "AccessDenied", "role %s not found", roleName)
+	}
+	sessionName := values.Get("RoleSessionName")
+	if !roleSessionNameRegexp.MatchString(sessionName) {
+		return nil, "", newStsError(http.StatusBadRequest, "ValidationError", "RoleSessionName should be 2 to 64 characters of letters, digits, and +=,.@_-")
+	}
+	return role, sessionName, nil
+}
+
+func (s3a *S3ApiServer) newRoleSession(role *Role, sessionName string, values url.Values) (*s3sts.Credentials, *stsError) {
+
+	duration, stsErr := parseSessionDuration(values, defaultRoleSessionDuration, role.MaxSessionDuration)
+	if stsErr != nil {
+		return nil, stsErr
+	}
+
+	policy := values.Get("Policy")
+	if policy != "" {
+		if len(policy) > maxSessionPolicySize {
+			return nil, newStsError(http.StatusBadRequest, "PackedPolicyTooLarge", "Policy should be at most %d characters", maxSessionPolicySize)
+		}
+		p, err := bucketpolicy.Parse([]byte(policy))
+		if err == nil {
+			err = p.ValidateIdentityPolicy()
+		}
+		if err != nil {
+			return nil, newStsError(http.StatusBadRequest, "MalformedPolicyDocument", "%v", err)
+		}
+	}
+
+	creds, err := s3sts.NewSession(s3a.iam.sessionSigningKey, &s3sts.SessionClaims{
+		Role:        role.Name,
+		SessionName: sessionName,
+		Policy:      policy,
+	}, duration)
+	if err != nil {
+		glog.Errorf("new session of role %s: %v", role.Name, err)
+		return nil, newStsError(http.StatusInternalServerError, "InternalError", "can not create the session")
+	}
+	return creds, nil
+}
+
+func parseSessionDuration(values url.Values, defaultDuration, maxDuration time.Duration) (time.Duration, *stsError) {
+	durationSeconds := values.Get("DurationSeconds")
+	if durationSeconds == "" {
+		if defaultDuration > maxDuration {
+			return maxDuration, nil
+		}
+		return defaultDuration, nil
+	}
+	seconds, err := strconv.ParseInt(durationSeconds, 10, 64)
+	duration := time.Duration(seconds) * time.Second
+	if err != nil || duration < minSessionDuration || duration > maxDuration {
+		return 0, newStsError(http.StatusBadRequest, "ValidationError", "DurationSeconds should be between %d and %d",
+			int64(minSessionDuration/time.Second), int64(maxDuration/time.Second))
+	}
+	return duration, nil
+}
+
+func toStsCredentials(creds *s3sts.Credentials) StsCredentials {
+	return StsCredentials{
+		AccessKeyId:     creds.AccessKeyId,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		Expiration:      creds.Expiration.Format(sessionCredentialsFormat),
+	}
+}
+
+func assumedRoleUser(role *Role, sessionName string, creds *s3sts.Credentials) AssumedRoleUser {
+	return AssumedRoleUser{
+		Arn:           assumedRoleArnPrefix + role.Name + "/" + sessionName,
+		AssumedRoleId: creds.AccessKeyId + ":" + sessionName,
+	}
+}
+
+func newRequestId() string {
+	return fmt.Sprintf("%d", time.Now().UnixNano())
+}
+
+func writeStsErrorResponse(w http.ResponseWriter, err *stsError) {
+	response := StsErrorResponse{RequestId: newRequestId()}
+	response.Error.Type = "Sender"
+	if err.statusCode >= http.StatusInternalServerError {
+		response.Error.Type = "Receiver"
+	}
+	response.Error.Code = err.code
+	response.Error.Message = err.message
+	writeResponse(w, err.statusCode, encodeResponse(response), mimeXML)
+}
@@ -0,0 +1,100 @@
+package s3api
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssumeRole(t *testing.T) {
+	iam := &IdentityAccessManagement{}
+	assert.NoError(t, iam.loadS3ApiConfiguration(&iam_pb.S3ApiConfiguration{
+		Identities: []*iam_pb.Identity{
+			{Name: "ci", Credentials: []*iam_pb.Credential{{AccessKey: "ci_key", SecretKey: "ci_secret"}}},
+			{Name: "dev", Credentials: []*iam_pb.Credential{{AccessKey: "dev_key", SecretKey: "dev_secret"}}},
+		},
+		Roles: []*iam_pb.Role{
+			{Name: "deployer", Actions: []string{"Read", "Write"}, TrustedIdentities: []string{"ci"}},
+		},
+		SessionSigningKey: "session_secret",
+	}))
+	s3a := &S3ApiServer{iam: iam}
+
+	sts := func(accessKey, secretKey string, values url.Values) *httptest.ResponseRecorder {
+		body := values.Encode()
+		r := httptest.NewRequest("POST", "http://127.0.0.1:8333/", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.Header.Set("X-Amz-Content-Sha256", getSHA256Hash([]byte(body)))
+		assert.NoError(t, signRequestV4(r, accessKey, secretKey))
+		w := httptest.NewRecorder()
+		s3a.StsHandler(w, r)
+		return w
+	}
+
+	assumeRole := url.Values{
+		"Action":          []string{"AssumeRole"},
+		"RoleArn":         []string{"arn:aws:iam::000000000000:role/deployer"},
+		"RoleSessionName": []string{"build-42"},
+		"Policy":          []string{`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::releases/*"}]}`},
+	}
+
+	// not trusted by the role
+	w := sts("dev_key", "dev_secret", assumeRole)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = sts("ci_key", "ci_secret", assumeRole)
+	assert.Equal(t, http.StatusOK, w.Code)
+	response := &AssumeRoleResponse{}
+	assert.NoError(t, xml.Unmarshal(w.Body.Bytes(), response))
+	creds := response.AssumeRoleResult.Credentials
+	assert.Equal(t, "arn:aws:sts:::assumed-role/deployer/build-42", response.AssumeRoleResult.AssumedRoleUser.Arn)
+
+	request := func(method, bucket, object, sessionToken string) *http.Request {
+		r := httptest.NewRequest(method, "http://127.0.0.1:8333/"+bucket+"/"+object, nil)
+		r = mux.SetURLVars(r, map[string]string{"bucket": bucket, "object": object})
+		r.Header.Set("X-Amz-Content-Sha256", emptySHA256)
+		if sessionToken != "" {
+			r.Header.Set(xhttp.AmzSecurityToken, sessionToken)
+		}
+		assert.NoError(t, signRequestV4(r, creds.AccessKeyId, creds.SecretAccessKey))
+		return r
+	}
+
+	identity, errCode := iam.authRequest(request("PUT", "releases", "v1.tar.gz", creds.SessionToken), s3_constants.ACTION_WRITE)
+	assert.Equal(t, s3err.ErrNone, errCode)
+	assert.Equal(t, "deployer", identity.Name)
+
+	// limited by the session policy
+	_, errCode = iam.authRequest(request("PUT", "other", "v1.tar.gz", creds.SessionToken), s3_constants.ACTION_WRITE)
+	assert.Equal(t, s3err.ErrAccessDenied, errCode)
+
+	// without or with a modified session token
+	_, errCode = iam.authRequest(request("GET", "releases", "v1.tar.gz", ""), s3_constants.ACTION_READ)
+	assert.Equal(t, s3err.ErrInvalidAccessKeyID, errCode)
+	_, errCode = iam.authRequest(request("GET", "releases", "v1.tar.gz", creds.SessionToken+"x"), s3_constants.ACTION_READ)
+	assert.Equal(t, s3err.ErrInvalidToken, errCode)
+
+	// the temporary credentials can not get other temporary credentials
+	r := httptest.NewRequest("POST", "http://127.0.0.1:8333/", strings.NewReader("Action=GetSessionToken"))
+	r.Header.Set("X-Amz-Content-Sha256", getSHA256Hash([]byte("Action=GetSessionToken")))
+	r.Header.Set(xhttp.AmzSecurityToken, creds.SessionToken)
+	assert.NoError(t, signRequestV4(r, creds.AccessKeyId, creds.SecretAccessKey))
+	w = httptest.NewRecorder()
+	s3a.StsHandler(w, r)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	// the session ends with the role
+	assert.NoError(t, iam.loadS3ApiConfiguration(&iam_pb.S3ApiConfiguration{SessionSigningKey: "session_secret"}))
+	_, errCode = iam.authRequest(request("GET", "releases", "v1.tar.gz", creds.SessionToken), s3_constants.ACTION_READ)
+	assert.Equal(t, s3err.ErrInvalidToken, errCode)
+}
@@ -0,0 +1,249 @@
+package s3api
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	. "github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/s3api/website"
+	"github.com/gorilla/mux"
+)
+
+// RegisterWebsiteRouter serves the buckets with a website configuration as static web sites,
+// as "{bucket}.{WebsiteDomainName}", or as "/{bucket}/" if the host name does not match.
+// The website endpoint is read only, and the requests are not authenticated: objects are served
+// only if anonymous users can read them, by the anonymous identity, the bucket policy or the acls.
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/userguide/WebsiteHosting.html
+func (s3a *S3ApiServer) RegisterWebsiteRouter(router *mux.Router, port int) {
+
+	type bucketRouter struct {
+		router    *mux.Router
+		pathStyle bool
+	}
+	var routers []bucketRouter
+	if s3a.option.WebsiteDomainName != "" {
+		domainNames := strings.Split(s3a.option.WebsiteDomainName, ",")
+		for _, domainName := range domainNames {
+			routers = append(routers, bucketRouter{router: router.Host(
+				fmt.Sprintf("%s.%s:%d", "{bucket:.+}", domainName, port)).Subrouter()})
+			routers = append(routers, bucketRouter{router: router.Host(
+				fmt.Sprintf("%s.%s", "{bucket:.+}", domainName)).Subrouter()})
+		}
+	}
+	routers = append(routers, bucketRouter{router: router.PathPrefix("/{bucket}").Subrouter(), pathStyle: true})
+
+	for _, bucket := range routers {
+		bucket.router.Methods("GET", "HEAD").Path("/{object:.+}").HandlerFunc(track(s3a.websiteHandler(bucket.pathStyle), "WEBSITE"))
+		bucket.router.Methods("GET", "HEAD").HandlerFunc(track(s3a.websiteHandler(bucket.pathStyle), "WEBSITE"))
+	}
+
+	router.NotFoundHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeWebsiteError(w, r, s3err.ErrMethodNotAllowed, "")
+	})
+
+}
+
+func (s3a *S3ApiServer) websiteHandler(pathStyle bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		bucket, object := getBucketAndObject(r)
+		key := strings.TrimPrefix(object, "/")
+
+		// the keys are relative to the bucket root
+		base := "/"
+		if pathStyle {
+			base = "/" + bucket + "/"
+			if key == "" && !strings.HasSuffix(r.URL.Path, "/") {
+				http.Redirect(w, r, base, http.StatusFound)
+				return
+			}
+		}
+
+		config, errCode := s3a.getWebsiteConfiguration(bucket)
+		if errCode != s3err.ErrNone {
+			writeWebsiteError(w, r, errCode, key)
+			return
+		}
+
+		if redirectAll := config.RedirectAllRequestsTo; redirectAll != nil {
+			location := (&url.URL{Scheme: requestProtocol(r, redirectAll.Protocol), Host: redirectAll.HostName, Path: "/" + key}).String()
+			http.Redirect(w, r, location, http.StatusMovedPermanently)
+			return
+		}
+
+		if rule := config.Route(key, 0); rule != nil {
+			websiteRedirect(w, r, rule, key, base)
+			return
+		}
+
+		target := key
+		if target == "" || strings.HasSuffix(target, "/") {
+			target += config.IndexDocument.Suffix
+		}
+
+		if !s3a.websiteCanRead(r, bucket, "/"+target) {
+			s3a.websiteError(w, r, config, bucket, key, base, s3err.ErrAccessDenied)
+			return
+		}
+
+		entry, err := s3a.getEntry(fmt.Sprintf("%s/%s", s3a.option.BucketsPath, bucket), target)
+		if err != nil {
+			glog.Errorf("website %s/%s: %v", bucket, target, err)
+			writeWebsiteError(w, r, s3err.ErrInternalError, key)
+			return
+		}
+		if entry == nil || entry.IsDirectory || isDeleteMarker(entry) {
+			// "dir" is redirected to "dir/" if it has the index document
+			if key != "" && target == key {
+				index, err := s3a.getEntry(fmt.Sprintf("%s/%s/%s", s3a.option.BucketsPath, bucket, key), config.IndexDocument.Suffix)
+				if err == nil && index != nil && !index.IsDirectory && !isDeleteMarker(index) {
+					http.Redirect(w, r, (&url.URL{Path: base + key + "/"}).String(), http.StatusFound)
+					return
+				}
+			}
+			s3a.websiteError(w, r, config, bucket, key, base, s3err.ErrNoSuchKey)
+			return
+		}
+
+		s3a.proxyToFiler(w, websiteRequest(r), s3a.websiteObjectUrl(bucket, target), passThroughResponse)
+
+	}
+}
+
+func (s3a *S3ApiServer) getWebsiteConfiguration(bucket string) (*website.WebsiteConfiguration, s3err.ErrorCode) {
+	entry, err := s3a.getBucketEntry(bucket)
+	if err != nil {
+		glog.Errorf("website configuration of %s: %v", bucket, err)
+		return nil, s3err.ErrInternalError
+	}
+	if entry == nil {
+		return nil, s3err.ErrNoSuchBucket
+	}
+	data := entry.Extended[xhttp.AmzBucketWebsite]
+	if len(data) == 0 {
+		return nil, s3err.ErrNoSuchWebsiteConfiguration
+	}
+	config, err := website.Parse(data)
+	if err != nil {
+		glog.Errorf("parse website configuration of %s: %v", bucket, err)
+		return nil, s3err.ErrInternalError
+	}
+	return config, s3err.ErrNone
+}
+
+// websiteCanRead checks whether anonymous users can read the object
+func (s3a *S3ApiServer) websiteCanRead(r *http.Request, bucket, object string) bool {
+
+	iam := s3a.iam
+	if !iam.isEnabled() {
+		return true
+	}
+
+	// evaluated as a plain anonymous GetObject request
+	readRequest := r.Clone(r.Context())
+	readRequest.Method = http.MethodGet
+	readRequest.URL.RawQuery = ""
+
+	identity, found := iam.lookupAnonymous()
+	if !found {
+		identity = nil
+	}
+
+	return iam.authorize(readRequest, identity, ACTION_READ, bucket, object) == s3err.ErrNone
+}
+
+// websiteError redirects by the routing rules for the error code, or serves the error document
+func (s3a *S3ApiServer) websiteError(w http.ResponseWriter, r *http.Request, config *website.WebsiteConfiguration, bucket, key, base string, errCode s3err.ErrorCode) {
+
+	status := s3err.GetAPIError(errCode).HTTPStatusCode
+	if rule := config.Route(key, status); rule != nil {
+		websiteRedirect(w, r, rule, key, base)
+		return
+	}
+
+	if config.ErrorDocument == nil || !s3a.websiteCanRead(r, bucket, "/"+config.ErrorDocument.Key) {
+		writeWebsiteError(w, r, errCode, key)
+		return
+	}
+	entry, err := s3a.getEntry(fmt.Sprintf("%s/%s", s3a.option.BucketsPath, bucket), config.ErrorDocument.Key)
+	if err != nil || entry == nil || entry.IsDirectory || isDeleteMarker(entry) {
+		writeWebsiteError(w, r, errCode, key)
+		return
+	}
+
+	// the error document is always returned in full, with the status of the error
+	errorRequest := websiteRequest(r)
+	for _, header := range []string{"Range", "If-Match", "If-None-Match", "If-Modified-Since", "If-Unmodified-Since"} {
+		errorRequest.Header.Del(header)
+	}
+	s3a.proxyToFiler(w, errorRequest, s3a.websiteObjectUrl(bucket, config.ErrorDocument.Key), func(proxyResponse *http.Response, w http.ResponseWriter) {
+		proxyResponse.StatusCode = status
+		passThroughResponse(proxyResponse, w)
+	})
+}
+
+func (s3a *S3ApiServer) websiteObjectUrl(bucket, key string) string {
+	return fmt.Sprintf("http://%s%s/%s%s", s3a.option.Filer, s3a.option.BucketsPath, bucket, urlPathEscape("/"+key))
+}
+
+// websiteRequest drops the headers which the filer would treat as object attributes or the identity
+func websiteRequest(r *http.Request) *http.Request {
+	proxyRequest := r.Clone(r.Context())
+	for header := range proxyRequest.Header {
+		if strings.HasPrefix(header, "Seaweed-") || strings.HasPrefix(strings.ToLower(header), "x-amz-") {
+			proxyRequest.Header.Del(header)
+		}
+	}
+	proxyRequest.Header.Del(xhttp.AmzIdentityId)
+	proxyRequest.Header.Del(xhttp.AmzIsAdmin)
+	proxyRequest.Header.Del("Authorization")
+	return proxyRequest
+}
+
+func websiteRedirect(w http.ResponseWriter, r *http.Request, rule *website.RoutingRule, key, base string) {
+	newKey, code := rule.RedirectKey(key)
+	location := &url.URL{Path: base + newKey}
+	if rule.Redirect.HostName != "" || rule.Redirect.Protocol != "" {
+		location.Scheme = requestProtocol(r, rule.Redirect.Protocol)
+		location.Host = r.Host
+		if rule.Redirect.HostName != "" {
+			location.Host = rule.Redirect.HostName
+			location.Path = "/" + newKey
+		}
+	}
+	http.Redirect(w, r, location.String(), code)
+}
+
+func requestProtocol(r *http.Request, protocol string) string {
+	if protocol != "" {
+		return protocol
+	}
+	if r.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
+
+// writeWebsiteError writes the error as a html page for the browsers, instead of the xml of the S3 api
+func writeWebsiteError(w http.ResponseWriter, r *http.Request, errCode s3err.ErrorCode, key string) {
+	apiError := s3err.GetAPIError(errCode)
+	title := fmt.Sprintf("%d %s", apiError.HTTPStatusCode, http.StatusText(apiError.HTTPStatusCode))
+	body := fmt.Sprintf("<html>\n<head><title>%s</title></head>\n<body>\n<h1>%s</h1>\n<ul>\n<li>Code: %s</li>\n<li>Message: %s</li>\n",
+		title, title, apiError.Code, html.EscapeString(apiError.Description))
+	if key != "" {
+		body += fmt.Sprintf("<li>Key: %s</li>\n", html.EscapeString(key))
+	}
+	body += "</ul>\n</body>\n</html>\n"
+	if r.Method == http.MethodHead {
+		writeResponse(w, apiError.HTTPStatusCode, nil, mimeNone)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	writeResponse(w, apiError.HTTPStatusCode, []byte(body), mimeNone)
+}
@@ -0,0 +1,51 @@
+package s3api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3err"
+	"github.com/chrislusf/seaweedfs/weed/s3api/website"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebsiteRedirect(t *testing.T) {
+
+	prefix := "documents/"
+	rule := &website.RoutingRule{
+		Condition: &website.Condition{KeyPrefixEquals: "docs/"},
+		Redirect:  website.Redirect{ReplaceKeyPrefixWith: &prefix, HttpRedirectCode: "302"},
+	}
+
+	r := httptest.NewRequest("GET", "http://example.com/site/docs/a%20b.html", nil)
+	w := httptest.NewRecorder()
+	websiteRedirect(w, r, rule, "docs/a b.html", "/site/")
+	assert.Equal(t, http.StatusFound, w.Code)
+	assert.Equal(t, "/site/documents/a%20b.html", w.Header().Get("Location"))
+
+	rule.Redirect.HostName = "archive.example.com"
+	rule.Redirect.Protocol = "https"
+	w = httptest.NewRecorder()
+	websiteRedirect(w, r, rule, "docs/a.html", "/site/")
+	assert.Equal(t, "https://archive.example.com/documents/a.html", w.Header().Get("Location"))
+
+}
+
+func TestWriteWebsiteError(t *testing.T) {
+
+	r := httptest.NewRequest("GET", "http://site.example.com/<missing>", nil)
+	w := httptest.NewRecorder()
+	writeWebsiteError(w, r, s3err.ErrNoSuchKey, "<missing>")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "NoSuchKey")
+	assert.Contains(t, w.Body.String(), "&lt;missing&gt;")
+
+	r = httptest.NewRequest("HEAD", "http://site.example.com/<missing>", nil)
+	w = httptest.NewRecorder()
+	writeWebsiteError(w, r, s3err.ErrAccessDenied, "")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, 0, w.Body.Len())
+
+}
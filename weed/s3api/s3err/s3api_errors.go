@@ -51,6 +51,41 @@ const (
 	ErrNoSuchBucket
 	ErrNoSuchKey
 	ErrNoSuchUpload
+	ErrNoSuchVersion
+	ErrNoSuchLifecycleConfiguration
+	ErrNoSuchCORSConfiguration
+	ErrNoSuchWebsiteConfiguration
+	ErrCORSForbidden
+	ErrNoSuchBucketPolicy
+	ErrMalformedPolicy
+	ErrObjectLockConfigurationNotFound
+	ErrNoSuchObjectLockConfiguration
+	ErrObjectLocked
+	ErrInvalidBucketState
+	ErrInvalidRetentionPeriod
+	ErrReservedObjectKey
+	ErrInvalidEncryptionAlgorithm
+	ErrInvalidSseCustomerKey
+	ErrSseCustomerKeyMD5Mismatch
+	ErrSseCustomerKeyRequired
+	ErrSseCustomerKeyMismatch
+	ErrSseNotConfigured
+	ErrInvalidExpressionType
+	ErrInvalidSelectQuery
+	ErrInvalidInputSerialization
+	ErrInvalidCompressionFormat
+	ErrQuotaExceeded
+	ErrSlowDown
+	ErrInvalidNotificationConfiguration
+	ErrInvalidTargetBucketForLogging
+	ErrReplicationConfigurationNotFound
+	ErrInvalidRange
+	ErrInvalidToken
+	ErrExpiredToken
+	ErrMalformedACLError
+	ErrUnsupportedAcl
+	ErrChecksumMismatch
+	ErrInvalidChecksum
 	ErrInvalidBucketName
 	ErrInvalidDigest
 	ErrInvalidMaxKeys
@@ -171,6 +206,181 @@ var errorCodeResponse = map[ErrorCode]APIError{
 		Description:    "The specified multipart upload does not exist. The upload ID may be invalid, or the upload may have been aborted or completed.",
 		HTTPStatusCode: http.StatusNotFound,
 	},
+	ErrNoSuchVersion: {
+		Code:           "NoSuchVersion",
+		Description:    "The specified version does not exist.",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrNoSuchLifecycleConfiguration: {
+		Code:           "NoSuchLifecycleConfiguration",
+		Description:    "The lifecycle configuration does not exist.",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrNoSuchCORSConfiguration: {
+		Code:           "NoSuchCORSConfiguration",
+		Description:    "The CORS configuration does not exist.",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrNoSuchWebsiteConfiguration: {
+		Code:           "NoSuchWebsiteConfiguration",
+		Description:    "The specified bucket does not have a website configuration.",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrNoSuchBucketPolicy: {
+		Code:           "NoSuchBucketPolicy",
+		Description:    "The bucket policy does not exist.",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrMalformedPolicy: {
+		Code:           "MalformedPolicy",
+		Description:    "Policies must be valid JSON and the first byte must be '{'.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrObjectLockConfigurationNotFound: {
+		Code:           "ObjectLockConfigurationNotFoundError",
+		Description:    "Object Lock configuration does not exist for this bucket",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrNoSuchObjectLockConfiguration: {
+		Code:           "NoSuchObjectLockConfiguration",
+		Description:    "The specified object does not have a ObjectLock configuration",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrObjectLocked: {
+		Code:           "AccessDenied",
+		Description:    "Access Denied because object protected by object lock.",
+		HTTPStatusCode: http.StatusForbidden,
+	},
+	ErrInvalidBucketState: {
+		Code:           "InvalidBucketState",
+		Description:    "The request is not valid with the current state of the bucket.",
+		HTTPStatusCode: http.StatusConflict,
+	},
+	ErrInvalidRetentionPeriod: {
+		Code:           "InvalidArgument",
+		Description:    "The object lock retention is not valid.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrReservedObjectKey: {
+		Code:           "InvalidArgument",
+		Description:    "The object keys under .versions/ are reserved for the object versions.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrInvalidEncryptionAlgorithm: {
+		Code:           "InvalidEncryptionAlgorithmError",
+		Description:    "The encryption request you specified is not valid. The valid value is AES256.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrInvalidSseCustomerKey: {
+		Code:           "InvalidArgument",
+		Description:    "The secret key was invalid for the specified algorithm.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrSseCustomerKeyMD5Mismatch: {
+		Code:           "InvalidArgument",
+		Description:    "The calculated MD5 hash of the key did not match the hash that was provided.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrSseCustomerKeyRequired: {
+		Code:           "InvalidRequest",
+		Description:    "The object was stored using a form of Server Side Encryption. The correct parameters must be provided to retrieve the object.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrSseCustomerKeyMismatch: {
+		Code:           "AccessDenied",
+		Description:    "The provided encryption key does not match the key used to encrypt the object.",
+		HTTPStatusCode: http.StatusForbidden,
+	},
+	ErrSseNotConfigured: {
+		Code:           "NotImplemented",
+		Description:    "Server side encryption with the S3 managed key needs a key management service configured on the filer.",
+		HTTPStatusCode: http.StatusNotImplemented,
+	},
+	ErrInvalidExpressionType: {
+		Code:           "InvalidExpressionType",
+		Description:    "The ExpressionType is invalid. Only SQL expressions are supported.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrInvalidSelectQuery: {
+		Code:           "ParseSelectFailure",
+		Description:    "The SQL expression is invalid or not supported.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrInvalidInputSerialization: {
+		Code:           "InvalidDataSource",
+		Description:    "The input serialization is invalid. Only CSV and JSON inputs are supported.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrInvalidCompressionFormat: {
+		Code:           "InvalidCompressionFormat",
+		Description:    "The file is not in a supported compression format. Only GZIP and BZIP2 are supported.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrQuotaExceeded: {
+		Code:           "QuotaExceeded",
+		Description:    "The upload exceeds the storage quota of the bucket or the user.",
+		HTTPStatusCode: http.StatusForbidden,
+	},
+	ErrSlowDown: {
+		Code:           "SlowDown",
+		Description:    "Please reduce your request rate.",
+		HTTPStatusCode: http.StatusServiceUnavailable,
+	},
+	ErrInvalidNotificationConfiguration: {
+		Code:           "InvalidArgument",
+		Description:    "Unable to validate the destination configurations, or the events and filter rules are not supported.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrInvalidTargetBucketForLogging: {
+		Code:           "InvalidTargetBucketForLogging",
+		Description:    "The target bucket for logging does not exist, or is not owned by you.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrReplicationConfigurationNotFound: {
+		Code:           "ReplicationConfigurationNotFoundError",
+		Description:    "The replication configuration was not found.",
+		HTTPStatusCode: http.StatusNotFound,
+	},
+	ErrInvalidRange: {
+		Code:           "InvalidRange",
+		Description:    "The requested range is not satisfiable",
+		HTTPStatusCode: http.StatusRequestedRangeNotSatisfiable,
+	},
+	ErrInvalidToken: {
+		Code:           "InvalidToken",
+		Description:    "The provided token is malformed or otherwise invalid.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrExpiredToken: {
+		Code:           "ExpiredToken",
+		Description:    "The provided token has expired.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrMalformedACLError: {
+		Code:           "MalformedACLError",
+		Description:    "The XML you provided was not well-formed or did not validate against our published schema.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrUnsupportedAcl: {
+		Code:           "InvalidArgument",
+		Description:    "The canned ACL or the grantee is not supported, or both the canned ACL and the grant headers are specified.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrChecksumMismatch: {
+		Code:           "BadDigest",
+		Description:    "The checksum you specified did not match the calculated checksum.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrInvalidChecksum: {
+		Code:           "InvalidRequest",
+		Description:    "The x-amz-checksum header should be one base64 encoded CRC32, CRC32C, SHA1 or SHA256 checksum.",
+		HTTPStatusCode: http.StatusBadRequest,
+	},
+	ErrCORSForbidden: {
+		Code:           "AccessForbidden",
+		Description:    "CORSResponse: This CORS request is not allowed. The Origin, request method or request headers are not allowed by the bucket CORS configuration.",
+		HTTPStatusCode: http.StatusForbidden,
+	},
 	ErrInternalError: {
 		Code:           "InternalError",
 		Description:    "We encountered an internal error, please try again.",
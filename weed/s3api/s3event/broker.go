@@ -0,0 +1,71 @@
+package s3event
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/messaging/msgclient"
+	"github.com/chrislusf/seaweedfs/weed/pb/messaging_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	Targets = append(Targets, &BrokerTarget{})
+}
+
+// BrokerTarget publishes each event to a topic of the SeaweedFS message broker
+type BrokerTarget struct {
+	sync.Mutex
+	client    *msgclient.MessagingClient
+	namespace string
+	topic     string
+	publisher *msgclient.Publisher
+}
+
+func (t *BrokerTarget) GetName() string {
+	return "broker"
+}
+
+func (t *BrokerTarget) Initialize(configuration util.Configuration, prefix string) (err error) {
+	glog.V(0).Infof("s3 event brokers: %v", configuration.GetStringSlice(prefix+"brokers"))
+	brokers := configuration.GetStringSlice(prefix + "brokers")
+	if len(brokers) == 0 {
+		return fmt.Errorf("missing %sbrokers", prefix)
+	}
+	configuration.SetDefault(prefix+"namespace", "s3")
+	configuration.SetDefault(prefix+"topic", "bucket_events")
+	t.namespace = configuration.GetString(prefix + "namespace")
+	t.topic = configuration.GetString(prefix + "topic")
+	t.client = msgclient.NewMessagingClient(brokers...)
+	return nil
+}
+
+func (t *BrokerTarget) Send(key string, event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	if t.publisher == nil {
+		if t.publisher, err = t.client.NewPublisher("s3event", t.namespace, t.topic); err != nil {
+			return fmt.Errorf("publish to %s/%s: %v", t.namespace, t.topic, err)
+		}
+	}
+	err = t.publisher.Publish(&messaging_pb.Message{
+		EventTimeNs: time.Now().UnixNano(),
+		Key:         []byte(key),
+		Value:       data,
+	})
+	if err != nil {
+		// connect again for the retry
+		t.publisher = nil
+		return fmt.Errorf("publish to %s/%s: %v", t.namespace, t.topic, err)
+	}
+	return nil
+}
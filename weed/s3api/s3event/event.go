@@ -0,0 +1,103 @@
+package s3event
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Event is the S3 event message, in the same JSON format as AWS S3.
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html
+type Event struct {
+	Records []Record `json:"Records"`
+}
+
+type Record struct {
+	EventVersion      string            `json:"eventVersion"`
+	EventSource       string            `json:"eventSource"`
+	AwsRegion         string            `json:"awsRegion"`
+	EventTime         string            `json:"eventTime"`
+	EventName         string            `json:"eventName"`
+	UserIdentity      Identity          `json:"userIdentity"`
+	RequestParameters map[string]string `json:"requestParameters"`
+	ResponseElements  map[string]string `json:"responseElements"`
+	S3                Entity            `json:"s3"`
+}
+
+type Identity struct {
+	PrincipalId string `json:"principalId"`
+}
+
+type Entity struct {
+	SchemaVersion   string `json:"s3SchemaVersion"`
+	ConfigurationId string `json:"configurationId"`
+	Bucket          Bucket `json:"bucket"`
+	Object          Object `json:"object"`
+}
+
+type Bucket struct {
+	Name          string   `json:"name"`
+	OwnerIdentity Identity `json:"ownerIdentity"`
+	Arn           string   `json:"arn"`
+}
+
+type Object struct {
+	Key       string `json:"key"`
+	Size      int64  `json:"size,omitempty"`
+	ETag      string `json:"eTag,omitempty"`
+	VersionId string `json:"versionId,omitempty"`
+	Sequencer string `json:"sequencer"`
+}
+
+// ObjectChange is one object change to notify
+type ObjectChange struct {
+	EventName string
+	Bucket    string
+	Owner     string
+	Key       string
+	Size      int64
+	ETag      string
+	VersionId string
+	TsNs      int64
+}
+
+// NewEvent creates the event of an object change for one destination
+func NewEvent(change *ObjectChange, destination Destination) *Event {
+	return &Event{
+		Records: []Record{
+			{
+				EventVersion:      "2.1",
+				EventSource:       "aws:s3",
+				AwsRegion:         "",
+				EventTime:         time.Unix(0, change.TsNs).UTC().Format("2006-01-02T15:04:05.000Z"),
+				EventName:         strings.TrimPrefix(change.EventName, "s3:"),
+				UserIdentity:      Identity{PrincipalId: change.Owner},
+				RequestParameters: map[string]string{},
+				ResponseElements:  map[string]string{},
+				S3: Entity{
+					SchemaVersion:   "1.0",
+					ConfigurationId: destination.ConfigurationId,
+					Bucket: Bucket{
+						Name:          change.Bucket,
+						OwnerIdentity: Identity{PrincipalId: change.Owner},
+						Arn:           "arn:aws:s3:::" + change.Bucket,
+					},
+					Object: Object{
+						Key:       encodeKey(change.Key),
+						Size:      change.Size,
+						ETag:      change.ETag,
+						VersionId: change.VersionId,
+						// the events of one key can be ordered by the sequencer
+						Sequencer: fmt.Sprintf("%016X", change.TsNs),
+					},
+				},
+			},
+		},
+	}
+}
+
+// encodeKey encodes the object key the same way as AWS S3, which keeps the "/"
+func encodeKey(key string) string {
+	return strings.ReplaceAll(url.QueryEscape(key), "%2F", "/")
+}
@@ -0,0 +1,202 @@
+package s3event
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// NotificationConfiguration is the S3 bucket event notification configuration.
+// The destinations are the targets configured in notification.toml, addressed by ARN,
+// e.g. arn:seaweed:sqs:::webhook
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketNotificationConfiguration.html
+type NotificationConfiguration struct {
+	XMLName                     xml.Name                     `xml:"http://s3.amazonaws.com/doc/2006-03-01/ NotificationConfiguration"`
+	TopicConfigurations         []TopicConfiguration         `xml:"TopicConfiguration,omitempty"`
+	QueueConfigurations         []QueueConfiguration         `xml:"QueueConfiguration,omitempty"`
+	CloudFunctionConfigurations []CloudFunctionConfiguration `xml:"CloudFunctionConfiguration,omitempty"`
+}
+
+// Rule is the part shared by the topic, queue, and cloud function configurations
+type Rule struct {
+	Id     string   `xml:"Id,omitempty"`
+	Events []string `xml:"Event"`
+	Filter *Filter  `xml:"Filter,omitempty"`
+}
+
+type TopicConfiguration struct {
+	Rule
+	Arn string `xml:"Topic"`
+}
+
+type QueueConfiguration struct {
+	Rule
+	Arn string `xml:"Queue"`
+}
+
+type CloudFunctionConfiguration struct {
+	Rule
+	Arn string `xml:"CloudFunction"`
+}
+
+type Filter struct {
+	FilterRules []FilterRule `xml:"S3Key>FilterRule"`
+}
+
+type FilterRule struct {
+	Name  string `xml:"Name"`
+	Value string `xml:"Value"`
+}
+
+// Destination is where a matched event goes
+type Destination struct {
+	ConfigurationId string
+	Arn             string
+}
+
+const (
+	ObjectCreatedPut                     = "s3:ObjectCreated:Put"
+	ObjectCreatedPost                    = "s3:ObjectCreated:Post"
+	ObjectCreatedCopy                    = "s3:ObjectCreated:Copy"
+	ObjectCreatedCompleteMultipartUpload = "s3:ObjectCreated:CompleteMultipartUpload"
+	ObjectRemovedDelete                  = "s3:ObjectRemoved:Delete"
+	ObjectRemovedDeleteMarkerCreated     = "s3:ObjectRemoved:DeleteMarkerCreated"
+
+	arnPrefix = "arn:seaweed:sqs:::"
+)
+
+var (
+	ErrNoEvent           = errors.New("notification configuration must have at least one event")
+	ErrInvalidEvent      = errors.New("notification configuration has an unsupported event")
+	ErrInvalidFilterRule = errors.New("filter rule name must be prefix or suffix, and used at most once")
+	ErrInvalidArn        = errors.New("notification destination must be a configured target, e.g. arn:seaweed:sqs:::webhook")
+	supportedEvents      = map[string]bool{
+		"s3:ObjectCreated:*":                 true,
+		ObjectCreatedPut:                     true,
+		ObjectCreatedPost:                    true,
+		ObjectCreatedCopy:                    true,
+		ObjectCreatedCompleteMultipartUpload: true,
+		"s3:ObjectRemoved:*":                 true,
+		ObjectRemovedDelete:                  true,
+		ObjectRemovedDeleteMarkerCreated:     true,
+	}
+)
+
+// TargetArn returns the ARN to address a target in the bucket notification configurations
+func TargetArn(targetName string) string {
+	return arnPrefix + targetName
+}
+
+// TargetName returns the target name in the ARN
+func TargetName(arn string) string {
+	if !strings.HasPrefix(arn, arnPrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(arn, arnPrefix)
+}
+
+func Parse(data []byte) (*NotificationConfiguration, error) {
+	c := &NotificationConfiguration{}
+	if err := xml.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// destinations lists the rules in all the configurations with their ARNs
+func (c *NotificationConfiguration) destinations(fn func(rule *Rule, arn string) error) error {
+	for i := range c.TopicConfigurations {
+		if err := fn(&c.TopicConfigurations[i].Rule, c.TopicConfigurations[i].Arn); err != nil {
+			return err
+		}
+	}
+	for i := range c.QueueConfigurations {
+		if err := fn(&c.QueueConfigurations[i].Rule, c.QueueConfigurations[i].Arn); err != nil {
+			return err
+		}
+	}
+	for i := range c.CloudFunctionConfigurations {
+		if err := fn(&c.CloudFunctionConfigurations[i].Rule, c.CloudFunctionConfigurations[i].Arn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the events, the filters, and that the ARNs are for known targets
+func (c *NotificationConfiguration) Validate() error {
+	return c.destinations(func(rule *Rule, arn string) error {
+		if findTarget(TargetName(arn)) == nil {
+			return fmt.Errorf("%w: %s", ErrInvalidArn, arn)
+		}
+		return rule.Validate()
+	})
+}
+
+func (r *Rule) Validate() error {
+	if len(r.Events) == 0 {
+		return ErrNoEvent
+	}
+	for _, event := range r.Events {
+		if !supportedEvents[event] {
+			return fmt.Errorf("%w: %s", ErrInvalidEvent, event)
+		}
+	}
+	if r.Filter != nil {
+		names := make(map[string]bool)
+		for _, filterRule := range r.Filter.FilterRules {
+			name := strings.ToLower(filterRule.Name)
+			if name != "prefix" && name != "suffix" || names[name] {
+				return ErrInvalidFilterRule
+			}
+			names[name] = true
+		}
+	}
+	return nil
+}
+
+// Match returns the destinations which want the event of the object key
+func (c *NotificationConfiguration) Match(eventName, key string) (destinations []Destination) {
+	c.destinations(func(rule *Rule, arn string) error {
+		if rule.matchEvent(eventName) && rule.matchKey(key) {
+			destinations = append(destinations, Destination{
+				ConfigurationId: rule.Id,
+				Arn:             arn,
+			})
+		}
+		return nil
+	})
+	return
+}
+
+func (r *Rule) matchEvent(eventName string) bool {
+	for _, event := range r.Events {
+		if event == eventName {
+			return true
+		}
+		if strings.HasSuffix(event, ":*") && strings.HasPrefix(eventName, strings.TrimSuffix(event, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Rule) matchKey(key string) bool {
+	if r.Filter == nil {
+		return true
+	}
+	for _, filterRule := range r.Filter.FilterRules {
+		switch strings.ToLower(filterRule.Name) {
+		case "prefix":
+			if !strings.HasPrefix(key, filterRule.Value) {
+				return false
+			}
+		case "suffix":
+			if !strings.HasSuffix(key, filterRule.Value) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package s3event
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAndMatch(t *testing.T) {
+	data := `<NotificationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <QueueConfiguration>
+    <Id>images</Id>
+    <Queue>arn:seaweed:sqs:::webhook</Queue>
+    <Event>s3:ObjectCreated:*</Event>
+    <Filter>
+      <S3Key>
+        <FilterRule><Name>prefix</Name><Value>images/</Value></FilterRule>
+        <FilterRule><Name>suffix</Name><Value>.jpg</Value></FilterRule>
+      </S3Key>
+    </Filter>
+  </QueueConfiguration>
+  <TopicConfiguration>
+    <Id>removals</Id>
+    <Topic>arn:seaweed:sqs:::broker</Topic>
+    <Event>s3:ObjectRemoved:Delete</Event>
+  </TopicConfiguration>
+</NotificationConfiguration>`
+
+	config, err := Parse([]byte(data))
+	assert.NoError(t, err)
+	assert.NoError(t, config.Validate())
+
+	assert.Equal(t, []Destination{{ConfigurationId: "images", Arn: "arn:seaweed:sqs:::webhook"}},
+		config.Match(ObjectCreatedPut, "images/a.jpg"))
+	assert.Nil(t, config.Match(ObjectCreatedPut, "images/a.png"))
+	assert.Nil(t, config.Match(ObjectCreatedPut, "docs/a.jpg"))
+	assert.Equal(t, []Destination{{ConfigurationId: "removals", Arn: "arn:seaweed:sqs:::broker"}},
+		config.Match(ObjectRemovedDelete, "images/a.jpg"))
+	assert.Nil(t, config.Match(ObjectRemovedDeleteMarkerCreated, "images/a.jpg"))
+}
+
+func TestValidate(t *testing.T) {
+	invalid := []struct {
+		config *NotificationConfiguration
+		err    error
+	}{
+		{&NotificationConfiguration{QueueConfigurations: []QueueConfiguration{{Arn: "arn:aws:sqs:us-east-1:123:q", Rule: Rule{Events: []string{ObjectCreatedPut}}}}}, ErrInvalidArn},
+		{&NotificationConfiguration{QueueConfigurations: []QueueConfiguration{{Arn: TargetArn("webhook")}}}, ErrNoEvent},
+		{&NotificationConfiguration{QueueConfigurations: []QueueConfiguration{{Arn: TargetArn("webhook"), Rule: Rule{Events: []string{"s3:ObjectAccessed:Get"}}}}}, ErrInvalidEvent},
+		{&NotificationConfiguration{QueueConfigurations: []QueueConfiguration{{Arn: TargetArn("webhook"), Rule: Rule{
+			Events: []string{ObjectCreatedPut},
+			Filter: &Filter{FilterRules: []FilterRule{{Name: "prefix", Value: "a"}, {Name: "Prefix", Value: "b"}}},
+		}}}}, ErrInvalidFilterRule},
+	}
+	for _, tt := range invalid {
+		assert.True(t, errors.Is(tt.config.Validate(), tt.err), tt.err.Error())
+	}
+
+	// an empty configuration turns off the notifications
+	assert.NoError(t, (&NotificationConfiguration{}).Validate())
+}
+
+func TestNewEvent(t *testing.T) {
+	event := NewEvent(&ObjectChange{
+		EventName: ObjectCreatedPut,
+		Bucket:    "photos",
+		Key:       "2021/my photo.jpg",
+		Size:      1024,
+		ETag:      "abc",
+		TsNs:      1617000000123456789,
+	}, Destination{ConfigurationId: "images", Arn: TargetArn("webhook")})
+
+	data, err := json.Marshal(event)
+	assert.NoError(t, err)
+
+	var decoded map[string][]map[string]interface{}
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	record := decoded["Records"][0]
+	assert.Equal(t, "ObjectCreated:Put", record["eventName"])
+	assert.Equal(t, "2021-03-29T06:40:00.123Z", record["eventTime"])
+	s3 := record["s3"].(map[string]interface{})
+	assert.Equal(t, "images", s3["configurationId"])
+	object := s3["object"].(map[string]interface{})
+	assert.Equal(t, "2021/my+photo.jpg", object["key"])
+	assert.Equal(t, float64(1024), object["size"])
+}
@@ -0,0 +1,103 @@
+package s3event
+
+import (
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// Target receives the S3 events of the buckets with matching notification configurations
+type Target interface {
+	// GetName gets the name to locate the configuration in notification.toml file, also used in the target ARN
+	GetName() string
+	// Initialize initializes the target
+	Initialize(configuration util.Configuration, prefix string) error
+	// Send sends one event, keyed by the bucket and the object key
+	Send(key string, event *Event) error
+}
+
+var (
+	Targets []Target
+)
+
+func findTarget(name string) Target {
+	for _, target := range Targets {
+		if target.GetName() == name {
+			return target
+		}
+	}
+	return nil
+}
+
+// LoadConfiguration returns the queues of the enabled targets, keyed by the target ARN
+func LoadConfiguration(config *util.ViperProxy, prefix string) map[string]*Queue {
+
+	queues := make(map[string]*Queue)
+	if config == nil {
+		return queues
+	}
+
+	for _, target := range Targets {
+		targetPrefix := prefix + target.GetName() + "."
+		if !config.GetBool(targetPrefix + "enabled") {
+			continue
+		}
+		if err := target.Initialize(config, targetPrefix); err != nil {
+			glog.Fatalf("Failed to initialize s3 event target %s: %+v", target.GetName(), err)
+		}
+		config.SetDefault(targetPrefix+"max_retries", 5)
+		queues[TargetArn(target.GetName())] = NewQueue(target, config.GetInt(targetPrefix+"max_retries"))
+		glog.V(0).Infof("Configure s3 event target %s", target.GetName())
+	}
+
+	return queues
+}
+
+type queuedEvent struct {
+	key   string
+	event *Event
+}
+
+// Queue sends the events to one target in the background, retrying the failed ones with backoff.
+// A slow target only holds up its own events, until the queue is full.
+type Queue struct {
+	target     Target
+	maxRetries int
+	events     chan queuedEvent
+}
+
+func NewQueue(target Target, maxRetries int) *Queue {
+	q := &Queue{
+		target:     target,
+		maxRetries: maxRetries,
+		events:     make(chan queuedEvent, 1024),
+	}
+	go q.loopSending()
+	return q
+}
+
+func (q *Queue) Add(key string, event *Event) {
+	q.events <- queuedEvent{key: key, event: event}
+}
+
+func (q *Queue) loopSending() {
+	for e := range q.events {
+		backoff := time.Second
+		for retry := 0; ; retry++ {
+			err := q.target.Send(e.key, e.event)
+			if err == nil {
+				break
+			}
+			if retry >= q.maxRetries {
+				glog.Errorf("drop s3 event of %s to %s after %d retries: %v", e.key, q.target.GetName(), retry, err)
+				break
+			}
+			glog.V(1).Infof("send s3 event of %s to %s: %v", e.key, q.target.GetName(), err)
+			time.Sleep(backoff)
+			if backoff < time.Minute {
+				backoff *= 2
+			}
+		}
+	}
+}
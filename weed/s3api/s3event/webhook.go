@@ -0,0 +1,67 @@
+package s3event
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	Targets = append(Targets, &WebhookTarget{})
+}
+
+// WebhookTarget posts each event as JSON to an HTTP endpoint
+type WebhookTarget struct {
+	endpoint  string
+	authToken string
+	client    *http.Client
+}
+
+func (t *WebhookTarget) GetName() string {
+	return "webhook"
+}
+
+func (t *WebhookTarget) Initialize(configuration util.Configuration, prefix string) (err error) {
+	glog.V(0).Infof("s3 event webhook endpoint: %v", configuration.GetString(prefix+"endpoint"))
+	t.endpoint = configuration.GetString(prefix + "endpoint")
+	t.authToken = configuration.GetString(prefix + "auth_token")
+	if t.endpoint == "" {
+		return fmt.Errorf("missing %sendpoint", prefix)
+	}
+	t.client = &http.Client{Timeout: 30 * time.Second}
+	return nil
+}
+
+func (t *WebhookTarget) Send(key string, event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, t.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if t.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+t.authToken)
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("post %s: %s", t.endpoint, resp.Status)
+	}
+	return nil
+}
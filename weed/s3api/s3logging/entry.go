@@ -0,0 +1,171 @@
+package s3logging
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Entry is one request in the server access log.
+// Log format reference: https://docs.aws.amazon.com/AmazonS3/latest/userguide/LogFormat.html
+type Entry struct {
+	BucketOwner        string
+	Bucket             string
+	Time               time.Time
+	RemoteIP           string
+	Requester          string
+	RequestID          string
+	Operation          string
+	Key                string
+	RequestURI         string
+	HTTPStatus         int
+	ErrorCode          string
+	BytesSent          int64
+	ObjectSize         int64 // -1 if unknown
+	TotalTime          time.Duration
+	TurnAroundTime     time.Duration
+	Referer            string
+	UserAgent          string
+	VersionId          string
+	SignatureVersion   string
+	CipherSuite        string
+	AuthenticationType string
+	HostHeader         string
+	TLSVersion         string
+}
+
+const timeFormat = "02/Jan/2006:15:04:05 -0700"
+
+// String formats the entry as one line of the log, with "-" for the unknown fields
+func (e *Entry) String() string {
+	var objectSize string
+	if e.ObjectSize >= 0 {
+		objectSize = strconv.FormatInt(e.ObjectSize, 10)
+	}
+	var bytesSent string
+	if e.BytesSent > 0 {
+		bytesSent = strconv.FormatInt(e.BytesSent, 10)
+	}
+	var key string
+	if e.Key != "" {
+		key = (&url.URL{Path: e.Key}).EscapedPath()
+	}
+	fields := []string{
+		field(e.BucketOwner),
+		field(e.Bucket),
+		"[" + e.Time.UTC().Format(timeFormat) + "]",
+		field(e.RemoteIP),
+		field(e.Requester),
+		field(e.RequestID),
+		field(e.Operation),
+		field(key),
+		quoted(e.RequestURI),
+		field(strconv.Itoa(e.HTTPStatus)),
+		field(e.ErrorCode),
+		field(bytesSent),
+		field(objectSize),
+		field(strconv.FormatInt(int64(e.TotalTime/time.Millisecond), 10)),
+		field(strconv.FormatInt(int64(e.TurnAroundTime/time.Millisecond), 10)),
+		quoted(e.Referer),
+		quoted(e.UserAgent),
+		field(e.VersionId),
+		"-", // host id
+		field(e.SignatureVersion),
+		field(e.CipherSuite),
+		field(e.AuthenticationType),
+		field(e.HostHeader),
+		field(e.TLSVersion),
+		"-", // access point arn
+		"-", // acl required
+	}
+	return strings.Join(fields, " ")
+}
+
+func field(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '\n' || r == '\r' {
+			return '+'
+		}
+		return r
+	}, s)
+}
+
+func quoted(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return strconv.Quote(s)
+}
+
+// ObjectKey names a log object, as the prefix followed by the time and a unique string
+func ObjectKey(prefix string, t time.Time, unique uint64) string {
+	return fmt.Sprintf("%s%s-%016X", prefix, t.UTC().Format("2006-01-02-15-04-05"), unique)
+}
+
+// Operation names the request as REST.<method>.<resource type>, e.g. REST.GET.OBJECT or REST.PUT.ACL
+func Operation(r *http.Request, object string) string {
+	query := r.URL.Query()
+	has := func(name string) bool {
+		_, found := query[name]
+		return found
+	}
+
+	method := r.Method
+	if method == http.MethodPut && r.Header.Get("X-Amz-Copy-Source") != "" && !has("partNumber") {
+		method = "COPY"
+	}
+
+	var resource string
+	switch {
+	case has("acl"):
+		resource = "ACL"
+	case has("tagging"):
+		resource = "TAGGING"
+		if object != "" {
+			resource = "OBJECT_TAGGING"
+		}
+	case has("uploads"):
+		resource = "UPLOADS"
+	case has("partNumber"):
+		resource = "PART"
+	case has("uploadId"):
+		resource = "UPLOAD"
+	case has("retention"):
+		resource = "RETENTION"
+	case has("legal-hold"):
+		resource = "LEGAL_HOLD"
+	case has("select"):
+		resource = "SELECT"
+	case object != "":
+		resource = "OBJECT"
+	case has("versioning"):
+		resource = "VERSIONING"
+	case has("versions"):
+		resource = "VERSIONS"
+	case has("lifecycle"):
+		resource = "LIFECYCLE"
+	case has("cors"):
+		resource = "CORS"
+	case has("policy"):
+		resource = "BUCKETPOLICY"
+	case has("notification"):
+		resource = "NOTIFICATION"
+	case has("website"):
+		resource = "WEBSITE"
+	case has("logging"):
+		resource = "LOGGING_STATUS"
+	case has("object-lock"):
+		resource = "OBJECT_LOCK_CONFIGURATION"
+	case has("delete"):
+		resource = "MULTI_OBJECT_DELETE"
+	default:
+		resource = "BUCKET"
+	}
+	return "REST." + method + "." + resource
+}
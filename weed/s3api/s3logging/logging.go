@@ -0,0 +1,57 @@
+package s3logging
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+)
+
+// BucketLoggingStatus is the S3 server access logging configuration of a bucket.
+// Without LoggingEnabled, the access logging of the bucket is turned off.
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketLogging.html
+type BucketLoggingStatus struct {
+	XMLName        xml.Name        `xml:"http://s3.amazonaws.com/doc/2006-03-01/ BucketLoggingStatus"`
+	LoggingEnabled *LoggingEnabled `xml:"LoggingEnabled,omitempty"`
+}
+
+// LoggingEnabled writes the access logs of the bucket as objects with the TargetPrefix into the TargetBucket
+type LoggingEnabled struct {
+	TargetBucket string `xml:"TargetBucket"`
+	TargetPrefix string `xml:"TargetPrefix"`
+}
+
+const maxTargetPrefixLength = 1024
+
+var (
+	ErrNoTargetBucket      = errors.New("LoggingEnabled must have a TargetBucket")
+	ErrInvalidTargetBucket = errors.New("TargetBucket must not contain a slash")
+	ErrInvalidTargetPrefix = errors.New("TargetPrefix must not start with a slash")
+	ErrTargetPrefixTooLong = errors.New("TargetPrefix must not be longer than 1024 characters")
+)
+
+func Parse(data []byte) (*BucketLoggingStatus, error) {
+	s := &BucketLoggingStatus{}
+	if err := xml.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *BucketLoggingStatus) Validate() error {
+	if s.LoggingEnabled == nil {
+		return nil
+	}
+	if s.LoggingEnabled.TargetBucket == "" {
+		return ErrNoTargetBucket
+	}
+	if strings.Contains(s.LoggingEnabled.TargetBucket, "/") {
+		return ErrInvalidTargetBucket
+	}
+	if strings.HasPrefix(s.LoggingEnabled.TargetPrefix, "/") {
+		return ErrInvalidTargetPrefix
+	}
+	if len(s.LoggingEnabled.TargetPrefix) > maxTargetPrefixLength {
+		return ErrTargetPrefixTooLong
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package s3logging
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAndValidate(t *testing.T) {
+
+	input := `<BucketLoggingStatus xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <LoggingEnabled>
+    <TargetBucket>logs</TargetBucket>
+    <TargetPrefix>photos/</TargetPrefix>
+  </LoggingEnabled>
+</BucketLoggingStatus>`
+
+	s, err := Parse([]byte(input))
+	assert.Nil(t, err)
+	assert.Nil(t, s.Validate())
+	assert.Equal(t, "logs", s.LoggingEnabled.TargetBucket)
+	assert.Equal(t, "photos/", s.LoggingEnabled.TargetPrefix)
+
+	s.LoggingEnabled.TargetPrefix = "/photos/"
+	assert.Equal(t, ErrInvalidTargetPrefix, s.Validate())
+
+	s.LoggingEnabled.TargetBucket = ""
+	assert.Equal(t, ErrNoTargetBucket, s.Validate())
+
+	s, err = Parse([]byte(`<BucketLoggingStatus xmlns="http://s3.amazonaws.com/doc/2006-03-01/" />`))
+	assert.Nil(t, err)
+	assert.Nil(t, s.Validate())
+	assert.Nil(t, s.LoggingEnabled)
+
+}
+
+func TestEntryString(t *testing.T) {
+
+	e := &Entry{
+		BucketOwner:        "admin",
+		Bucket:             "photos",
+		Time:               time.Date(2019, 2, 6, 0, 0, 38, 0, time.UTC),
+		RemoteIP:           "192.0.2.3",
+		Requester:          "alice",
+		RequestID:          "1549411238000000000",
+		Operation:          "REST.GET.OBJECT",
+		Key:                "2019/puppy dog.jpg",
+		RequestURI:         "GET /photos/2019/puppy%20dog.jpg HTTP/1.1",
+		HTTPStatus:         200,
+		BytesSent:          2662992,
+		ObjectSize:         3462992,
+		TotalTime:          70 * time.Millisecond,
+		TurnAroundTime:     10 * time.Millisecond,
+		UserAgent:          "aws-cli/1.16",
+		SignatureVersion:   "SigV4",
+		AuthenticationType: "AuthHeader",
+		HostHeader:         "localhost:8333",
+	}
+
+	assert.Equal(t, `admin photos [06/Feb/2019:00:00:38 +0000] 192.0.2.3 alice 1549411238000000000 REST.GET.OBJECT 2019/puppy%20dog.jpg "GET /photos/2019/puppy%20dog.jpg HTTP/1.1" 200 - 2662992 3462992 70 10 - "aws-cli/1.16" - - SigV4 - AuthHeader localhost:8333 - - -`, e.String())
+
+	assert.Equal(t, "logs/2019-02-06-00-00-38-00000000000000FF", ObjectKey("logs/", e.Time, 255))
+
+}
+
+func TestOperation(t *testing.T) {
+
+	operation := func(method, target, object string) string {
+		return Operation(httptest.NewRequest(method, target, nil), object)
+	}
+
+	assert.Equal(t, "REST.GET.OBJECT", operation("GET", "/photos/a.jpg", "/a.jpg"))
+	assert.Equal(t, "REST.HEAD.BUCKET", operation("HEAD", "/photos", ""))
+	assert.Equal(t, "REST.GET.BUCKET", operation("GET", "/photos?list-type=2", ""))
+	assert.Equal(t, "REST.PUT.ACL", operation("PUT", "/photos/a.jpg?acl", "/a.jpg"))
+	assert.Equal(t, "REST.GET.OBJECT_TAGGING", operation("GET", "/photos/a.jpg?tagging", "/a.jpg"))
+	assert.Equal(t, "REST.POST.UPLOADS", operation("POST", "/photos/a.jpg?uploads", "/a.jpg"))
+	assert.Equal(t, "REST.PUT.PART", operation("PUT", "/photos/a.jpg?partNumber=1&uploadId=x", "/a.jpg"))
+	assert.Equal(t, "REST.POST.UPLOAD", operation("POST", "/photos/a.jpg?uploadId=x", "/a.jpg"))
+	assert.Equal(t, "REST.POST.MULTI_OBJECT_DELETE", operation("POST", "/photos?delete", ""))
+	assert.Equal(t, "REST.GET.LOGGING_STATUS", operation("GET", "/photos?logging", ""))
+
+	r := httptest.NewRequest("PUT", "/photos/b.jpg", strings.NewReader(""))
+	r.Header.Set("X-Amz-Copy-Source", "/photos/a.jpg")
+	assert.Equal(t, "REST.COPY.OBJECT", Operation(r, "/b.jpg"))
+
+}
@@ -0,0 +1,163 @@
+// Package s3presign generates the presigned urls verified by the s3 api server.
+// It is kept apart from the s3api package so that the shell and the iam api can use it.
+package s3presign
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	signV4Algorithm = "AWS4-HMAC-SHA256"
+	iso8601Format   = "20060102T150405Z"
+	yyyymmdd        = "20060102"
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+
+	DefaultRegion = "us-east-1"
+	MaxExpires    = 7 * 24 * time.Hour
+)
+
+// Options describes the request allowed by a presigned url
+type Options struct {
+	// the s3 endpoint, e.g., http://localhost:8333
+	Endpoint  string
+	Region    string
+	AccessKey string
+	SecretKey string
+	Method    string
+	Bucket    string
+	Object    string
+	Expires   time.Duration
+	// the extra query parameters, e.g., uploadId and partNumber to upload a part
+	Query url.Values
+	// the headers the request must send with exactly the same values,
+	// e.g., Content-Type, Content-Length, or Content-MD5 to constrain an upload
+	Headers http.Header
+	// the signing time, now if not set
+	Date time.Time
+}
+
+// PresignV4 signs the request with AWS Signature V4 in the query string
+// http://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-query-string-auth.html
+func PresignV4(opts Options) (string, error) {
+	if opts.AccessKey == "" || opts.SecretKey == "" {
+		return "", fmt.Errorf("presign needs the access key and the secret key")
+	}
+	if opts.Expires < time.Second || opts.Expires > MaxExpires {
+		return "", fmt.Errorf("presign expires %v should be between 1s and %v", opts.Expires, MaxExpires)
+	}
+	if opts.Bucket == "" && opts.Object != "" {
+		return "", fmt.Errorf("presign object %s without a bucket", opts.Object)
+	}
+	endpoint, err := url.Parse(opts.Endpoint)
+	if err != nil {
+		return "", fmt.Errorf("parse endpoint %s: %v", opts.Endpoint, err)
+	}
+	if (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return "", fmt.Errorf("endpoint %s should be like http://host:port", opts.Endpoint)
+	}
+
+	region := opts.Region
+	if region == "" {
+		region = DefaultRegion
+	}
+	method := strings.ToUpper(opts.Method)
+	if method == "" {
+		method = http.MethodGet
+	}
+	date := opts.Date
+	if date.IsZero() {
+		date = time.Now()
+	}
+	date = date.UTC()
+	scope := strings.Join([]string{date.Format(yyyymmdd), region, "s3", "aws4_request"}, "/")
+
+	urlPath := "/"
+	if opts.Bucket != "" {
+		urlPath += opts.Bucket
+		if opts.Object != "" {
+			urlPath += "/" + strings.TrimPrefix(opts.Object, "/")
+		}
+	}
+	encodedPath := encodePath(urlPath)
+
+	signedHeaders := map[string][]string{
+		"host": {endpoint.Host},
+	}
+	for k, v := range opts.Headers {
+		k = strings.ToLower(k)
+		signedHeaders[k] = append(signedHeaders[k], v...)
+	}
+	var headerNames []string
+	for k := range signedHeaders {
+		headerNames = append(headerNames, k)
+	}
+	sort.Strings(headerNames)
+
+	query := make(url.Values)
+	for k, v := range opts.Query {
+		query[k] = v
+	}
+	query.Set("X-Amz-Algorithm", signV4Algorithm)
+	query.Set("X-Amz-Credential", opts.AccessKey+"/"+scope)
+	query.Set("X-Amz-Date", date.Format(iso8601Format))
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(opts.Expires/time.Second), 10))
+	query.Set("X-Amz-SignedHeaders", strings.Join(headerNames, ";"))
+
+	var canonicalHeaders bytes.Buffer
+	for _, k := range headerNames {
+		var values []string
+		for _, v := range signedHeaders[k] {
+			values = append(values, strings.Join(strings.Fields(v), " "))
+		}
+		canonicalHeaders.WriteString(k + ":" + strings.Join(values, ",") + "\n")
+	}
+	canonicalRequest := strings.Join([]string{
+		method,
+		encodedPath,
+		strings.Replace(query.Encode(), "+", "%20", -1),
+		canonicalHeaders.String(),
+		strings.Join(headerNames, ";"),
+		unsignedPayload,
+	}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := signV4Algorithm + "\n" + date.Format(iso8601Format) + "\n" + scope + "\n" + hex.EncodeToString(canonicalRequestHash[:])
+
+	signingKey := []byte("AWS4" + opts.SecretKey)
+	for _, data := range []string{date.Format(yyyymmdd), region, "s3", "aws4_request"} {
+		signingKey = sumHMAC(signingKey, []byte(data))
+	}
+	query.Set("X-Amz-Signature", hex.EncodeToString(sumHMAC(signingKey, []byte(stringToSign))))
+
+	return fmt.Sprintf("%s://%s%s?%s", endpoint.Scheme, endpoint.Host, encodedPath,
+		strings.Replace(query.Encode(), "+", "%20", -1)), nil
+}
+
+func sumHMAC(key []byte, data []byte) []byte {
+	hash := hmac.New(sha256.New, key)
+	hash.Write(data)
+	return hash.Sum(nil)
+}
+
+// encodePath escapes the path the same way as the s3 api server when verifying the signature
+func encodePath(pathName string) string {
+	var buf bytes.Buffer
+	for _, b := range []byte(pathName) {
+		if 'A' <= b && b <= 'Z' || 'a' <= b && b <= 'z' || '0' <= b && b <= '9' ||
+			b == '-' || b == '_' || b == '.' || b == '~' || b == '/' {
+			buf.WriteByte(b)
+			continue
+		}
+		buf.WriteString(fmt.Sprintf("%%%02X", b))
+	}
+	return buf.String()
+}
@@ -0,0 +1,162 @@
+package s3ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Limit is the number of requests and bytes per second, 0 means no limit
+type Limit struct {
+	ReadRequests  uint64
+	WriteRequests uint64
+	ReadBytes     uint64
+	WriteBytes    uint64
+}
+
+func (l Limit) IsZero() bool {
+	return l == Limit{}
+}
+
+// tokenBucket holds at most one second of tokens, refilled at the rate per second.
+// The byte tokens can be taken in advance, so the bucket can go below zero.
+type tokenBucket struct {
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate uint64, now time.Time) *tokenBucket {
+	if rate == 0 {
+		return nil
+	}
+	return &tokenBucket{
+		rate:   float64(rate),
+		tokens: float64(rate),
+		last:   now,
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * b.rate
+		if b.tokens > b.rate {
+			b.tokens = b.rate
+		}
+	}
+	b.last = now
+}
+
+// Limiter limits the requests and the bandwidth, separately for reads and writes
+type Limiter struct {
+	sync.Mutex
+	limit         Limit
+	readRequests  *tokenBucket
+	writeRequests *tokenBucket
+	readBytes     *tokenBucket
+	writeBytes    *tokenBucket
+	now           func() time.Time
+}
+
+func NewLimiter(limit Limit) *Limiter {
+	return newLimiter(limit, time.Now)
+}
+
+func newLimiter(limit Limit, now func() time.Time) *Limiter {
+	t := now()
+	return &Limiter{
+		limit:         limit,
+		readRequests:  newTokenBucket(limit.ReadRequests, t),
+		writeRequests: newTokenBucket(limit.WriteRequests, t),
+		readBytes:     newTokenBucket(limit.ReadBytes, t),
+		writeBytes:    newTokenBucket(limit.WriteBytes, t),
+		now:           now,
+	}
+}
+
+func (l *Limiter) Limit() Limit {
+	return l.limit
+}
+
+// Allow takes a request token, and rejects the request if there is none left,
+// or if the bandwidth is used up by the requests in progress
+func (l *Limiter) Allow(write bool) bool {
+	l.Lock()
+	defer l.Unlock()
+
+	requests, bytes := l.readRequests, l.readBytes
+	if write {
+		requests, bytes = l.writeRequests, l.writeBytes
+	}
+	now := l.now()
+	if bytes != nil {
+		bytes.refill(now)
+		if bytes.tokens <= 0 {
+			return false
+		}
+	}
+	if requests != nil {
+		requests.refill(now)
+		if requests.tokens < 1 {
+			return false
+		}
+		requests.tokens--
+	}
+	return true
+}
+
+// Cancel returns the request token taken by Allow, when the request is rejected by another limiter
+func (l *Limiter) Cancel(write bool) {
+	l.Lock()
+	defer l.Unlock()
+
+	requests := l.readRequests
+	if write {
+		requests = l.writeRequests
+	}
+	if requests == nil {
+		return
+	}
+	requests.refill(l.now())
+	requests.tokens++
+	if requests.tokens > requests.rate {
+		requests.tokens = requests.rate
+	}
+}
+
+// Take takes the tokens of the bytes, and returns how long to wait to stay within the bandwidth
+func (l *Limiter) Take(write bool, n int) time.Duration {
+	l.Lock()
+	defer l.Unlock()
+
+	bytes := l.readBytes
+	if write {
+		bytes = l.writeBytes
+	}
+	if bytes == nil || n <= 0 {
+		return 0
+	}
+	bytes.refill(l.now())
+	bytes.tokens -= float64(n)
+	if bytes.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bytes.tokens / bytes.rate * float64(time.Second))
+}
+
+// Idle is true if all the tokens are refilled, so the limiter can be dropped without any effect
+func (l *Limiter) Idle() bool {
+	l.Lock()
+	defer l.Unlock()
+
+	now := l.now()
+	for _, b := range []*tokenBucket{l.readRequests, l.writeRequests, l.readBytes, l.writeBytes} {
+		if b == nil {
+			continue
+		}
+		b.refill(now)
+		if b.tokens < b.rate {
+			return false
+		}
+	}
+	return true
+}
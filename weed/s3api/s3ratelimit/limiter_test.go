@@ -0,0 +1,72 @@
+package s3ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestRate(t *testing.T) {
+
+	now := time.Now()
+	l := newLimiter(Limit{ReadRequests: 2}, func() time.Time { return now })
+
+	assert.True(t, l.Allow(false))
+	assert.True(t, l.Allow(false))
+	assert.False(t, l.Allow(false))
+
+	// writes are not limited
+	assert.True(t, l.Allow(true))
+
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, l.Allow(false))
+	assert.False(t, l.Allow(false))
+
+	// at most one second of requests is saved up
+	now = now.Add(time.Minute)
+	assert.True(t, l.Idle())
+	assert.True(t, l.Allow(false))
+	assert.True(t, l.Allow(false))
+	assert.False(t, l.Allow(false))
+	assert.False(t, l.Idle())
+
+}
+
+func TestCancel(t *testing.T) {
+
+	now := time.Now()
+	l := newLimiter(Limit{WriteRequests: 1}, func() time.Time { return now })
+
+	assert.True(t, l.Allow(true))
+	assert.False(t, l.Allow(true))
+	l.Cancel(true)
+	assert.True(t, l.Allow(true))
+
+	// never more than one second of requests
+	l.Cancel(true)
+	l.Cancel(true)
+	assert.True(t, l.Idle())
+	l.Cancel(false)
+
+}
+
+func TestBandwidth(t *testing.T) {
+
+	now := time.Now()
+	l := newLimiter(Limit{WriteBytes: 1000}, func() time.Time { return now })
+
+	assert.True(t, l.Allow(true))
+	assert.Equal(t, time.Duration(0), l.Take(true, 600))
+	assert.Equal(t, time.Duration(0), l.Take(true, 400))
+	assert.Equal(t, 500*time.Millisecond, l.Take(true, 500))
+
+	// the bandwidth is used up by the requests in progress
+	assert.False(t, l.Allow(true))
+	assert.True(t, l.Allow(false))
+	assert.Equal(t, time.Duration(0), l.Take(false, 1<<20))
+
+	now = now.Add(time.Second)
+	assert.True(t, l.Allow(true))
+
+}
@@ -0,0 +1,64 @@
+package s3ratelimit
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+// throttle waits until the bytes are within the bandwidth of all the limiters
+func throttle(limiters []*Limiter, write bool, n int) {
+	var wait time.Duration
+	for _, l := range limiters {
+		if d := l.Take(write, n); d > wait {
+			wait = d
+		}
+	}
+	if wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+// Reader throttles the request body to the write bandwidth
+type Reader struct {
+	io.ReadCloser
+	limiters []*Limiter
+}
+
+func NewReader(body io.ReadCloser, limiters []*Limiter) *Reader {
+	return &Reader{
+		ReadCloser: body,
+		limiters:   limiters,
+	}
+}
+
+func (r *Reader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(p)
+	throttle(r.limiters, true, n)
+	return
+}
+
+// ResponseWriter throttles the response body to the read bandwidth
+type ResponseWriter struct {
+	http.ResponseWriter
+	limiters []*Limiter
+}
+
+func NewResponseWriter(w http.ResponseWriter, limiters []*Limiter) *ResponseWriter {
+	return &ResponseWriter{
+		ResponseWriter: w,
+		limiters:       limiters,
+	}
+}
+
+func (w *ResponseWriter) Write(p []byte) (n int, err error) {
+	n, err = w.ResponseWriter.Write(p)
+	throttle(w.limiters, false, n)
+	return
+}
+
+func (w *ResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,239 @@
+package s3replication
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ReplicationConfiguration is the S3 bucket replication configuration.
+// The destinations are buckets of the targets configured in notification.toml, addressed by ARN,
+// e.g. arn:seaweed:s3:backup::photos for the bucket "photos" of the target [s3_replication.backup]
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketReplication.html
+type ReplicationConfiguration struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ReplicationConfiguration"`
+	Role    string   `xml:"Role,omitempty"`
+	Rules   []Rule   `xml:"Rule"`
+}
+
+type Rule struct {
+	ID                      string                   `xml:"ID,omitempty"`
+	Priority                int                      `xml:"Priority,omitempty"`
+	Status                  string                   `xml:"Status"`
+	Prefix                  string                   `xml:"Prefix,omitempty"` // deprecated, use Filter instead
+	Filter                  *Filter                  `xml:"Filter,omitempty"`
+	DeleteMarkerReplication *DeleteMarkerReplication `xml:"DeleteMarkerReplication,omitempty"`
+	Destination             Destination              `xml:"Destination"`
+}
+
+type Tag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+type Filter struct {
+	Prefix string `xml:"Prefix,omitempty"`
+	Tag    *Tag   `xml:"Tag,omitempty"`
+	And    *And   `xml:"And,omitempty"`
+}
+
+type And struct {
+	Prefix string `xml:"Prefix,omitempty"`
+	Tags   []Tag  `xml:"Tag,omitempty"`
+}
+
+// DeleteMarkerReplication replicates the deletions, as deletions of the replicas
+type DeleteMarkerReplication struct {
+	Status string `xml:"Status"`
+}
+
+type Destination struct {
+	Bucket       string `xml:"Bucket"`
+	StorageClass string `xml:"StorageClass,omitempty"`
+}
+
+const (
+	StatusEnabled  = "Enabled"
+	StatusDisabled = "Disabled"
+
+	// the replication status of the objects, as x-amz-replication-status
+	StatusPending   = "PENDING"
+	StatusCompleted = "COMPLETED"
+	StatusFailed    = "FAILED"
+	StatusReplica   = "REPLICA"
+
+	arnPrefix = "arn:seaweed:s3:"
+	maxRules  = 1000
+)
+
+var (
+	ErrNoRule              = errors.New("replication configuration must have at least one rule")
+	ErrTooManyRules        = errors.New("replication configuration allows at most 1000 rules")
+	ErrDuplicateRuleId     = errors.New("rule ID must be unique")
+	ErrRuleIdTooLong       = errors.New("rule ID must be less than 255 characters")
+	ErrInvalidStatus       = errors.New("rule status must be Enabled or Disabled")
+	ErrInvalidFilter       = errors.New("filter must have exactly one of Prefix, Tag, or And")
+	ErrTagFilterNotAllowed = errors.New("tag filters are not allowed with DeleteMarkerReplication")
+	ErrInvalidDestination  = errors.New("destination bucket must be a bucket of a configured target, e.g. arn:seaweed:s3:backup::photos")
+)
+
+// DestinationArn returns the ARN to address a bucket of a target in the replication rules
+func DestinationArn(targetName, bucket string) string {
+	return fmt.Sprintf("%s%s::%s", arnPrefix, targetName, bucket)
+}
+
+// ParseDestinationArn returns the target name and the bucket in the ARN, or empty strings if it is invalid
+func ParseDestinationArn(arn string) (targetName, bucket string) {
+	if !strings.HasPrefix(arn, arnPrefix) {
+		return "", ""
+	}
+	parts := strings.SplitN(strings.TrimPrefix(arn, arnPrefix), ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" || strings.Contains(parts[2], "/") {
+		return "", ""
+	}
+	return parts[0], parts[2]
+}
+
+func Parse(data []byte) (*ReplicationConfiguration, error) {
+	c := &ReplicationConfiguration{}
+	if err := xml.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *ReplicationConfiguration) Validate() error {
+	if len(c.Rules) == 0 {
+		return ErrNoRule
+	}
+	if len(c.Rules) > maxRules {
+		return ErrTooManyRules
+	}
+	ids := make(map[string]bool)
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if rule.ID != "" {
+			if ids[rule.ID] {
+				return ErrDuplicateRuleId
+			}
+			ids[rule.ID] = true
+		}
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Rule) Validate() error {
+	if len(r.ID) > 255 {
+		return ErrRuleIdTooLong
+	}
+	if r.Status != StatusEnabled && r.Status != StatusDisabled {
+		return ErrInvalidStatus
+	}
+	if r.Filter != nil {
+		count := 0
+		if r.Filter.Prefix != "" {
+			count++
+		}
+		if r.Filter.Tag != nil {
+			count++
+		}
+		if r.Filter.And != nil {
+			count++
+		}
+		if count > 1 {
+			return ErrInvalidFilter
+		}
+	}
+	if r.DeleteMarkerReplication != nil {
+		if status := r.DeleteMarkerReplication.Status; status != StatusEnabled && status != StatusDisabled {
+			return ErrInvalidStatus
+		}
+		if r.replicatesDeletes() && r.hasTagFilter() {
+			return ErrTagFilterNotAllowed
+		}
+	}
+	if targetName, _ := ParseDestinationArn(r.Destination.Bucket); targetName == "" {
+		return fmt.Errorf("%w: %s", ErrInvalidDestination, r.Destination.Bucket)
+	}
+	return nil
+}
+
+func (r *Rule) hasTagFilter() bool {
+	return r.Filter != nil && (r.Filter.Tag != nil || (r.Filter.And != nil && len(r.Filter.And.Tags) > 0))
+}
+
+func (r *Rule) replicatesDeletes() bool {
+	return r.DeleteMarkerReplication != nil && r.DeleteMarkerReplication.Status == StatusEnabled
+}
+
+// GetPrefix returns the key prefix the rule applies to
+func (r *Rule) GetPrefix() string {
+	if r.Filter == nil {
+		return r.Prefix
+	}
+	if r.Filter.And != nil {
+		return r.Filter.And.Prefix
+	}
+	return r.Filter.Prefix
+}
+
+// Match checks whether an enabled rule applies to the object key, without the leading "/", and its tags
+func (r *Rule) Match(key string, tags map[string]string) bool {
+	if r.Status != StatusEnabled {
+		return false
+	}
+	if !strings.HasPrefix(key, r.GetPrefix()) {
+		return false
+	}
+	if r.Filter == nil {
+		return true
+	}
+	if r.Filter.Tag != nil && tags[r.Filter.Tag.Key] != r.Filter.Tag.Value {
+		return false
+	}
+	if r.Filter.And != nil {
+		for _, tag := range r.Filter.And.Tags {
+			if v, found := tags[tag.Key]; !found || v != tag.Value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Destinations returns the rules replicating the object, the one with the highest priority for each destination
+func (c *ReplicationConfiguration) Destinations(key string, tags map[string]string) (destinations []*Rule) {
+	return c.destinations(func(rule *Rule) bool {
+		return rule.Match(key, tags)
+	})
+}
+
+// DeleteDestinations returns the rules replicating the deletion of the object, one for each destination
+func (c *ReplicationConfiguration) DeleteDestinations(key string) (destinations []*Rule) {
+	return c.destinations(func(rule *Rule) bool {
+		return rule.replicatesDeletes() && rule.Match(key, nil)
+	})
+}
+
+func (c *ReplicationConfiguration) destinations(match func(rule *Rule) bool) (destinations []*Rule) {
+	byArn := make(map[string]int)
+	for i := range c.Rules {
+		rule := &c.Rules[i]
+		if !match(rule) {
+			continue
+		}
+		if j, found := byArn[rule.Destination.Bucket]; found {
+			if rule.Priority > destinations[j].Priority {
+				destinations[j] = rule
+			}
+			continue
+		}
+		byArn[rule.Destination.Bucket] = len(destinations)
+		destinations = append(destinations, rule)
+	}
+	return
+}
@@ -0,0 +1,158 @@
+package s3replication
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAndValidate(t *testing.T) {
+
+	input := `<ReplicationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Role></Role>
+  <Rule>
+    <ID>photos</ID>
+    <Priority>1</Priority>
+    <Status>Enabled</Status>
+    <Filter>
+      <Prefix>photos/</Prefix>
+    </Filter>
+    <DeleteMarkerReplication>
+      <Status>Enabled</Status>
+    </DeleteMarkerReplication>
+    <Destination>
+      <Bucket>arn:seaweed:s3:backup::photos</Bucket>
+    </Destination>
+  </Rule>
+  <Rule>
+    <ID>important</ID>
+    <Priority>2</Priority>
+    <Status>Enabled</Status>
+    <Filter>
+      <And>
+        <Prefix>docs/</Prefix>
+        <Tag><Key>important</Key><Value>yes</Value></Tag>
+      </And>
+    </Filter>
+    <Destination>
+      <Bucket>arn:seaweed:s3:aws::docs-backup</Bucket>
+      <StorageClass>STANDARD_IA</StorageClass>
+    </Destination>
+  </Rule>
+</ReplicationConfiguration>`
+
+	c, err := Parse([]byte(input))
+	assert.Nil(t, err)
+	assert.Nil(t, c.Validate())
+	assert.Equal(t, 2, len(c.Rules))
+	assert.Equal(t, "photos/", c.Rules[0].GetPrefix())
+	assert.Equal(t, "docs/", c.Rules[1].GetPrefix())
+	assert.Equal(t, "STANDARD_IA", c.Rules[1].Destination.StorageClass)
+
+	c.Rules[1].DeleteMarkerReplication = &DeleteMarkerReplication{Status: StatusEnabled}
+	assert.Equal(t, ErrTagFilterNotAllowed, c.Validate())
+	c.Rules[1].DeleteMarkerReplication = nil
+
+	c.Rules[1].Filter.Prefix = "docs/"
+	assert.Equal(t, ErrInvalidFilter, c.Validate())
+	c.Rules[1].Filter.Prefix = ""
+
+	c.Rules[1].ID = "photos"
+	assert.Equal(t, ErrDuplicateRuleId, c.Validate())
+	c.Rules[1].ID = "important"
+
+	c.Rules[1].Status = "enabled"
+	assert.Equal(t, ErrInvalidStatus, c.Validate())
+	c.Rules[1].Status = StatusEnabled
+
+	c.Rules[1].Destination.Bucket = "arn:aws:s3:::docs-backup"
+	assert.True(t, errors.Is(c.Validate(), ErrInvalidDestination))
+
+	c.Rules = nil
+	assert.Equal(t, ErrNoRule, c.Validate())
+
+}
+
+func TestParseDestinationArn(t *testing.T) {
+
+	tests := []struct {
+		arn        string
+		targetName string
+		bucket     string
+	}{
+		{"arn:seaweed:s3:backup::photos", "backup", "photos"},
+		{DestinationArn("backup", "photos"), "backup", "photos"},
+		{"arn:seaweed:s3:::photos", "", ""},
+		{"arn:seaweed:s3:backup::", "", ""},
+		{"arn:seaweed:s3:backup::photos/2021", "", ""},
+		{"arn:aws:s3:::photos", "", ""},
+		{"photos", "", ""},
+	}
+
+	for _, test := range tests {
+		targetName, bucket := ParseDestinationArn(test.arn)
+		assert.Equal(t, test.targetName, targetName, test.arn)
+		assert.Equal(t, test.bucket, bucket, test.arn)
+	}
+
+}
+
+func TestDestinations(t *testing.T) {
+
+	c := &ReplicationConfiguration{
+		Rules: []Rule{
+			{
+				ID:          "all",
+				Priority:    1,
+				Status:      StatusEnabled,
+				Destination: Destination{Bucket: "arn:seaweed:s3:backup::all"},
+			},
+			{
+				ID:          "photos",
+				Priority:    2,
+				Status:      StatusEnabled,
+				Filter:      &Filter{Prefix: "photos/"},
+				Destination: Destination{Bucket: "arn:seaweed:s3:backup::all", StorageClass: "STANDARD_IA"},
+			},
+			{
+				ID:                      "tagged",
+				Priority:                3,
+				Status:                  StatusEnabled,
+				Filter:                  &Filter{Tag: &Tag{Key: "replicate", Value: "yes"}},
+				Destination:             Destination{Bucket: "arn:seaweed:s3:aws::tagged"},
+				DeleteMarkerReplication: &DeleteMarkerReplication{Status: StatusDisabled},
+			},
+			{
+				ID:                      "deleted",
+				Priority:                4,
+				Status:                  StatusEnabled,
+				Filter:                  &Filter{Prefix: "docs/"},
+				Destination:             Destination{Bucket: "arn:seaweed:s3:aws::docs"},
+				DeleteMarkerReplication: &DeleteMarkerReplication{Status: StatusEnabled},
+			},
+			{
+				ID:          "disabled",
+				Status:      StatusDisabled,
+				Destination: Destination{Bucket: "arn:seaweed:s3:aws::disabled"},
+			},
+		},
+	}
+
+	ids := func(rules []*Rule) (ids []string) {
+		for _, rule := range rules {
+			ids = append(ids, rule.ID)
+		}
+		return
+	}
+
+	assert.Equal(t, []string{"all"}, ids(c.Destinations("a.txt", nil)))
+	assert.Equal(t, []string{"photos"}, ids(c.Destinations("photos/a.jpg", nil)))
+	assert.Equal(t, []string{"photos", "tagged"}, ids(c.Destinations("photos/a.jpg", map[string]string{"replicate": "yes"})))
+	assert.Equal(t, []string{"all"}, ids(c.Destinations("a.txt", map[string]string{"replicate": "no"})))
+	assert.Equal(t, []string{"all", "deleted"}, ids(c.Destinations("docs/a.txt", nil)))
+
+	assert.Equal(t, []string(nil), ids(c.DeleteDestinations("a.txt")))
+	assert.Equal(t, []string{"deleted"}, ids(c.DeleteDestinations("docs/a.txt")))
+
+}
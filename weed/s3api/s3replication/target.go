@@ -0,0 +1,134 @@
+package s3replication
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// Target is another SeaweedFS S3 gateway, or any S3 endpoint, receiving the replicas
+type Target struct {
+	Name       string
+	MaxRetries int
+	conn       s3iface.S3API
+	uploader   *s3manager.Uploader
+}
+
+// Object is the data and the attributes of an object to replicate
+type Object struct {
+	Key          string
+	Body         io.Reader
+	ContentType  string
+	Metadata     map[string]string // without the X-Amz-Meta- prefix
+	Tags         map[string]string
+	StorageClass string
+}
+
+// LoadConfiguration returns the enabled targets in the sections under the prefix, keyed by the target name
+func LoadConfiguration(config *util.ViperProxy, prefix string) map[string]*Target {
+
+	targets := make(map[string]*Target)
+	if config == nil {
+		return targets
+	}
+
+	for name := range config.GetStringMap(strings.TrimSuffix(prefix, ".")) {
+		targetPrefix := prefix + name + "."
+		if !config.GetBool(targetPrefix + "enabled") {
+			continue
+		}
+		config.SetDefault(targetPrefix+"region", "us-east-1")
+		config.SetDefault(targetPrefix+"max_retries", 5)
+		target, err := NewTarget(name,
+			config.GetString(targetPrefix+"endpoint"),
+			config.GetString(targetPrefix+"region"),
+			config.GetString(targetPrefix+"aws_access_key_id"),
+			config.GetString(targetPrefix+"aws_secret_access_key"),
+		)
+		if err != nil {
+			glog.Fatalf("Failed to initialize s3 replication target %s: %+v", name, err)
+		}
+		target.MaxRetries = config.GetInt(targetPrefix + "max_retries")
+		targets[name] = target
+		glog.V(0).Infof("Configure s3 replication target %s", name)
+	}
+
+	return targets
+}
+
+func NewTarget(name, endpoint, region, awsAccessKeyId, awsSecretAccessKey string) (*Target, error) {
+	config := &aws.Config{
+		Region:           aws.String(region),
+		S3ForcePathStyle: aws.Bool(true),
+	}
+	if endpoint != "" {
+		config.Endpoint = aws.String(endpoint)
+	}
+	if awsAccessKeyId != "" && awsSecretAccessKey != "" {
+		config.Credentials = credentials.NewStaticCredentials(awsAccessKeyId, awsSecretAccessKey, "")
+	}
+
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, fmt.Errorf("create aws session: %v", err)
+	}
+	conn := s3.New(sess)
+
+	return &Target{
+		Name:     name,
+		conn:     conn,
+		uploader: s3manager.NewUploaderWithClient(conn),
+	}, nil
+}
+
+// Put writes the object into the bucket, marked as a replica, so it is not replicated again
+func (t *Target) Put(bucket string, object *Object) error {
+	input := &s3manager.UploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(object.Key),
+		Body:   object.Body,
+	}
+	if object.ContentType != "" {
+		input.ContentType = aws.String(object.ContentType)
+	}
+	if object.StorageClass != "" {
+		input.StorageClass = aws.String(object.StorageClass)
+	}
+	if len(object.Metadata) > 0 {
+		input.Metadata = aws.StringMap(object.Metadata)
+	}
+	if len(object.Tags) > 0 {
+		tags := url.Values{}
+		for k, v := range object.Tags {
+			tags.Set(k, v)
+		}
+		input.Tagging = aws.String(tags.Encode())
+	}
+
+	_, err := t.uploader.Upload(input, s3manager.WithUploaderRequestOptions(
+		request.WithSetRequestHeaders(map[string]string{xhttp.AmzReplicationStatus: StatusReplica}),
+	))
+	return err
+}
+
+// Delete deletes the object in the bucket
+func (t *Target) Delete(bucket, key string) error {
+	_, err := t.conn.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
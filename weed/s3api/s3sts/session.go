@@ -0,0 +1,119 @@
+// Package s3sts issues and verifies the temporary credentials of the STS api.
+//
+// The credentials are stateless, so any s3 gateway sharing the signing key can verify them:
+// the session token is a JWT signed by the key, carrying the access key and the permissions,
+// and the secret key is derived from the access key with the same signing key.
+package s3sts
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+const (
+	issuer          = "seaweedfs-sts"
+	accessKeyPrefix = "ASIA"
+	accessKeyChars  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid session token")
+	ErrExpiredToken = errors.New("expired session token")
+)
+
+// SessionClaims describes the temporary credentials. Either the Parent or the Role is set.
+type SessionClaims struct {
+	AccessKey string `json:"ak"`
+	// the identity calling GetSessionToken, the session has the same permissions
+	Parent string `json:"parent,omitempty"`
+	// the assumed role, the session has the permissions of the role
+	Role        string `json:"role,omitempty"`
+	SessionName string `json:"session,omitempty"`
+	// the session policy further limiting the permissions, in the bucket policy syntax without principals
+	Policy string `json:"policy,omitempty"`
+	jwt.StandardClaims
+}
+
+type Credentials struct {
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      time.Time
+}
+
+// NewSession issues the temporary credentials
+func NewSession(signingKey []byte, claims *SessionClaims, duration time.Duration) (*Credentials, error) {
+	if len(signingKey) == 0 {
+		return nil, fmt.Errorf("no session signing key")
+	}
+	accessKey, err := newAccessKey()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	claims.AccessKey = accessKey
+	claims.Issuer = issuer
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(duration).Unix()
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(signingKey)
+	if err != nil {
+		return nil, fmt.Errorf("sign session token: %v", err)
+	}
+	return &Credentials{
+		AccessKeyId:     accessKey,
+		SecretAccessKey: SecretKey(signingKey, accessKey),
+		SessionToken:    token,
+		Expiration:      time.Unix(claims.ExpiresAt, 0).UTC(),
+	}, nil
+}
+
+// ParseSession verifies the session token is signed by the key, not expired, and issued for the access key
+func ParseSession(signingKey []byte, accessKey, sessionToken string) (*SessionClaims, error) {
+	if len(signingKey) == 0 {
+		return nil, ErrInvalidToken
+	}
+	claims := &SessionClaims{}
+	_, err := jwt.ParseWithClaims(sessionToken, claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		return signingKey, nil
+	})
+	if err != nil {
+		if validationErr, ok := err.(*jwt.ValidationError); ok && validationErr.Errors == jwt.ValidationErrorExpired {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+	if claims.Issuer != issuer || claims.ExpiresAt == 0 || claims.AccessKey != accessKey {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// SecretKey derives the secret key of the temporary access key
+func SecretKey(signingKey []byte, accessKey string) string {
+	mac := hmac.New(sha256.New, signingKey)
+	mac.Write([]byte("secret:" + accessKey))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))[:40]
+}
+
+func newAccessKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate access key: %v", err)
+	}
+	key := []byte(accessKeyPrefix)
+	for _, c := range b {
+		key = append(key, accessKeyChars[int(c)%len(accessKeyChars)])
+	}
+	return string(key), nil
+}
@@ -0,0 +1,94 @@
+package s3sts
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSession(t *testing.T) {
+	signingKey := []byte("secret")
+
+	creds, err := NewSession(signingKey, &SessionClaims{Role: "ci", SessionName: "build-1"}, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, len(creds.AccessKeyId))
+	assert.Equal(t, SecretKey(signingKey, creds.AccessKeyId), creds.SecretAccessKey)
+
+	claims, err := ParseSession(signingKey, creds.AccessKeyId, creds.SessionToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "ci", claims.Role)
+	assert.Equal(t, "build-1", claims.SessionName)
+
+	// another access key, or another signing key
+	_, err = ParseSession(signingKey, "ASIAOTHER", creds.SessionToken)
+	assert.Equal(t, ErrInvalidToken, err)
+	_, err = ParseSession([]byte("other"), creds.AccessKeyId, creds.SessionToken)
+	assert.Equal(t, ErrInvalidToken, err)
+
+	expired, err := NewSession(signingKey, &SessionClaims{Parent: "me"}, -time.Minute)
+	assert.NoError(t, err)
+	_, err = ParseSession(signingKey, expired.AccessKeyId, expired.SessionToken)
+	assert.Equal(t, ErrExpiredToken, err)
+}
+
+func TestWebIdentityProvider(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	// the keys in a local file
+	dir, err := ioutil.TempDir("", "jwks")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	jwksFile := filepath.Join(dir, "jwks.json")
+	jwks, _ := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kid": "key1",
+			"kty": "RSA",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+		}},
+	})
+	assert.NoError(t, ioutil.WriteFile(jwksFile, jwks, 0644))
+
+	sign := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "key1"
+		s, err := token.SignedString(privateKey)
+		assert.NoError(t, err)
+		return s
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	provider := NewWebIdentityProvider("ci", "https://issuer.example.com", jwksFile, []string{"seaweedfs"})
+
+	identity, err := provider.Verify(sign(jwt.MapClaims{"iss": "https://issuer.example.com", "sub": "repo:org/app", "aud": []string{"other", "seaweedfs"}, "exp": exp}))
+	assert.NoError(t, err)
+	assert.Equal(t, &WebIdentity{Subject: "repo:org/app", Audience: "seaweedfs", Provider: "ci"}, identity)
+
+	for _, claims := range []jwt.MapClaims{
+		{"iss": "https://other.example.com", "sub": "repo:org/app", "aud": "seaweedfs", "exp": exp},
+		{"iss": "https://issuer.example.com", "sub": "repo:org/app", "aud": "other", "exp": exp},
+		{"iss": "https://issuer.example.com", "sub": "repo:org/app", "aud": "seaweedfs", "exp": time.Now().Add(-time.Minute).Unix()},
+		{"iss": "https://issuer.example.com", "sub": "repo:org/app", "aud": "seaweedfs"},
+	} {
+		_, err = provider.Verify(sign(claims))
+		assert.True(t, errors.Is(err, ErrInvalidIdentityToken), "%v", claims)
+	}
+
+	// signed by a session signing key
+	hmacToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iss": "https://issuer.example.com", "sub": "x", "exp": exp}).SignedString([]byte("secret"))
+	_, err = provider.Verify(hmacToken)
+	assert.True(t, errors.Is(err, ErrInvalidIdentityToken))
+}
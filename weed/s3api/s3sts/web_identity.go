@@ -0,0 +1,261 @@
+package s3sts
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+const jwksRefreshInterval = time.Minute
+
+var ErrInvalidIdentityToken = errors.New("invalid web identity token")
+
+// WebIdentityProvider verifies the tokens of an OpenID Connect issuer
+type WebIdentityProvider struct {
+	Name      string
+	Issuer    string
+	Jwks      string
+	Audiences []string
+
+	sync.Mutex
+	keys        map[string]interface{}
+	lastFetched time.Time
+}
+
+// WebIdentity is the verified content of a web identity token
+type WebIdentity struct {
+	Subject  string
+	Audience string
+	Provider string
+}
+
+func NewWebIdentityProvider(name, issuer, jwks string, audiences []string) *WebIdentityProvider {
+	return &WebIdentityProvider{
+		Name:      name,
+		Issuer:    issuer,
+		Jwks:      jwks,
+		Audiences: audiences,
+	}
+}
+
+// Verify checks the token signature with the provider keys, the issuer, the audience, and the expiration
+func (p *WebIdentityProvider) Verify(tokenString string) (*WebIdentity, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA, *jwt.SigningMethodRSAPSS:
+		default:
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		return p.lookupKey(kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidIdentityToken, err)
+	}
+
+	if iss, _ := claims["iss"].(string); iss != p.Issuer {
+		return nil, fmt.Errorf("%w: issuer %s", ErrInvalidIdentityToken, iss)
+	}
+	if _, found := claims["exp"]; !found {
+		return nil, fmt.Errorf("%w: no expiration", ErrInvalidIdentityToken)
+	}
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidIdentityToken)
+	}
+
+	var audiences []string
+	switch aud := claims["aud"].(type) {
+	case string:
+		audiences = append(audiences, aud)
+	case []interface{}:
+		for _, a := range aud {
+			if s, ok := a.(string); ok {
+				audiences = append(audiences, s)
+			}
+		}
+	}
+	identity := &WebIdentity{Subject: subject, Provider: p.Name}
+	if len(audiences) > 0 {
+		identity.Audience = audiences[0]
+	}
+	if len(p.Audiences) > 0 {
+		identity.Audience = ""
+		for _, a := range audiences {
+			if contains(p.Audiences, a) {
+				identity.Audience = a
+				break
+			}
+		}
+		if identity.Audience == "" {
+			return nil, fmt.Errorf("%w: audience %v", ErrInvalidIdentityToken, audiences)
+		}
+	}
+	return identity, nil
+}
+
+// lookupKey finds the key by its id, and fetches the keys again for a new key id, at most once per minute
+func (p *WebIdentityProvider) lookupKey(kid string) (interface{}, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if key, found := p.findKey(kid); found {
+		return key, nil
+	}
+	if time.Since(p.lastFetched) < jwksRefreshInterval {
+		return nil, fmt.Errorf("key %s not found", kid)
+	}
+	p.lastFetched = time.Now()
+	keys, err := p.fetchKeys()
+	if err != nil {
+		glog.Errorf("fetch keys of web identity provider %s: %v", p.Name, err)
+		return nil, err
+	}
+	p.keys = keys
+	if key, found := p.findKey(kid); found {
+		return key, nil
+	}
+	return nil, fmt.Errorf("key %s not found", kid)
+}
+
+func (p *WebIdentityProvider) findKey(kid string) (interface{}, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, found := p.keys[kid]
+	return key, found
+}
+
+func (p *WebIdentityProvider) fetchKeys() (map[string]interface{}, error) {
+	jwksLocation := p.Jwks
+	if jwksLocation == "" {
+		// OpenID Connect discovery
+		data, err := readLocation(strings.TrimSuffix(p.Issuer, "/") + "/.well-known/openid-configuration")
+		if err != nil {
+			return nil, err
+		}
+		var discovery struct {
+			JwksUri string `json:"jwks_uri"`
+		}
+		if err = json.Unmarshal(data, &discovery); err != nil {
+			return nil, fmt.Errorf("parse openid configuration: %v", err)
+		}
+		if discovery.JwksUri == "" {
+			return nil, fmt.Errorf("no jwks_uri in the openid configuration")
+		}
+		jwksLocation = discovery.JwksUri
+	}
+	data, err := readLocation(jwksLocation)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJwks(data)
+}
+
+func readLocation(location string) ([]byte, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		return ioutil.ReadFile(location)
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get %s: %s", location, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// ParseJwks reads the RSA and EC public keys of a JSON Web Key Set, keyed by the key ids
+func ParseJwks(data []byte) (map[string]interface{}, error) {
+	var jwks struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Kty string `json:"kty"`
+			Use string `json:"use"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+			Crv string `json:"crv"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, fmt.Errorf("parse jwks: %v", err)
+	}
+	keys := make(map[string]interface{})
+	for _, k := range jwks.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		switch k.Kty {
+		case "RSA":
+			n, err := decodeBigInt(k.N)
+			if err != nil {
+				return nil, fmt.Errorf("key %s: %v", k.Kid, err)
+			}
+			e, err := decodeBigInt(k.E)
+			if err != nil {
+				return nil, fmt.Errorf("key %s: %v", k.Kid, err)
+			}
+			keys[k.Kid] = &rsa.PublicKey{N: n, E: int(e.Int64())}
+		case "EC":
+			var curve elliptic.Curve
+			switch k.Crv {
+			case "P-256":
+				curve = elliptic.P256()
+			case "P-384":
+				curve = elliptic.P384()
+			case "P-521":
+				curve = elliptic.P521()
+			default:
+				return nil, fmt.Errorf("key %s: unsupported curve %s", k.Kid, k.Crv)
+			}
+			x, err := decodeBigInt(k.X)
+			if err != nil {
+				return nil, fmt.Errorf("key %s: %v", k.Kid, err)
+			}
+			y, err := decodeBigInt(k.Y)
+			if err != nil {
+				return nil, fmt.Errorf("key %s: %v", k.Kid, err)
+			}
+			keys[k.Kid] = &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		}
+	}
+	return keys, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,174 @@
+package website
+
+import (
+	"encoding/xml"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// WebsiteConfiguration is the S3 bucket static website hosting configuration.
+// API reference: https://docs.aws.amazon.com/AmazonS3/latest/API/API_PutBucketWebsite.html
+type WebsiteConfiguration struct {
+	XMLName               xml.Name               `xml:"http://s3.amazonaws.com/doc/2006-03-01/ WebsiteConfiguration"`
+	IndexDocument         *IndexDocument         `xml:"IndexDocument,omitempty"`
+	ErrorDocument         *ErrorDocument         `xml:"ErrorDocument,omitempty"`
+	RedirectAllRequestsTo *RedirectAllRequestsTo `xml:"RedirectAllRequestsTo,omitempty"`
+	RoutingRules          []RoutingRule          `xml:"RoutingRules>RoutingRule,omitempty"`
+}
+
+type IndexDocument struct {
+	Suffix string `xml:"Suffix"`
+}
+
+type ErrorDocument struct {
+	Key string `xml:"Key"`
+}
+
+type RedirectAllRequestsTo struct {
+	HostName string `xml:"HostName"`
+	Protocol string `xml:"Protocol,omitempty"`
+}
+
+type RoutingRule struct {
+	Condition *Condition `xml:"Condition,omitempty"`
+	Redirect  Redirect   `xml:"Redirect"`
+}
+
+type Condition struct {
+	HttpErrorCodeReturnedEquals string `xml:"HttpErrorCodeReturnedEquals,omitempty"`
+	KeyPrefixEquals             string `xml:"KeyPrefixEquals,omitempty"`
+}
+
+// Redirect replaces the whole key, or the prefix of the key matched by the condition.
+// The replacements are pointers, since replacing the prefix with an empty string is valid.
+type Redirect struct {
+	HostName             string  `xml:"HostName,omitempty"`
+	HttpRedirectCode     string  `xml:"HttpRedirectCode,omitempty"`
+	Protocol             string  `xml:"Protocol,omitempty"`
+	ReplaceKeyPrefixWith *string `xml:"ReplaceKeyPrefixWith,omitempty"`
+	ReplaceKeyWith       *string `xml:"ReplaceKeyWith,omitempty"`
+}
+
+const maxRoutingRules = 50
+
+var (
+	ErrNoIndexDocument     = errors.New("website configuration must have an IndexDocument, or only RedirectAllRequestsTo")
+	ErrInvalidIndexSuffix  = errors.New("IndexDocument Suffix must not be empty or contain a slash")
+	ErrInvalidErrorKey     = errors.New("ErrorDocument Key must not be empty")
+	ErrRedirectAllOnly     = errors.New("RedirectAllRequestsTo can not be used with other website settings")
+	ErrNoHostName          = errors.New("RedirectAllRequestsTo must have a HostName")
+	ErrInvalidProtocol     = errors.New("Protocol must be http or https")
+	ErrTooManyRoutingRules = errors.New("website configuration allows at most 50 routing rules")
+	ErrEmptyCondition      = errors.New("routing rule Condition must have KeyPrefixEquals or HttpErrorCodeReturnedEquals")
+	ErrInvalidErrorCode    = errors.New("routing rule HttpErrorCodeReturnedEquals must be a 4xx or 5xx status code")
+	ErrInvalidRedirectCode = errors.New("routing rule HttpRedirectCode must be a 3xx status code")
+	ErrBothReplacements    = errors.New("routing rule can not have both ReplaceKeyPrefixWith and ReplaceKeyWith")
+)
+
+func Parse(data []byte) (*WebsiteConfiguration, error) {
+	c := &WebsiteConfiguration{}
+	if err := xml.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *WebsiteConfiguration) Validate() error {
+	if c.RedirectAllRequestsTo != nil {
+		if c.IndexDocument != nil || c.ErrorDocument != nil || len(c.RoutingRules) > 0 {
+			return ErrRedirectAllOnly
+		}
+		if c.RedirectAllRequestsTo.HostName == "" {
+			return ErrNoHostName
+		}
+		return validateProtocol(c.RedirectAllRequestsTo.Protocol)
+	}
+	if c.IndexDocument == nil {
+		return ErrNoIndexDocument
+	}
+	if c.IndexDocument.Suffix == "" || strings.Contains(c.IndexDocument.Suffix, "/") {
+		return ErrInvalidIndexSuffix
+	}
+	if c.ErrorDocument != nil && c.ErrorDocument.Key == "" {
+		return ErrInvalidErrorKey
+	}
+	if len(c.RoutingRules) > maxRoutingRules {
+		return ErrTooManyRoutingRules
+	}
+	for i := range c.RoutingRules {
+		if err := c.RoutingRules[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *RoutingRule) Validate() error {
+	if r.Condition != nil {
+		if r.Condition.KeyPrefixEquals == "" && r.Condition.HttpErrorCodeReturnedEquals == "" {
+			return ErrEmptyCondition
+		}
+		if r.Condition.HttpErrorCodeReturnedEquals != "" && !isStatusCode(r.Condition.HttpErrorCodeReturnedEquals, 400, 599) {
+			return ErrInvalidErrorCode
+		}
+	}
+	if r.Redirect.HttpRedirectCode != "" && !isStatusCode(r.Redirect.HttpRedirectCode, 300, 399) {
+		return ErrInvalidRedirectCode
+	}
+	if r.Redirect.ReplaceKeyPrefixWith != nil && r.Redirect.ReplaceKeyWith != nil {
+		return ErrBothReplacements
+	}
+	return validateProtocol(r.Redirect.Protocol)
+}
+
+func validateProtocol(protocol string) error {
+	if protocol != "" && protocol != "http" && protocol != "https" {
+		return ErrInvalidProtocol
+	}
+	return nil
+}
+
+func isStatusCode(value string, min, max int) bool {
+	code, err := strconv.Atoi(value)
+	return err == nil && code >= min && code <= max
+}
+
+// Route returns the first routing rule for the key, or nil.
+// Before the object is read, the status code is 0, and only the rules without an error code condition apply.
+// After the object can not be served, only the rules with the same error code apply.
+func (c *WebsiteConfiguration) Route(key string, statusCode int) *RoutingRule {
+	for i := range c.RoutingRules {
+		rule := &c.RoutingRules[i]
+		errorCode := ""
+		if rule.Condition != nil {
+			if !strings.HasPrefix(key, rule.Condition.KeyPrefixEquals) {
+				continue
+			}
+			errorCode = rule.Condition.HttpErrorCodeReturnedEquals
+		}
+		if statusCode == 0 && errorCode == "" || statusCode != 0 && errorCode == strconv.Itoa(statusCode) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// RedirectKey returns the key to redirect to, and the redirect status code
+func (r *RoutingRule) RedirectKey(key string) (string, int) {
+	code := 301
+	if r.Redirect.HttpRedirectCode != "" {
+		code, _ = strconv.Atoi(r.Redirect.HttpRedirectCode)
+	}
+	switch {
+	case r.Redirect.ReplaceKeyWith != nil:
+		key = *r.Redirect.ReplaceKeyWith
+	case r.Redirect.ReplaceKeyPrefixWith != nil:
+		prefix := ""
+		if r.Condition != nil {
+			prefix = r.Condition.KeyPrefixEquals
+		}
+		key = *r.Redirect.ReplaceKeyPrefixWith + strings.TrimPrefix(key, prefix)
+	}
+	return key, code
+}
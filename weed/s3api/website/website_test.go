@@ -0,0 +1,84 @@
+package website
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAndValidate(t *testing.T) {
+
+	input := `<WebsiteConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <IndexDocument><Suffix>index.html</Suffix></IndexDocument>
+  <ErrorDocument><Key>404.html</Key></ErrorDocument>
+  <RoutingRules>
+    <RoutingRule>
+      <Condition><KeyPrefixEquals>docs/</KeyPrefixEquals></Condition>
+      <Redirect><ReplaceKeyPrefixWith>documents/</ReplaceKeyPrefixWith></Redirect>
+    </RoutingRule>
+    <RoutingRule>
+      <Condition><KeyPrefixEquals>old/</KeyPrefixEquals></Condition>
+      <Redirect><ReplaceKeyPrefixWith></ReplaceKeyPrefixWith><HttpRedirectCode>302</HttpRedirectCode></Redirect>
+    </RoutingRule>
+    <RoutingRule>
+      <Condition><HttpErrorCodeReturnedEquals>404</HttpErrorCodeReturnedEquals></Condition>
+      <Redirect><HostName>archive.example.com</HostName><Protocol>https</Protocol></Redirect>
+    </RoutingRule>
+  </RoutingRules>
+</WebsiteConfiguration>`
+
+	c, err := Parse([]byte(input))
+	assert.Nil(t, err)
+	assert.Nil(t, c.Validate())
+	assert.Equal(t, "index.html", c.IndexDocument.Suffix)
+	assert.Equal(t, "404.html", c.ErrorDocument.Key)
+	assert.Equal(t, 3, len(c.RoutingRules))
+
+	c.RoutingRules[0].Redirect.ReplaceKeyWith = c.RoutingRules[0].Redirect.ReplaceKeyPrefixWith
+	assert.Equal(t, ErrBothReplacements, c.Validate())
+	c.RoutingRules[0].Redirect.ReplaceKeyWith = nil
+
+	c.RoutingRules[2].Condition.HttpErrorCodeReturnedEquals = "200"
+	assert.Equal(t, ErrInvalidErrorCode, c.Validate())
+	c.RoutingRules[2].Condition.HttpErrorCodeReturnedEquals = "404"
+
+	c.IndexDocument.Suffix = "a/index.html"
+	assert.Equal(t, ErrInvalidIndexSuffix, c.Validate())
+
+	c = &WebsiteConfiguration{RedirectAllRequestsTo: &RedirectAllRequestsTo{HostName: "example.com"}}
+	assert.Nil(t, c.Validate())
+	c.IndexDocument = &IndexDocument{Suffix: "index.html"}
+	assert.Equal(t, ErrRedirectAllOnly, c.Validate())
+
+	assert.Equal(t, ErrNoIndexDocument, (&WebsiteConfiguration{}).Validate())
+}
+
+func TestRoute(t *testing.T) {
+
+	prefix, empty, page := "documents/", "", "moved.html"
+	c := &WebsiteConfiguration{
+		IndexDocument: &IndexDocument{Suffix: "index.html"},
+		RoutingRules: []RoutingRule{
+			{Condition: &Condition{KeyPrefixEquals: "docs/"}, Redirect: Redirect{ReplaceKeyPrefixWith: &prefix}},
+			{Condition: &Condition{KeyPrefixEquals: "old/"}, Redirect: Redirect{ReplaceKeyPrefixWith: &empty, HttpRedirectCode: "302"}},
+			{Condition: &Condition{KeyPrefixEquals: "blog/", HttpErrorCodeReturnedEquals: "404"}, Redirect: Redirect{ReplaceKeyWith: &page}},
+		},
+	}
+
+	rule := c.Route("docs/a/b.html", 0)
+	assert.NotNil(t, rule)
+	key, code := rule.RedirectKey("docs/a/b.html")
+	assert.Equal(t, "documents/a/b.html", key)
+	assert.Equal(t, 301, code)
+
+	key, code = c.Route("old/x.html", 0).RedirectKey("old/x.html")
+	assert.Equal(t, "x.html", key)
+	assert.Equal(t, 302, code)
+
+	assert.Nil(t, c.Route("blog/x.html", 0))
+	assert.Nil(t, c.Route("blog/x.html", 403))
+	key, _ = c.Route("blog/x.html", 404).RedirectKey("blog/x.html")
+	assert.Equal(t, "moved.html", key)
+
+	assert.Nil(t, c.Route("index.html", 0))
+}
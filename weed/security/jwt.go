@@ -18,6 +18,34 @@ type SeaweedFileIdClaims struct {
 	jwt.StandardClaims
 }
 
+// SeaweedFilerClaims is created by the servers calling the filer with the privileges of a gateway,
+// e.g. the S3 gateway bypassing the GOVERNANCE retention. The purpose names the only privilege granted.
+type SeaweedFilerClaims struct {
+	Purpose string `json:"purpose"`
+	jwt.StandardClaims
+}
+
+func GenJwtForFilerServer(signingKey SigningKey, expiresAfterSec int, purpose string) EncodedJwt {
+	if len(signingKey) == 0 {
+		return ""
+	}
+
+	claims := SeaweedFilerClaims{
+		purpose,
+		jwt.StandardClaims{},
+	}
+	if expiresAfterSec > 0 {
+		claims.ExpiresAt = time.Now().Add(time.Second * time.Duration(expiresAfterSec)).Unix()
+	}
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	encoded, e := t.SignedString([]byte(signingKey))
+	if e != nil {
+		glog.V(0).Infof("Failed to sign claims %+v: %v", t.Claims, e)
+		return ""
+	}
+	return EncodedJwt(encoded)
+}
+
 func GenJwt(signingKey SigningKey, expiresAfterSec int, fileId string) EncodedJwt {
 	if len(signingKey) == 0 {
 		return ""
@@ -64,3 +92,24 @@ func DecodeJwt(signingKey SigningKey, tokenString EncodedJwt) (token *jwt.Token,
 		return []byte(signingKey), nil
 	})
 }
+
+// DecodeFilerJwt verifies the token signed by GenJwtForFilerServer, and that it is granted for the purpose
+func DecodeFilerJwt(signingKey SigningKey, tokenString EncodedJwt, purpose string) error {
+	claims := &SeaweedFilerClaims{}
+	token, err := jwt.ParseWithClaims(string(tokenString), claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unknown token method")
+		}
+		return []byte(signingKey), nil
+	})
+	if err != nil {
+		return err
+	}
+	if !token.Valid {
+		return fmt.Errorf("invalid token")
+	}
+	if claims.Purpose != purpose {
+		return fmt.Errorf("token for %q, not %q", claims.Purpose, purpose)
+	}
+	return nil
+}
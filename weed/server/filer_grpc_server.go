@@ -13,14 +13,20 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	"github.com/chrislusf/seaweedfs/weed/pb/master_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc/metadata"
 )
 
 func (fs *FilerServer) LookupDirectoryEntry(ctx context.Context, req *filer_pb.LookupDirectoryEntryRequest) (*filer_pb.LookupDirectoryEntryResponse, error) {
 
 	glog.V(4).Infof("LookupDirectoryEntry %s", filepath.Join(req.Directory, req.Name))
 
+	if err := fs.checkTrashAdmin(ctx, util.JoinPath(req.Directory, req.Name)); err != nil {
+		return nil, err
+	}
+
 	entry, err := fs.filer.FindEntry(ctx, util.JoinPath(req.Directory, req.Name))
 	if err == filer_pb.ErrNotFound {
 		return &filer_pb.LookupDirectoryEntryResponse{}, err
@@ -30,7 +36,11 @@ func (fs *FilerServer) LookupDirectoryEntry(ctx context.Context, req *filer_pb.L
 		return nil, err
 	}
 
-	return &filer_pb.LookupDirectoryEntryResponse{
+	if req.IncludeDirectoryStats {
+		fs.filer.FillDirectoryStats(ctx, entry)
+	}
+
+	resp := &filer_pb.LookupDirectoryEntryResponse{
 		Entry: &filer_pb.Entry{
 			Name:            req.Name,
 			IsDirectory:     entry.IsDirectory(),
@@ -41,13 +51,19 @@ func (fs *FilerServer) LookupDirectoryEntry(ctx context.Context, req *filer_pb.L
 			HardLinkCounter: entry.HardLinkCounter,
 			Content:         entry.Content,
 		},
-	}, nil
+	}
+	resp.Entry.Attributes.DirectoryStats = entry.DirectoryStats.ToPb()
+	return resp, nil
 }
 
 func (fs *FilerServer) ListEntries(req *filer_pb.ListEntriesRequest, stream filer_pb.SeaweedFiler_ListEntriesServer) (err error) {
 
 	glog.V(4).Infof("ListEntries %v", req)
 
+	if err := fs.checkTrashAdmin(stream.Context(), util.FullPath(req.Directory)); err != nil {
+		return err
+	}
+
 	limit := int(req.Limit)
 	if limit == 0 {
 		limit = fs.option.DirListingLimit
@@ -65,7 +81,10 @@ func (fs *FilerServer) ListEntries(req *filer_pb.ListEntriesRequest, stream file
 		var hasEntries bool
 		lastFileName, listErr = fs.filer.StreamListDirectoryEntries(stream.Context(), util.FullPath(req.Directory), lastFileName, includeLastFile, int64(paginationLimit), req.Prefix, "", "", func(entry *filer.Entry) bool {
 			hasEntries = true
-			if err = stream.Send(&filer_pb.ListEntriesResponse{
+			if req.IncludeDirectoryStats {
+				fs.filer.FillDirectoryStats(stream.Context(), entry)
+			}
+			resp := &filer_pb.ListEntriesResponse{
 				Entry: &filer_pb.Entry{
 					Name:            entry.Name(),
 					IsDirectory:     entry.IsDirectory(),
@@ -76,7 +95,9 @@ func (fs *FilerServer) ListEntries(req *filer_pb.ListEntriesRequest, stream file
 					HardLinkCounter: entry.HardLinkCounter,
 					Content:         entry.Content,
 				},
-			}); err != nil {
+			}
+			resp.Entry.Attributes.DirectoryStats = entry.DirectoryStats.ToPb()
+			if err = stream.Send(resp); err != nil {
 				return false
 			}
 
@@ -185,6 +206,7 @@ func (fs *FilerServer) UpdateEntry(ctx context.Context, req *filer_pb.UpdateEntr
 
 	glog.V(4).Infof("UpdateEntry %v", req)
 
+	ctx = fs.withObjectLockPrivileges(ctx)
 	fullpath := util.Join(req.Directory, req.Entry.Name)
 	entry, err := fs.filer.FindEntry(ctx, util.FullPath(fullpath))
 	if err != nil {
@@ -323,6 +345,7 @@ func (fs *FilerServer) DeleteEntry(ctx context.Context, req *filer_pb.DeleteEntr
 
 	glog.V(4).Infof("DeleteEntry %v", req)
 
+	ctx = fs.withObjectLockPrivileges(ctx)
 	err = fs.filer.DeleteEntryMetaAndData(ctx, util.JoinPath(req.Directory, req.Name), req.IsRecursive, req.IgnoreRecursiveError, req.IsDeleteData, req.IsFromOtherCluster, req.Signatures)
 	resp = &filer_pb.DeleteEntryResponse{}
 	if err != nil && err != filer_pb.ErrNotFound {
@@ -331,8 +354,44 @@ func (fs *FilerServer) DeleteEntry(ctx context.Context, req *filer_pb.DeleteEntr
 	return resp, nil
 }
 
+// withObjectLockPrivileges honors the bypass of the GOVERNANCE retention, and turning the legal hold off,
+// only with a jwt signed by the filer signing key for the same purpose
+func (fs *FilerServer) withObjectLockPrivileges(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	if fs.hasFilerJwt(md, filer.BypassGovernanceRetentionKey) {
+		ctx = filer.WithBypassGovernanceRetention(ctx)
+	}
+	if fs.hasFilerJwt(md, filer.ChangeLegalHoldKey) {
+		ctx = filer.WithChangingLegalHold(ctx)
+	}
+	return ctx
+}
+
+func (fs *FilerServer) hasFilerJwt(md metadata.MD, purpose string) bool {
+	for _, v := range md.Get(purpose) {
+		if len(fs.secret) == 0 {
+			glog.V(0).Infof("%s: jwt.filer_signing.key is not configured", purpose)
+			return false
+		}
+		if err := security.DecodeFilerJwt(fs.secret, security.EncodedJwt(v), purpose); err != nil {
+			glog.V(0).Infof("%s: invalid jwt: %v", purpose, err)
+			continue
+		}
+		return true
+	}
+	return false
+}
+
 func (fs *FilerServer) AssignVolume(ctx context.Context, req *filer_pb.AssignVolumeRequest) (resp *filer_pb.AssignVolumeResponse, err error) {
 
+	if err := fs.filer.CheckQuota(req.Path, 1, 0); err != nil {
+		glog.V(3).Infof("AssignVolume %s: %v", req.Path, err)
+		return &filer_pb.AssignVolumeResponse{Error: err.Error()}, nil
+	}
+
 	so := fs.detectStorageOption(req.Path, req.Collection, req.Replication, req.TtlSec, req.DiskType, req.DataCenter, req.Rack)
 
 	assignRequest, altRequest := so.ToAssignRequests(int(req.Count))
@@ -384,6 +443,11 @@ func (fs *FilerServer) DeleteCollection(ctx context.Context, req *filer_pb.Delet
 
 	glog.V(4).Infof("DeleteCollection %v", req)
 
+	bucketPath := util.NewFullPath(fs.filer.DirBucketsPath, req.GetCollection())
+	if fs.filer.HasSnapshotsUsingCollection(bucketPath) {
+		return nil, fmt.Errorf("%w: %s", filer.ErrSnapshotsExist, bucketPath)
+	}
+
 	err = fs.filer.MasterClient.WithClient(func(client master_pb.SeaweedClient) error {
 		_, err := client.CollectionDelete(context.Background(), &master_pb.CollectionDeleteRequest{
 			Name: req.GetCollection(),
@@ -0,0 +1,296 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func (fs *FilerServer) RestoreMetadata(ctx context.Context, req *filer_pb.RestoreMetadataRequest) (*filer_pb.RestoreMetadataResponse, error) {
+
+	glog.V(1).Infof("RestoreMetadata %v", req)
+
+	dir := util.FullPath(filepath.ToSlash(filepath.Clean(req.Directory)))
+	targetDir := dir
+	if req.TargetDirectory != "" {
+		targetDir = util.FullPath(filepath.ToSlash(filepath.Clean(req.TargetDirectory)))
+	}
+	for _, p := range []util.FullPath{dir, targetDir} {
+		if !strings.HasPrefix(string(p), "/") || p == "/" || isInDirectory(p, filer.TopicsDir) || fs.filer.IsSnapshotPath(p) {
+			return nil, fmt.Errorf("can not restore %s", p)
+		}
+	}
+	if targetDir != dir {
+		if isInDirectory(targetDir, string(dir)) || isInDirectory(dir, string(targetDir)) {
+			return nil, fmt.Errorf("can not restore %s into %s", dir, targetDir)
+		}
+		if _, err := fs.filer.FindEntry(ctx, targetDir); err != filer_pb.ErrNotFound {
+			return nil, fmt.Errorf("%w: entry %s already exists", filer.ErrEntryExists, targetDir)
+		}
+	}
+
+	current, restored, err := fs.filer.MetadataAt(ctx, dir, time.Unix(0, req.TsNs))
+	if err != nil {
+		return nil, fmt.Errorf("restore %s: %v", dir, err)
+	}
+	if targetDir != dir {
+		current, restored = nil, filer.RebaseTree(restored, dir, targetDir)
+	}
+
+	// the chunks of the restored entries are not deleted with the replaced entries,
+	// except the counted dedup chunks, which are referenced again by the restored entries
+	keptChunks := make(map[string]bool)
+	for _, entry := range restored {
+		for _, chunk := range entry.Chunks {
+			if !chunk.IsDedup {
+				keptChunks[chunk.GetFileIdString()] = true
+			}
+		}
+	}
+
+	changes := filer.DiffTrees(current, restored)
+	resp := &filer_pb.RestoreMetadataResponse{}
+	journal := &restoreJournal{
+		retained: make([]bool, len(changes)),
+		copied:   make([][]*filer_pb.FileChunk, len(changes)),
+	}
+	for i, change := range changes {
+		c := &filer_pb.RestoreMetadataResponse_Change{
+			Path: string(change.FullPath),
+		}
+		if change.NewEntry == nil {
+			c.Action, c.IsDirectory, c.FileSize = "delete", change.OldEntry.IsDirectory(), change.OldEntry.Size()
+		} else {
+			c.Action, c.IsDirectory, c.FileSize = "update", change.NewEntry.IsDirectory(), change.NewEntry.Size()
+			if change.OldEntry == nil {
+				c.Action = "create"
+			}
+			recovered, lost, copied, err := fs.recoverChunks(change.NewEntry, req.DryRun)
+			journal.copied[i] = copied
+			if err != nil {
+				fs.undoRestore(ctx, changes, journal, 0)
+				return nil, fmt.Errorf("restore %s: %v", change.FullPath, err)
+			}
+			c.RecoveredChunks, c.LostChunks = int32(recovered), int32(lost)
+			// referenced before any replaced entry releases the chunks
+			if lost == 0 && !req.DryRun {
+				if err = fs.filer.RetainDedupChunks(ctx, change.NewEntry.Chunks); err != nil {
+					fs.undoRestore(ctx, changes, journal, 0)
+					return nil, fmt.Errorf("restore %s: %v", change.FullPath, err)
+				}
+				journal.retained[i] = true
+			}
+		}
+		resp.Changes = append(resp.Changes, c)
+	}
+	if req.DryRun {
+		return resp, nil
+	}
+
+	// the changes are stored in one transaction, if the filer store supports it,
+	// and the chunks of the replaced entries are only deleted after the commit
+	txCtx, err := fs.filer.BeginTransaction(ctx)
+	if err != nil {
+		fs.undoRestore(ctx, changes, journal, 0)
+		return nil, fmt.Errorf("restore %s: %v", dir, err)
+	}
+	var garbage []*filer_pb.FileChunk
+	for i, change := range changes {
+		var replacedChunks []*filer_pb.FileChunk
+		if change.NewEntry == nil {
+			replacedChunks, err = fs.deleteReplacedEntry(txCtx, change.OldEntry, keptChunks)
+		} else if resp.Changes[i].LostChunks == 0 {
+			replacedChunks, err = fs.writeRestoredEntry(txCtx, change.OldEntry, change.NewEntry, keptChunks)
+		}
+		if err != nil {
+			if rollbackErr := fs.filer.RollbackTransaction(txCtx); rollbackErr != nil {
+				glog.Errorf("restore %s: rollback: %v", dir, rollbackErr)
+			}
+			fs.undoRestore(ctx, changes, journal, i+1)
+			return nil, fmt.Errorf("restore %s: %v", change.FullPath, err)
+		}
+		garbage = append(garbage, replacedChunks...)
+	}
+	if err = fs.filer.CommitTransaction(txCtx); err != nil {
+		fs.undoRestore(ctx, changes, journal, len(changes))
+		return nil, fmt.Errorf("restore %s: commit: %v", dir, err)
+	}
+	fs.filer.DeleteChunks(garbage)
+
+	return resp, nil
+}
+
+// restoreJournal keeps what is done for each restored entry before it is stored
+type restoreJournal struct {
+	// the deduplicated chunks are referenced once more
+	retained []bool
+	// the deleted chunks are copied to new file ids
+	copied [][]*filer_pb.FileChunk
+}
+
+// undoRestore takes back the chunk references and the copied chunks of the restored entries not stored.
+// The entries of the first applied changes are still stored, unless the filer store rolled them back.
+func (fs *FilerServer) undoRestore(ctx context.Context, changes []*filer.MetadataChange, journal *restoreJournal, applied int) {
+	for i, change := range changes {
+		if change.NewEntry == nil || i < applied && fs.isRestoredEntryStored(ctx, change.NewEntry) {
+			continue
+		}
+		if journal.retained[i] {
+			if err := fs.filer.ReleaseRetainedDedupChunks(ctx, change.NewEntry.Chunks); err != nil {
+				glog.Errorf("restore %s: release dedup chunks: %v", change.FullPath, err)
+			}
+		}
+		fs.filer.DeleteChunks(journal.copied[i])
+	}
+}
+
+func (fs *FilerServer) isRestoredEntryStored(ctx context.Context, entry *filer.Entry) bool {
+	stored, err := fs.filer.FindEntry(ctx, entry.FullPath)
+	if err != nil || len(stored.Chunks) != len(entry.Chunks) {
+		return false
+	}
+	for i, chunk := range stored.Chunks {
+		if chunk.GetFileIdString() != entry.Chunks[i].GetFileIdString() {
+			return false
+		}
+	}
+	return true
+}
+
+func isInDirectory(p util.FullPath, dir string) bool {
+	return string(p) == dir || strings.HasPrefix(string(p), dir+"/")
+}
+
+// deleteReplacedEntry returns the chunks to delete once the restore is committed
+func (fs *FilerServer) deleteReplacedEntry(ctx context.Context, entry *filer.Entry, keptChunks map[string]bool) ([]*filer_pb.FileChunk, error) {
+	// the children are deleted before
+	if err := fs.filer.DeleteEntryMetaAndData(ctx, entry.FullPath, false, false, false, false, nil); err != nil {
+		return nil, err
+	}
+	return notKeptChunks(entry.Chunks, keptChunks), nil
+}
+
+func notKeptChunks(chunks []*filer_pb.FileChunk, keptChunks map[string]bool) (garbage []*filer_pb.FileChunk) {
+	for _, chunk := range chunks {
+		if !keptChunks[chunk.GetFileIdString()] {
+			garbage = append(garbage, chunk)
+		}
+	}
+	return
+}
+
+// writeRestoredEntry returns the chunks to delete once the restore is committed
+func (fs *FilerServer) writeRestoredEntry(ctx context.Context, oldEntry, entry *filer.Entry, keptChunks map[string]bool) ([]*filer_pb.FileChunk, error) {
+	if oldEntry == nil {
+		return nil, fs.filer.CreateEntry(ctx, entry, true, false, nil)
+	}
+	if err := fs.filer.UpdateEntry(ctx, oldEntry, entry); err != nil {
+		return nil, err
+	}
+	fs.filer.NotifyUpdateEvent(ctx, oldEntry, entry, true, false, nil)
+
+	return notKeptChunks(oldEntry.Chunks, keptChunks), nil
+}
+
+// recoverChunks copies the deleted but not yet garbage collected chunks of the entry to new file ids.
+// The entry is not changed if any chunk is lost.
+func (fs *FilerServer) recoverChunks(entry *filer.Entry, dryRun bool) (recovered, lost int, copiedChunks []*filer_pb.FileChunk, err error) {
+
+	if entry.IsDirectory() || fs.isChunksAlive(entry.Chunks) {
+		return 0, 0, nil, nil
+	}
+
+	lookupFileIdFn := fs.filer.MasterClient.GetLookupFileIdFunction()
+	dataChunks, _, resolveErr := filer.ResolveChunkManifest(lookupFileIdFn, entry.Chunks)
+	if resolveErr != nil {
+		glog.V(1).Infof("resolve chunk manifest of %s: %v", entry.FullPath, resolveErr)
+		return 0, len(entry.Chunks), nil, nil
+	}
+
+	so := fs.detectStorageOption(string(entry.FullPath), entry.Collection, entry.Replication, entry.TtlSec, entry.DiskType, "", "")
+	var chunks []*filer_pb.FileChunk
+	for _, chunk := range dataChunks {
+		isAlive, isDeleted := fs.checkChunk(chunk.GetFileIdString())
+		if isAlive {
+			chunks = append(chunks, chunk)
+			continue
+		}
+		if !isDeleted {
+			lost++
+			continue
+		}
+		recovered++
+		if dryRun || lost > 0 {
+			continue
+		}
+		copied, copyErr := fs.copyDeletedChunk(lookupFileIdFn, chunk, so)
+		if copyErr != nil {
+			return recovered, lost, copiedChunks, fmt.Errorf("recover chunk %s: %v", chunk.GetFileIdString(), copyErr)
+		}
+		chunks = append(chunks, copied)
+		copiedChunks = append(copiedChunks, copied)
+	}
+
+	if lost == 0 && !dryRun {
+		entry.Chunks = chunks
+	}
+	return recovered, lost, copiedChunks, nil
+}
+
+func (fs *FilerServer) isChunksAlive(chunks []*filer_pb.FileChunk) bool {
+	for _, chunk := range chunks {
+		if isAlive, _ := fs.checkChunk(chunk.GetFileIdString()); !isAlive {
+			return false
+		}
+	}
+	return true
+}
+
+// checkChunk tells whether the chunk can be read, or can only be read as a deleted chunk
+func (fs *FilerServer) checkChunk(fileId string) (isAlive, isDeleted bool) {
+	urlStrings, err := fs.filer.MasterClient.GetLookupFileIdFunction()(fileId)
+	if err != nil {
+		glog.V(1).Infof("lookup chunk %s: %v", fileId, err)
+		return false, false
+	}
+	for _, urlString := range urlStrings {
+		if _, err := util.Head(urlString); err == nil {
+			return true, false
+		}
+		if _, err := util.Head(urlString + "?readDeleted=true"); err == nil {
+			isDeleted = true
+		}
+	}
+	return false, isDeleted
+}
+
+func (fs *FilerServer) copyDeletedChunk(lookupFileIdFn func(fileId string) ([]string, error), chunk *filer_pb.FileChunk, so *operation.StorageOption) (*filer_pb.FileChunk, error) {
+
+	data, err := filer.FetchChunkView(lookupFileIdFn, &filer.ChunkView{
+		FileId:      chunk.GetFileIdString(),
+		Size:        chunk.Size,
+		LogicOffset: chunk.Offset,
+		ChunkSize:   chunk.Size,
+		CipherKey:   chunk.CipherKey,
+		IsGzipped:   chunk.IsCompressed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	copied, err := fs.uploadCopiedData(data, chunk.Offset, so, len(chunk.CipherKey) > 0)
+	if err != nil {
+		return nil, err
+	}
+	// keep the order of the overlapping chunks
+	copied.Mtime = chunk.Mtime
+	return copied, nil
+
+}
@@ -0,0 +1,39 @@
+package weed_server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestObjectLockPrivilegesJwt(t *testing.T) {
+
+	withToken := func(key string, token security.EncodedJwt) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(key, string(token)))
+	}
+	bypassToken := func(signingKey security.SigningKey, purpose string) context.Context {
+		return withToken(filer.BypassGovernanceRetentionKey, security.GenJwtForFilerServer(signingKey, 10, purpose))
+	}
+	isBypassing := func(fs *FilerServer, ctx context.Context) bool {
+		return fs.withObjectLockPrivileges(ctx) != ctx
+	}
+
+	fs := &FilerServer{secret: security.SigningKey("filer secret")}
+	assert.True(t, isBypassing(fs, bypassToken(fs.secret, filer.BypassGovernanceRetentionKey)))
+	assert.False(t, isBypassing(fs, withToken(filer.BypassGovernanceRetentionKey, "true")))
+	assert.False(t, isBypassing(fs, bypassToken(security.SigningKey("other secret"), filer.BypassGovernanceRetentionKey)))
+	assert.False(t, isBypassing(fs, context.Background()))
+
+	// the jwt is only honored for its purpose
+	assert.False(t, isBypassing(fs, bypassToken(fs.secret, "")))
+	assert.False(t, isBypassing(fs, bypassToken(fs.secret, filer.ChangeLegalHoldKey)))
+	assert.True(t, isBypassing(fs, withToken(filer.ChangeLegalHoldKey, security.GenJwtForFilerServer(fs.secret, 10, filer.ChangeLegalHoldKey))))
+
+	// never trusted without the signing key
+	assert.False(t, isBypassing(&FilerServer{}, withToken(filer.BypassGovernanceRetentionKey, "true")))
+
+}
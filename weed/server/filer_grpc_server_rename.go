@@ -21,6 +21,9 @@ func (fs *FilerServer) AtomicRenameEntry(ctx context.Context, req *filer_pb.Atom
 	if err := fs.filer.CanRename(oldParent, newParent); err != nil {
 		return nil, err
 	}
+	if fs.filer.IsSnapshotPath(oldParent.Child(req.OldName)) || fs.filer.IsSnapshotPath(newParent.Child(req.NewName)) {
+		return nil, filer.ErrSnapshotReadOnly
+	}
 
 	ctx, err := fs.filer.BeginTransaction(ctx)
 	if err != nil {
@@ -126,8 +129,8 @@ func (fs *FilerServer) moveSelfEntry(ctx context.Context, oldParent util.FullPat
 		}
 	}
 
-	// delete old entry
-	deleteErr := fs.filer.DeleteEntryMetaAndData(ctx, oldPath, false, false, false, false, nil)
+	// delete old entry, which is allowed for locked objects since the data is kept in the new entry
+	deleteErr := fs.filer.DeleteEntryMetaAndData(filer.WithMovingEntry(ctx), oldPath, false, false, false, false, nil)
 	if deleteErr != nil {
 		return deleteErr
 	}
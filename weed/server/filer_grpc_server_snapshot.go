@@ -0,0 +1,35 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func (fs *FilerServer) CreateSnapshot(ctx context.Context, req *filer_pb.CreateSnapshotRequest) (*filer_pb.CreateSnapshotResponse, error) {
+
+	glog.V(1).Infof("CreateSnapshot %v", req)
+
+	dir := util.FullPath(filepath.ToSlash(req.Directory))
+	if _, err := fs.filer.CreateSnapshot(ctx, dir, req.Name); err != nil {
+		return nil, fmt.Errorf("create snapshot %s of %s: %v", req.Name, dir, err)
+	}
+
+	return &filer_pb.CreateSnapshotResponse{}, nil
+}
+
+func (fs *FilerServer) DeleteSnapshot(ctx context.Context, req *filer_pb.DeleteSnapshotRequest) (*filer_pb.DeleteSnapshotResponse, error) {
+
+	glog.V(1).Infof("DeleteSnapshot %v", req)
+
+	dir := util.FullPath(filepath.ToSlash(req.Directory))
+	if err := fs.filer.DeleteSnapshot(ctx, dir, req.Name); err != nil {
+		return nil, fmt.Errorf("delete snapshot %s of %s: %v", req.Name, dir, err)
+	}
+
+	return &filer_pb.DeleteSnapshotResponse{}, nil
+}
@@ -166,7 +166,7 @@ func (fs *FilerServer) eachEventNotificationFn(req *filer_pb.SubscribeMetadataRe
 			return nil
 		}
 
-		if !strings.HasPrefix(fullpath, req.PathPrefix) {
+		if !strings.HasPrefix(fullpath, req.PathPrefix) && !isInSubscribedDirectories(req.Directories, dirPath, eventNotification.NewParentPath) {
 			if eventNotification.NewParentPath != "" {
 				newFullPath := util.Join(eventNotification.NewParentPath, entryName)
 				if !strings.HasPrefix(newFullPath, req.PathPrefix) {
@@ -191,6 +191,15 @@ func (fs *FilerServer) eachEventNotificationFn(req *filer_pb.SubscribeMetadataRe
 	}
 }
 
+func isInSubscribedDirectories(directories []string, dirPath, newParentPath string) bool {
+	for _, dir := range directories {
+		if dir == dirPath || dir == newParentPath {
+			return true
+		}
+	}
+	return false
+}
+
 func (fs *FilerServer) addClient(clientType string, clientAddress string) (clientName string) {
 	clientName = clientType + "@" + clientAddress
 	glog.V(0).Infof("+ listener %v", clientName)
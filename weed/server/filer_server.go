@@ -36,12 +36,16 @@ import (
 	_ "github.com/chrislusf/seaweedfs/weed/filer/redis"
 	_ "github.com/chrislusf/seaweedfs/weed/filer/redis2"
 	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/kms"
+	_ "github.com/chrislusf/seaweedfs/weed/kms/local"
 	"github.com/chrislusf/seaweedfs/weed/notification"
 	_ "github.com/chrislusf/seaweedfs/weed/notification/aws_sqs"
 	_ "github.com/chrislusf/seaweedfs/weed/notification/gocdk_pub_sub"
 	_ "github.com/chrislusf/seaweedfs/weed/notification/google_pub_sub"
 	_ "github.com/chrislusf/seaweedfs/weed/notification/kafka"
 	_ "github.com/chrislusf/seaweedfs/weed/notification/log"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3event"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3replication"
 	"github.com/chrislusf/seaweedfs/weed/security"
 )
 
@@ -63,6 +67,8 @@ type FilerOption struct {
 	SaveToFilerLimit      int64
 	Filers                []string
 	ConcurrentUploadLimit int64
+	LifecycleScanInterval time.Duration
+	TrashPurgeInterval    time.Duration
 }
 
 type FilerServer struct {
@@ -84,6 +90,15 @@ type FilerServer struct {
 
 	inFlightDataSize      int64
 	inFlightDataLimitCond *sync.Cond
+
+	// wraps the data keys of the S3 server side encryption
+	kms kms.KeyManagementService
+
+	// sends the S3 bucket events, if any targets are enabled
+	s3Events *s3EventNotifier
+
+	// replicates the S3 objects by the bucket replication rules, if any targets are enabled
+	s3Replication *s3Replicator
 }
 
 func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption) (fs *FilerServer, err error) {
@@ -124,6 +139,7 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 	}
 	util.LoadConfiguration("notification", false)
 
+	fs.secret = security.SigningKey(v.GetString("jwt.filer_signing.key"))
 	fs.option.recursiveDelete = v.GetBool("filer.options.recursive_delete")
 	v.SetDefault("filer.options.buckets_folder", "/buckets")
 	fs.filer.DirBucketsPath = v.GetString("filer.options.buckets_folder")
@@ -134,6 +150,8 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 
 	notification.LoadConfiguration(v, "notification.")
 
+	fs.kms = kms.LoadConfiguration(v, "kms.")
+
 	handleStaticResources(defaultMux)
 	if !option.DisableHttp {
 		defaultMux.HandleFunc("/", fs.filerHandler)
@@ -147,8 +165,32 @@ func NewFilerServer(defaultMux, readonlyMux *http.ServeMux, option *FilerOption)
 
 	fs.filer.LoadBuckets()
 
+	fs.filer.LoadSnapshots()
+
 	fs.filer.LoadFilerConf()
 
+	fs.filer.StartQuotaTracking()
+
+	fs.filer.StartDirectoryStats()
+
+	if queues := s3event.LoadConfiguration(v, "s3_event."); len(queues) > 0 {
+		fs.s3Events = newS3EventNotifier(queues)
+		go fs.loopSendingS3Events()
+	}
+
+	if targets := s3replication.LoadConfiguration(v, "s3_replication."); len(targets) > 0 {
+		fs.s3Replication = newS3Replicator(targets)
+		go fs.loopReplicatingS3Objects()
+	}
+
+	if option.LifecycleScanInterval > 0 {
+		go fs.loopProcessingLifecycle(option.LifecycleScanInterval)
+	}
+
+	if option.TrashPurgeInterval > 0 {
+		go fs.loopPurgingTrash(option.TrashPurgeInterval)
+	}
+
 	grace.OnInterrupt(func() {
 		fs.filer.Shutdown()
 	})
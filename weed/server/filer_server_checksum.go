@@ -0,0 +1,93 @@
+package weed_server
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"hash"
+	"hash/crc32"
+	"io"
+	"net/http"
+
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+)
+
+// The S3 additional checksums, sent in one of the x-amz-checksum-* headers, are verified
+// while the content is uploaded, and kept in the entry extended attributes with the same header name,
+// so they are returned by GET and HEAD the same as the other saved headers.
+
+var (
+	ErrChecksumInvalid   = errors.New("checksum header should be one base64 encoded x-amz-checksum-crc32, crc32c, sha1 or sha256")
+	ErrChecksumMismatch  = errors.New("checksum does not match the content")
+	checksumAlgorithms   = []string{xhttp.AmzChecksumCrc32, xhttp.AmzChecksumCrc32c, xhttp.AmzChecksumSha1, xhttp.AmzChecksumSha256}
+	crc32cTable          = crc32.MakeTable(crc32.Castagnoli)
+	checksumHashFunction = map[string]func() hash.Hash{
+		xhttp.AmzChecksumCrc32:  func() hash.Hash { return crc32.NewIEEE() },
+		xhttp.AmzChecksumCrc32c: func() hash.Hash { return crc32.New(crc32cTable) },
+		xhttp.AmzChecksumSha1:   sha1.New,
+		xhttp.AmzChecksumSha256: sha256.New,
+	}
+)
+
+type objectChecksum struct {
+	header   string
+	expected string
+	hash     hash.Hash
+}
+
+// ValidateChecksumHeaders checks the x-amz-checksum-* header before the upload
+func ValidateChecksumHeaders(header http.Header) error {
+	_, err := parseChecksumHeaders(header)
+	return err
+}
+
+// parseChecksumHeaders returns the checksum requested by the x-amz-checksum-* header, or nil if there is none.
+func parseChecksumHeaders(header http.Header) (*objectChecksum, error) {
+	var checksum *objectChecksum
+	for _, name := range checksumAlgorithms {
+		value := header.Get(name)
+		if value == "" {
+			continue
+		}
+		if checksum != nil {
+			return nil, ErrChecksumInvalid
+		}
+		h := checksumHashFunction[name]()
+		if decoded, err := base64.StdEncoding.DecodeString(value); err != nil || len(decoded) != h.Size() {
+			return nil, ErrChecksumInvalid
+		}
+		checksum = &objectChecksum{
+			header:   http.CanonicalHeaderKey(name),
+			expected: value,
+			hash:     h,
+		}
+	}
+	return checksum, nil
+}
+
+// wrap computes the checksum of the content read through the returned reader
+func (c *objectChecksum) wrap(reader io.Reader) io.Reader {
+	if c == nil {
+		return reader
+	}
+	return io.TeeReader(reader, c.hash)
+}
+
+// verify compares the checksum of the content with the expected value, after the content is read
+func (c *objectChecksum) verify() error {
+	if c == nil {
+		return nil
+	}
+	if base64.StdEncoding.EncodeToString(c.hash.Sum(nil)) != c.expected {
+		return ErrChecksumMismatch
+	}
+	return nil
+}
+
+// removeChecksumAttributes drops the checksums of the previous content
+func removeChecksumAttributes(extended map[string][]byte) {
+	for _, name := range checksumAlgorithms {
+		delete(extended, http.CanonicalHeaderKey(name))
+	}
+}
@@ -0,0 +1,54 @@
+package weed_server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"hash/crc32"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjectChecksum(t *testing.T) {
+
+	content := []byte("the manifest content")
+
+	checksum, err := parseChecksumHeaders(http.Header{})
+	assert.Nil(t, err)
+	assert.Nil(t, checksum)
+	assert.Nil(t, checksum.verify())
+
+	sum := sha256.Sum256(content)
+	header := http.Header{}
+	header.Set(xhttp.AmzChecksumSha256, base64.StdEncoding.EncodeToString(sum[:]))
+	checksum, err = parseChecksumHeaders(header)
+	assert.Nil(t, err)
+	assert.Equal(t, "X-Amz-Checksum-Sha256", checksum.header)
+	data, _ := ioutil.ReadAll(checksum.wrap(bytes.NewReader(content)))
+	assert.Equal(t, content, data)
+	assert.Nil(t, checksum.verify())
+
+	crc := make([]byte, 4)
+	crc32c := crc32.Checksum(content, crc32.MakeTable(crc32.Castagnoli))
+	crc[0], crc[1], crc[2], crc[3] = byte(crc32c>>24), byte(crc32c>>16), byte(crc32c>>8), byte(crc32c)
+	header = http.Header{}
+	header.Set(xhttp.AmzChecksumCrc32c, base64.StdEncoding.EncodeToString(crc))
+	checksum, _ = parseChecksumHeaders(header)
+	ioutil.ReadAll(checksum.wrap(bytes.NewReader(content)))
+	assert.Nil(t, checksum.verify())
+
+	checksum, _ = parseChecksumHeaders(header)
+	ioutil.ReadAll(checksum.wrap(bytes.NewReader([]byte("other content"))))
+	assert.Equal(t, ErrChecksumMismatch, checksum.verify())
+
+	// only one checksum, of the right size
+	header.Set(xhttp.AmzChecksumSha256, base64.StdEncoding.EncodeToString(sum[:]))
+	assert.Equal(t, ErrChecksumInvalid, ValidateChecksumHeaders(header))
+	header = http.Header{}
+	header.Set(xhttp.AmzChecksumCrc32, base64.StdEncoding.EncodeToString(sum[:]))
+	assert.Equal(t, ErrChecksumInvalid, ValidateChecksumHeaders(header))
+}
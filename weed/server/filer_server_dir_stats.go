@@ -0,0 +1,60 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func (fs *FilerServer) GetDirectoryStats(ctx context.Context, req *filer_pb.GetDirectoryStatsRequest) (*filer_pb.GetDirectoryStatsResponse, error) {
+
+	dir, err := fs.findStatsDirectory(ctx, req.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := fs.filer.FindDirectoryStats(ctx, dir)
+	if err != nil {
+		return nil, fmt.Errorf("read directory stats of %s: %v", dir, err)
+	}
+
+	return &filer_pb.GetDirectoryStatsResponse{
+		Stats: stats.ToPb(),
+	}, nil
+}
+
+func (fs *FilerServer) RebuildDirectoryStats(ctx context.Context, req *filer_pb.RebuildDirectoryStatsRequest) (*filer_pb.RebuildDirectoryStatsResponse, error) {
+
+	dir, err := fs.findStatsDirectory(ctx, req.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := fs.filer.RebuildDirectoryStats(dir); err != nil {
+		return nil, err
+	}
+
+	return &filer_pb.RebuildDirectoryStatsResponse{}, nil
+}
+
+func (fs *FilerServer) findStatsDirectory(ctx context.Context, directory string) (util.FullPath, error) {
+	if !strings.HasPrefix(directory, "/") {
+		return "", fmt.Errorf("invalid directory %q", directory)
+	}
+	dir := util.FullPath(filepath.ToSlash(filepath.Clean(directory)))
+	if dir == "/" {
+		return dir, nil
+	}
+	entry, err := fs.filer.FindEntry(ctx, dir)
+	if err != nil {
+		return "", fmt.Errorf("find %s: %w", dir, err)
+	}
+	if !entry.IsDirectory() {
+		return "", fmt.Errorf("%s is not a directory", dir)
+	}
+	return dir, nil
+}
@@ -29,6 +29,13 @@ func (fs *FilerServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request,
 		path = path[:len(path)-1]
 	}
 
+	// the trashed entries are only read by the admins through grpc
+	if filer.IsInTrash(util.FullPath(path)) {
+		stats.FilerRequestCounter.WithLabelValues("read.forbidden").Inc()
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
 	entry, err := fs.filer.FindEntry(context.Background(), util.FullPath(path))
 	if err != nil {
 		if path == "/" {
@@ -61,9 +68,17 @@ func (fs *FilerServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	sse, sseErr := fs.sseForRead(r, entry)
+	if sseErr != nil {
+		glog.V(1).Infof("read %s: %v", path, sseErr)
+		writeJsonError(w, r, sseErrorStatus(sseErr), sseErr)
+		return
+	}
+
 	// set etag
 	etag := filer.ETagEntry(entry)
-	if ifm := r.Header.Get("If-Match"); ifm != "" && ifm != "\""+etag+"\"" {
+	preconditionStatus := checkPreconditions(r, etag, entry.Attr.Mtime)
+	if preconditionStatus == http.StatusPreconditionFailed {
 		w.WriteHeader(http.StatusPreconditionFailed)
 		return
 	}
@@ -81,17 +96,8 @@ func (fs *FilerServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request,
 		w.Header().Set("Content-Type", mimeType)
 	}
 
-	// if modified since
 	if !entry.Attr.Mtime.IsZero() {
 		w.Header().Set("Last-Modified", entry.Attr.Mtime.UTC().Format(http.TimeFormat))
-		if r.Header.Get("If-Modified-Since") != "" {
-			if t, parseError := time.Parse(http.TimeFormat, r.Header.Get("If-Modified-Since")); parseError == nil {
-				if !t.Before(entry.Attr.Mtime) {
-					w.WriteHeader(http.StatusNotModified)
-					return
-				}
-			}
-		}
 	}
 
 	// print out the header from extended properties
@@ -122,11 +128,11 @@ func (fs *FilerServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request,
 		}
 	}
 
-	if inm := r.Header.Get("If-None-Match"); inm == "\""+etag+"\"" {
+	setEtag(w, etag)
+	if preconditionStatus == http.StatusNotModified {
 		w.WriteHeader(http.StatusNotModified)
 		return
 	}
-	setEtag(w, etag)
 
 	filename := entry.Name()
 	filename = url.QueryEscape(filename)
@@ -139,11 +145,20 @@ func (fs *FilerServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
+	chunks := entry.Chunks
+	if sse != nil {
+		if chunks, err = sse.decryptChunkKeys(fs.filer.MasterClient.GetLookupFileIdFunction(), entry.Chunks); err != nil {
+			glog.Errorf("failed to decrypt %s: %v", path, err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
 	if rangeReq := r.Header.Get("Range"); rangeReq == "" {
 		ext := filepath.Ext(filename)
 		width, height, mode, shouldResize := shouldResizeImages(ext, r)
 		if shouldResize {
-			data, err := filer.ReadAll(fs.filer.MasterClient, entry.Chunks)
+			data, err := filer.ReadAll(fs.filer.MasterClient, chunks)
 			if err != nil {
 				glog.Errorf("failed to read %s: %v", path, err)
 				w.WriteHeader(http.StatusNotModified)
@@ -163,10 +178,54 @@ func (fs *FilerServer) GetOrHeadHandler(w http.ResponseWriter, r *http.Request,
 			}
 			return err
 		}
-		err = filer.StreamContent(fs.filer.MasterClient, writer, entry.Chunks, offset, size)
+		err = filer.StreamContent(fs.filer.MasterClient, writer, chunks, offset, size)
 		if err != nil {
 			glog.Errorf("failed to stream content %s: %v", r.URL, err)
 		}
 		return err
 	})
 }
+
+// checkPreconditions evaluates the conditional request headers in the order of RFC 7232 section 6,
+// and returns http.StatusPreconditionFailed, http.StatusNotModified, or 0 if the request should proceed.
+func checkPreconditions(r *http.Request, etag string, mtime time.Time) int {
+	// the http dates have no sub-second precision
+	mtime = mtime.Truncate(time.Second)
+
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		if !etagMatches(ifMatch, etag) {
+			return http.StatusPreconditionFailed
+		}
+	} else if t, err := http.ParseTime(r.Header.Get("If-Unmodified-Since")); err == nil && !mtime.IsZero() {
+		if mtime.After(t) {
+			return http.StatusPreconditionFailed
+		}
+	}
+
+	if ifNoneMatch := r.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		if etagMatches(ifNoneMatch, etag) {
+			return http.StatusNotModified
+		}
+	} else if t, err := http.ParseTime(r.Header.Get("If-Modified-Since")); err == nil && !mtime.IsZero() {
+		if !mtime.After(t) {
+			return http.StatusNotModified
+		}
+	}
+
+	return 0
+}
+
+// etagMatches checks the etag against the list of, possibly weak, entity tags in the header, or "*" for any
+func etagMatches(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		tag = strings.TrimPrefix(tag, "W/")
+		if strings.Trim(tag, "\"") == etag {
+			return true
+		}
+	}
+	return false
+}
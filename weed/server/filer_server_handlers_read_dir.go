@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/chrislusf/seaweedfs/weed/filer"
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	ui "github.com/chrislusf/seaweedfs/weed/server/filer_ui"
 	"github.com/chrislusf/seaweedfs/weed/stats"
@@ -56,18 +57,31 @@ func (fs *FilerServer) listDirectoryHandler(w http.ResponseWriter, r *http.Reque
 	glog.V(4).Infof("listDirectory %s, last file %s, limit %d: %d items", path, lastFileName, limit, len(entries))
 
 	if r.Header.Get("Accept") == "application/json" {
+		var dirStats *filer.DirectoryStats
+		if r.FormValue("directoryStats") == "true" {
+			fs.filer.FillDirectoryStats(context.Background(), entries...)
+			dir := util.FullPath(path)
+			if dir == "" {
+				dir = "/"
+			}
+			if dirStats, err = fs.filer.FindDirectoryStats(context.Background(), dir); err != nil {
+				glog.V(0).Infof("read directory stats of %s: %v", path, err)
+			}
+		}
 		writeJsonQuiet(w, r, http.StatusOK, struct {
 			Path                  string
 			Entries               interface{}
 			Limit                 int
 			LastFileName          string
 			ShouldDisplayLoadMore bool
+			DirectoryStats        *filer.DirectoryStats `json:",omitempty"`
 		}{
 			path,
 			entries,
 			limit,
 			lastFileName,
 			shouldDisplayLoadMore,
+			dirStats,
 		})
 		return
 	}
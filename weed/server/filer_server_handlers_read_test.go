@@ -0,0 +1,47 @@
+package weed_server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckPreconditions(t *testing.T) {
+
+	mtime := time.Date(2021, 6, 1, 12, 0, 0, 500, time.UTC)
+	before := mtime.Add(-time.Hour).Format(http.TimeFormat)
+	after := mtime.Add(time.Hour).Format(http.TimeFormat)
+
+	tests := []struct {
+		headers  map[string]string
+		expected int
+	}{
+		{map[string]string{}, 0},
+		{map[string]string{"If-Match": `"abc"`}, 0},
+		{map[string]string{"If-Match": `"xyz", W/"abc"`}, 0},
+		{map[string]string{"If-Match": `"xyz"`}, http.StatusPreconditionFailed},
+		{map[string]string{"If-Match": "*"}, 0},
+		{map[string]string{"If-Unmodified-Since": before}, http.StatusPreconditionFailed},
+		{map[string]string{"If-Unmodified-Since": mtime.Format(http.TimeFormat)}, 0},
+		// If-Match takes precedence over If-Unmodified-Since
+		{map[string]string{"If-Match": `"abc"`, "If-Unmodified-Since": before}, 0},
+		{map[string]string{"If-None-Match": `"abc"`}, http.StatusNotModified},
+		{map[string]string{"If-None-Match": "*"}, http.StatusNotModified},
+		{map[string]string{"If-None-Match": `"xyz"`}, 0},
+		{map[string]string{"If-Modified-Since": after}, http.StatusNotModified},
+		{map[string]string{"If-Modified-Since": before}, 0},
+		// If-None-Match takes precedence over If-Modified-Since
+		{map[string]string{"If-None-Match": `"xyz"`, "If-Modified-Since": after}, 0},
+		{map[string]string{"If-Match": `"xyz"`, "If-None-Match": `"abc"`}, http.StatusPreconditionFailed},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", "/dir/file", nil)
+		for k, v := range tt.headers {
+			r.Header.Set(k, v)
+		}
+		assert.Equal(t, tt.expected, checkPreconditions(r, "abc", mtime), tt.headers)
+	}
+}
@@ -2,6 +2,7 @@ package weed_server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3replication"
 	"github.com/chrislusf/seaweedfs/weed/stats"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/util"
@@ -40,23 +42,54 @@ func (fs *FilerServer) autoChunk(ctx context.Context, w http.ResponseWriter, r *
 		stats.FilerRequestHistogram.WithLabelValues("chunk").Observe(time.Since(start).Seconds())
 	}()
 
+	sse, err := fs.sseForWrite(r)
+	if err != nil {
+		writeJsonError(w, r, sseErrorStatus(err), err)
+		return
+	}
+
+	// fail early if the content is known to go over the quota
+	if err := fs.filer.CheckQuota(r.URL.Path, contentLength, 0); err != nil {
+		writeJsonError(w, r, http.StatusInsufficientStorage, err)
+		return
+	}
+
+	// fail early if the create-only write would overwrite an existing entry, which is checked again when saving
+	if isCreateOnly(r) {
+		if existing, findErr := fs.filer.FindEntry(ctx, util.FullPath(r.URL.Path)); findErr == nil && existing != nil {
+			writeJsonError(w, r, http.StatusPreconditionFailed, fmt.Errorf("%w: entry %s already exists", filer.ErrEntryExists, r.URL.Path))
+			return
+		}
+	}
+
 	var reply *FilerPostResult
-	var err error
 	var md5bytes []byte
 	if r.Method == "POST" {
 		if r.Header.Get("Content-Type") == "" && strings.HasSuffix(r.URL.Path, "/") {
 			reply, err = fs.mkdir(ctx, w, r)
 		} else {
-			reply, md5bytes, err = fs.doPostAutoChunk(ctx, w, r, chunkSize, contentLength, so)
+			reply, md5bytes, err = fs.doPostAutoChunk(ctx, w, r, chunkSize, contentLength, so, sse)
 		}
 	} else {
-		reply, md5bytes, err = fs.doPutAutoChunk(ctx, w, r, chunkSize, contentLength, so)
+		reply, md5bytes, err = fs.doPutAutoChunk(ctx, w, r, chunkSize, contentLength, so, sse)
 	}
 	if err != nil {
 		if strings.HasPrefix(err.Error(), "read input:") {
 			writeJsonError(w, r, 499, err)
 		} else if strings.HasSuffix(err.Error(), "is a file") {
 			writeJsonError(w, r, http.StatusConflict, err)
+		} else if errors.Is(err, filer.ErrQuotaExceeded) {
+			writeJsonError(w, r, http.StatusInsufficientStorage, err)
+		} else if errors.Is(err, filer.ErrEntryExists) {
+			writeJsonError(w, r, http.StatusPreconditionFailed, err)
+		} else if err == ErrChecksumInvalid || err == ErrChecksumMismatch {
+			writeJsonError(w, r, http.StatusBadRequest, err)
+		} else if errors.Is(err, ErrCopySourceNotFound) {
+			writeJsonError(w, r, http.StatusNotFound, err)
+		} else if errors.Is(err, ErrCopySourcePrecondition) {
+			writeJsonError(w, r, http.StatusPreconditionFailed, err)
+		} else if err == ErrCopySourceRange {
+			writeJsonError(w, r, http.StatusRequestedRangeNotSatisfiable, err)
 		} else {
 			writeJsonError(w, r, http.StatusInternalServerError, err)
 		}
@@ -68,7 +101,7 @@ func (fs *FilerServer) autoChunk(ctx context.Context, w http.ResponseWriter, r *
 	}
 }
 
-func (fs *FilerServer) doPostAutoChunk(ctx context.Context, w http.ResponseWriter, r *http.Request, chunkSize int32, contentLength int64, so *operation.StorageOption) (filerResult *FilerPostResult, md5bytes []byte, replyerr error) {
+func (fs *FilerServer) doPostAutoChunk(ctx context.Context, w http.ResponseWriter, r *http.Request, chunkSize int32, contentLength int64, so *operation.StorageOption, sse *serverSideEncryption) (filerResult *FilerPostResult, md5bytes []byte, replyerr error) {
 
 	multipartReader, multipartReaderErr := r.MultipartReader()
 	if multipartReaderErr != nil {
@@ -89,18 +122,18 @@ func (fs *FilerServer) doPostAutoChunk(ctx context.Context, w http.ResponseWrite
 		contentType = ""
 	}
 
-	fileChunks, md5Hash, chunkOffset, err, smallContent := fs.uploadReaderToChunks(w, r, part1, chunkSize, fileName, contentType, contentLength, so)
+	fileChunks, md5Hash, chunkOffset, err, smallContent := fs.uploadReaderToChunks(w, r, part1, chunkSize, fileName, contentType, contentLength, so, sse)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	md5bytes = md5Hash.Sum(nil)
-	filerResult, replyerr = fs.saveMetaData(ctx, r, fileName, contentType, so, md5bytes, fileChunks, chunkOffset, smallContent)
+	filerResult, replyerr = fs.saveMetaData(ctx, r, fileName, contentType, so, md5bytes, fileChunks, chunkOffset, smallContent, sse, nil)
 
 	return
 }
 
-func (fs *FilerServer) doPutAutoChunk(ctx context.Context, w http.ResponseWriter, r *http.Request, chunkSize int32, contentLength int64, so *operation.StorageOption) (filerResult *FilerPostResult, md5bytes []byte, replyerr error) {
+func (fs *FilerServer) doPutAutoChunk(ctx context.Context, w http.ResponseWriter, r *http.Request, chunkSize int32, contentLength int64, so *operation.StorageOption, sse *serverSideEncryption) (filerResult *FilerPostResult, md5bytes []byte, replyerr error) {
 
 	fileName := path.Base(r.URL.Path)
 	contentType := r.Header.Get("Content-Type")
@@ -108,13 +141,33 @@ func (fs *FilerServer) doPutAutoChunk(ctx context.Context, w http.ResponseWriter
 		contentType = ""
 	}
 
-	fileChunks, md5Hash, chunkOffset, err, smallContent := fs.uploadReaderToChunks(w, r, r.Body, chunkSize, fileName, contentType, contentLength, so)
+	if isCopy(r) {
+		fileChunks, md5bytes, size, content, err := fs.copyChunks(ctx, r, so, sse)
+		if err != nil {
+			return nil, nil, err
+		}
+		filerResult, replyerr = fs.saveMetaData(ctx, r, fileName, contentType, so, md5bytes, fileChunks, size, content, sse, nil)
+		return filerResult, md5bytes, replyerr
+	}
+
+	checksum, err := parseChecksumHeaders(r.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fileChunks, md5Hash, chunkOffset, err, smallContent := fs.uploadReaderToChunks(w, r, checksum.wrap(r.Body), chunkSize, fileName, contentType, contentLength, so, sse)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if err = checksum.verify(); err != nil {
+		glog.V(1).Infof("write %s: %v", r.URL.Path, err)
+		fs.filer.DeleteChunks(fileChunks)
+		return nil, nil, err
+	}
+
 	md5bytes = md5Hash.Sum(nil)
-	filerResult, replyerr = fs.saveMetaData(ctx, r, fileName, contentType, so, md5bytes, fileChunks, chunkOffset, smallContent)
+	filerResult, replyerr = fs.saveMetaData(ctx, r, fileName, contentType, so, md5bytes, fileChunks, chunkOffset, smallContent, sse, checksum)
 
 	return
 }
@@ -123,7 +176,12 @@ func isAppend(r *http.Request) bool {
 	return r.URL.Query().Get("op") == "append"
 }
 
-func (fs *FilerServer) saveMetaData(ctx context.Context, r *http.Request, fileName string, contentType string, so *operation.StorageOption, md5bytes []byte, fileChunks []*filer_pb.FileChunk, chunkOffset int64, content []byte) (filerResult *FilerPostResult, replyerr error) {
+// isCreateOnly checks the conditional write "If-None-Match: *", which fails if the entry already exists
+func isCreateOnly(r *http.Request) bool {
+	return r.Header.Get("If-None-Match") == "*"
+}
+
+func (fs *FilerServer) saveMetaData(ctx context.Context, r *http.Request, fileName string, contentType string, so *operation.StorageOption, md5bytes []byte, fileChunks []*filer_pb.FileChunk, chunkOffset int64, content []byte, sse *serverSideEncryption, checksum *objectChecksum) (filerResult *FilerPostResult, replyerr error) {
 
 	// detect file mode
 	modeStr := r.URL.Query().Get("mode")
@@ -162,6 +220,19 @@ func (fs *FilerServer) saveMetaData(ctx context.Context, r *http.Request, fileNa
 		}
 		entry = existingEntry
 	}
+
+	// the content length may be unknown before the upload, so the quota is checked again with the actual size
+	if entry != nil {
+		replyerr = fs.filer.CheckQuota(path, chunkOffset, 0)
+	} else {
+		replyerr = fs.checkQuota(ctx, path, chunkOffset)
+	}
+	if replyerr != nil {
+		glog.V(0).Infof("write %s: %v", path, replyerr)
+		fs.filer.DeleteChunks(fileChunks)
+		return
+	}
+
 	if entry != nil {
 		entry.Mtime = time.Now()
 		entry.Md5 = nil
@@ -177,6 +248,10 @@ func (fs *FilerServer) saveMetaData(ctx context.Context, r *http.Request, fileNa
 			replyerr = fmt.Errorf("append to small file is not supported yet")
 			return
 		}
+		if sse != nil || IsServerSideEncrypted(entry.Extended) {
+			replyerr = fmt.Errorf("append to encrypted file is not supported yet")
+			return
+		}
 
 	} else {
 		glog.V(4).Infoln("saving", path)
@@ -201,6 +276,15 @@ func (fs *FilerServer) saveMetaData(ctx context.Context, r *http.Request, fileNa
 		}
 	}
 
+	// the data keys are encrypted before being saved, also in the chunk manifests
+	if sse != nil {
+		if replyerr = sse.encryptChunkKeys(fileChunks); replyerr != nil {
+			glog.V(0).Infof("encrypt %s: %v", r.RequestURI, replyerr)
+			fs.filer.DeleteChunks(fileChunks)
+			return
+		}
+	}
+
 	// maybe compact entry chunks
 	mergedChunks, replyerr = filer.MaybeManifestize(fs.saveAsChunk(so), mergedChunks)
 	if replyerr != nil {
@@ -212,6 +296,7 @@ func (fs *FilerServer) saveMetaData(ctx context.Context, r *http.Request, fileNa
 	filerResult = &FilerPostResult{
 		Name: fileName,
 		Size: int64(entry.FileSize),
+		ETag: filer.ETagEntry(entry),
 	}
 
 	if entry.Extended == nil {
@@ -226,7 +311,30 @@ func (fs *FilerServer) saveMetaData(ctx context.Context, r *http.Request, fileNa
 		}
 	}
 
-	if dbErr := fs.filer.CreateEntry(ctx, entry, false, false, nil); dbErr != nil {
+	// the encryption attributes are only set by the filer
+	delete(entry.Extended, xhttp.SeaweedServerSideEncryption)
+	delete(entry.Extended, xhttp.SeaweedServerSideEncryptionCustomerAlgorithm)
+	delete(entry.Extended, xhttp.SeaweedServerSideEncryptionCustomerKeyMD5)
+	if sse != nil {
+		for k, v := range sse.attributes {
+			entry.Extended[k] = v
+		}
+	}
+
+	// the checksum is of the whole content, which is changed by an append
+	removeChecksumAttributes(entry.Extended)
+	if checksum != nil && !isAppend(r) {
+		entry.Extended[checksum.header] = []byte(checksum.expected)
+	}
+
+	// the replication status is kept by the bucket replication, except that the replicas are marked by the s3 gateway,
+	// for the writes of the bucket replication of the source
+	delete(entry.Extended, xhttp.AmzReplicationStatus)
+	if r.Header.Get(xhttp.AmzReplicationStatus) == s3replication.StatusReplica {
+		entry.Extended[xhttp.AmzReplicationStatus] = []byte(s3replication.StatusReplica)
+	}
+
+	if dbErr := fs.filer.CreateEntry(ctx, entry, isCreateOnly(r) && !isAppend(r), false, nil); dbErr != nil {
 		fs.filer.DeleteChunks(fileChunks)
 		replyerr = dbErr
 		filerResult.Error = dbErr.Error()
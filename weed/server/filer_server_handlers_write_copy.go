@@ -0,0 +1,267 @@
+package weed_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/operation"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// The S3 gateway copies objects, and parts of multipart uploads, by writing the destination with the path of the
+// copy source in the AmzCopySourcePath header. The filer clones the chunks of the copy source into new chunks of
+// the destination collection. The whole chunks are copied by the volume servers, from needle to needle, so only
+// the partial chunks at the ends of the x-amz-copy-source-range, and the chunks to encrypt, are read by the filer.
+
+const copyChunkConcurrency = 8
+
+var (
+	ErrCopySourceNotFound     = errors.New("copy source is not found")
+	ErrCopySourcePrecondition = errors.New("copy source precondition failed")
+	ErrCopySourceRange        = errors.New("copy source range is not satisfiable")
+)
+
+// the conditions on the copy source, and the conditional request headers to evaluate them
+var copySourceConditionHeaders = map[string]string{
+	xhttp.AmzCopySourceIfMatch:           "If-Match",
+	xhttp.AmzCopySourceIfNoneMatch:       "If-None-Match",
+	xhttp.AmzCopySourceIfModifiedSince:   "If-Modified-Since",
+	xhttp.AmzCopySourceIfUnmodifiedSince: "If-Unmodified-Since",
+}
+
+func isCopy(r *http.Request) bool {
+	return r.Header.Get(xhttp.AmzCopySourcePath) != ""
+}
+
+// ParseCopySourceRange parses the x-amz-copy-source-range "bytes=first-last" of a copy source of the size
+func ParseCopySourceRange(rangeHeader string, size int64) (offset, length int64, err error) {
+	if !strings.HasPrefix(rangeHeader, "bytes=") {
+		return 0, 0, ErrCopySourceRange
+	}
+	parts := strings.SplitN(strings.TrimPrefix(rangeHeader, "bytes="), "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, ErrCopySourceRange
+	}
+	first, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, ErrCopySourceRange
+	}
+	last, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, ErrCopySourceRange
+	}
+	if first < 0 || first > last || last >= size {
+		return 0, 0, ErrCopySourceRange
+	}
+	return first, last - first + 1, nil
+}
+
+// copyChunks clones the data of the copy source, or of its x-amz-copy-source-range, into new chunks for the storage option.
+// The small copy sources kept in the filer are copied as the content.
+func (fs *FilerServer) copyChunks(ctx context.Context, r *http.Request, so *operation.StorageOption, sse *serverSideEncryption) (chunks []*filer_pb.FileChunk, md5bytes []byte, size int64, content []byte, err error) {
+
+	srcPath := r.Header.Get(xhttp.AmzCopySourcePath)
+	src, err := fs.filer.FindEntry(ctx, util.FullPath(srcPath))
+	if err == filer_pb.ErrNotFound || (err == nil && src.IsDirectory()) {
+		return nil, nil, 0, nil, fmt.Errorf("%w: %s", ErrCopySourceNotFound, srcPath)
+	}
+	if err != nil {
+		return nil, nil, 0, nil, err
+	}
+
+	conditions := &http.Request{Header: make(http.Header)}
+	for amzHeader, header := range copySourceConditionHeaders {
+		if value := r.Header.Get(amzHeader); value != "" {
+			conditions.Header.Set(header, value)
+		}
+	}
+	if checkPreconditions(conditions, filer.ETagEntry(src), src.Attr.Mtime) != 0 {
+		return nil, nil, 0, nil, fmt.Errorf("%w: %s", ErrCopySourcePrecondition, srcPath)
+	}
+
+	srcSse, err := fs.sseForEntry(src,
+		r.Header.Get(xhttp.AmzCopySourceServerSideEncryptionCustomerPrefix+"algorithm"),
+		r.Header.Get(xhttp.AmzCopySourceServerSideEncryptionCustomerPrefix+"key"),
+		r.Header.Get(xhttp.AmzCopySourceServerSideEncryptionCustomerPrefix+"key-MD5"))
+	if err != nil {
+		return nil, nil, 0, nil, err
+	}
+
+	offset, size := int64(0), int64(src.Size())
+	if rangeHeader := r.Header.Get(xhttp.AmzCopySourceRange); rangeHeader != "" {
+		if offset, size, err = ParseCopySourceRange(rangeHeader, size); err != nil {
+			return nil, nil, 0, nil, err
+		}
+	}
+	if offset == 0 && size == int64(src.Size()) {
+		// the same content has the same etag
+		md5bytes = src.Md5
+	}
+
+	if len(src.Content) > 0 {
+		if sse == nil {
+			return nil, md5bytes, size, src.Content[offset : offset+size], nil
+		}
+		// the encrypted objects always keep the data in encrypted chunks
+		chunk, err := fs.uploadCopiedData(src.Content[offset:offset+size], 0, so, true)
+		if err != nil {
+			return nil, nil, 0, nil, err
+		}
+		return []*filer_pb.FileChunk{chunk}, md5bytes, size, nil, nil
+	}
+
+	lookupFileIdFn := fs.filer.MasterClient.GetLookupFileIdFunction()
+	var srcChunks []*filer_pb.FileChunk
+	if srcSse != nil {
+		srcChunks, err = srcSse.decryptChunkKeys(lookupFileIdFn, src.Chunks)
+	} else {
+		srcChunks, _, err = filer.ResolveChunkManifest(lookupFileIdFn, src.Chunks)
+	}
+	if err != nil {
+		return nil, nil, 0, nil, err
+	}
+	srcChunkByFileId := make(map[string]*filer_pb.FileChunk)
+	for _, chunk := range srcChunks {
+		srcChunkByFileId[chunk.GetFileIdString()] = chunk
+	}
+
+	// the data is encrypted by the volume data encryption or the server side encryption of the destination
+	cipher := fs.option.Cipher || sse != nil
+
+	views := filer.ViewFromChunks(lookupFileIdFn, srcChunks, offset, size)
+	chunks = make([]*filer_pb.FileChunk, len(views))
+	var wg sync.WaitGroup
+	var errLock sync.Mutex
+	limiter := make(chan struct{}, copyChunkConcurrency)
+	for i, view := range views {
+		wg.Add(1)
+		limiter <- struct{}{}
+		go func(i int, view *filer.ChunkView) {
+			defer func() {
+				<-limiter
+				wg.Done()
+			}()
+			chunk, copyErr := fs.copyChunkView(view, srcChunkByFileId[view.FileId], view.LogicOffset-offset, so, cipher)
+			if copyErr != nil {
+				errLock.Lock()
+				err = copyErr
+				errLock.Unlock()
+				return
+			}
+			chunks[i] = chunk
+		}(i, view)
+	}
+	wg.Wait()
+
+	if err != nil {
+		var copied []*filer_pb.FileChunk
+		for _, chunk := range chunks {
+			if chunk != nil {
+				copied = append(copied, chunk)
+			}
+		}
+		fs.filer.DeleteChunks(copied)
+		return nil, nil, 0, nil, fmt.Errorf("copy %s: %v", srcPath, err)
+	}
+
+	return chunks, md5bytes, size, nil, nil
+}
+
+// copyChunkView copies the whole chunks by the volume servers, as long as the encryption is kept,
+// and reads and uploads the others
+func (fs *FilerServer) copyChunkView(view *filer.ChunkView, srcChunk *filer_pb.FileChunk, offset int64, so *operation.StorageOption, cipher bool) (*filer_pb.FileChunk, error) {
+
+	if view.IsFullChunk() && srcChunk != nil && (len(srcChunk.CipherKey) > 0 || !cipher) {
+		return fs.copyNeedle(srcChunk, offset, so)
+	}
+
+	data, err := filer.FetchChunkView(fs.filer.MasterClient.GetLookupFileIdFunction(), view)
+	if err != nil {
+		return nil, fmt.Errorf("read chunk %s: %v", view.FileId, err)
+	}
+	return fs.uploadCopiedData(data, offset, so, cipher)
+
+}
+
+func (fs *FilerServer) copyNeedle(srcChunk *filer_pb.FileChunk, offset int64, so *operation.StorageOption) (*filer_pb.FileChunk, error) {
+
+	srcFileId, err := needle.ParseFileIdFromString(srcChunk.GetFileIdString())
+	if err != nil {
+		return nil, err
+	}
+	locations, found := fs.filer.MasterClient.GetLocations(uint32(srcFileId.VolumeId))
+	if !found || len(locations) == 0 {
+		return nil, fmt.Errorf("volume %d of chunk %s is not found", srcFileId.VolumeId, srcChunk.GetFileIdString())
+	}
+
+	fileId, _, auth, err := fs.assignNewFileInfo(so)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *volume_server_pb.CopyNeedleResponse
+	for _, location := range locations {
+		err = operation.WithVolumeServerClient(location.Url, fs.grpcDialOption, func(client volume_server_pb.VolumeServerClient) error {
+			resp, err = client.CopyNeedle(context.Background(), &volume_server_pb.CopyNeedleRequest{
+				VolumeId:     uint32(srcFileId.VolumeId),
+				NeedleId:     uint64(srcFileId.Key),
+				Cookie:       uint32(srcFileId.Cookie),
+				TargetFileId: fileId,
+				TargetAuth:   string(auth),
+				TargetFsync:  so.Fsync,
+			})
+			return err
+		})
+		if err == nil {
+			break
+		}
+		glog.V(1).Infof("copy chunk %s from %s: %v", srcChunk.GetFileIdString(), location.Url, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("copy chunk %s: %v", srcChunk.GetFileIdString(), err)
+	}
+
+	// the same data, and the same data key if it is encrypted
+	chunk := proto.Clone(srcChunk).(*filer_pb.FileChunk)
+	chunk.FileId = fileId
+	chunk.Fid, _ = filer_pb.ToFileIdObject(fileId)
+	chunk.SourceFileId = ""
+	chunk.SourceFid = nil
+	// the new file id is not counted as a deduplicated chunk
+	chunk.IsDedup = false
+	chunk.Offset = offset
+	chunk.Mtime = time.Now().UnixNano()
+	if len(chunk.CipherKey) == 0 {
+		chunk.IsCompressed = resp.IsCompressed
+	}
+	return chunk, nil
+
+}
+
+func (fs *FilerServer) uploadCopiedData(data []byte, offset int64, so *operation.StorageOption, cipher bool) (*filer_pb.FileChunk, error) {
+
+	fileId, urlLocation, auth, err := fs.assignNewFileInfo(so)
+	if err != nil {
+		return nil, err
+	}
+	uploadResult, err := operation.UploadData(urlLocation, "", cipher, data, false, "", nil, auth)
+	if err != nil {
+		return nil, fmt.Errorf("upload chunk %s: %v", fileId, err)
+	}
+	return uploadResult.ToPbFileChunk(fileId, offset), nil
+
+}
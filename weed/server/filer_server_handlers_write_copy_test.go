@@ -0,0 +1,35 @@
+package weed_server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCopySourceRange(t *testing.T) {
+
+	tests := []struct {
+		rangeHeader string
+		offset      int64
+		length      int64
+		err         error
+	}{
+		{"bytes=0-99", 0, 100, nil},
+		{"bytes=100-100", 100, 1, nil},
+		{"bytes=500-999", 500, 500, nil},
+		{"bytes=500-1000", 0, 0, ErrCopySourceRange},
+		{"bytes=100-99", 0, 0, ErrCopySourceRange},
+		{"bytes=-100", 0, 0, ErrCopySourceRange},
+		{"bytes=100-", 0, 0, ErrCopySourceRange},
+		{"bytes=0-99,200-299", 0, 0, ErrCopySourceRange},
+		{"0-99", 0, 0, ErrCopySourceRange},
+	}
+
+	for _, test := range tests {
+		offset, length, err := ParseCopySourceRange(test.rangeHeader, 1000)
+		assert.Equal(t, test.err, err, test.rangeHeader)
+		assert.Equal(t, test.offset, offset, test.rangeHeader)
+		assert.Equal(t, test.length, length, test.rangeHeader)
+	}
+
+}
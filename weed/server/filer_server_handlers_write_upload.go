@@ -2,7 +2,9 @@ package weed_server
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
+	"crypto/sha256"
 	"hash"
 	"io"
 	"io/ioutil"
@@ -17,9 +19,10 @@ import (
 	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/stats"
 	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/chrislusf/seaweedfs/weed/util/fastcdc"
 )
 
-func (fs *FilerServer) uploadReaderToChunks(w http.ResponseWriter, r *http.Request, reader io.Reader, chunkSize int32, fileName, contentType string, contentLength int64, so *operation.StorageOption) ([]*filer_pb.FileChunk, hash.Hash, int64, error, []byte) {
+func (fs *FilerServer) uploadReaderToChunks(w http.ResponseWriter, r *http.Request, reader io.Reader, chunkSize int32, fileName, contentType string, contentLength int64, so *operation.StorageOption, sse *serverSideEncryption) ([]*filer_pb.FileChunk, hash.Hash, int64, error, []byte) {
 	var fileChunks []*filer_pb.FileChunk
 
 	md5Hash := md5.New()
@@ -28,20 +31,48 @@ func (fs *FilerServer) uploadReaderToChunks(w http.ResponseWriter, r *http.Reque
 	chunkOffset := int64(0)
 	var smallContent []byte
 
-	for {
-		limitedReader := io.LimitReader(partReader, int64(chunkSize))
+	// split by the content, so the same content is split into the same chunks and stored only once
+	var chunker *fastcdc.Chunker
+	var dedupScope string
+	if fs.isDedupUpload(r, so, sse) {
+		chunker = fastcdc.NewChunker(partReader, int(chunkSize)/16, int(chunkSize)/4, int(chunkSize))
+		dedupScope = filer.DedupScope(so.Collection, so.Replication, so.DiskType)
+	}
 
-		data, err := ioutil.ReadAll(limitedReader)
+	for {
+		var data []byte
+		var err error
+		if chunker != nil {
+			if data, err = chunker.Next(); err == io.EOF {
+				break
+			}
+		} else {
+			data, err = ioutil.ReadAll(io.LimitReader(partReader, int64(chunkSize)))
+		}
 		if err != nil {
 			return nil, nil, 0, err, nil
 		}
-		if chunkOffset == 0 && !isAppend(r) {
+		// the encrypted objects always keep the data in encrypted chunks
+		if chunkOffset == 0 && !isAppend(r) && sse == nil && (chunker == nil || len(data) < chunker.MinSize()) {
 			if len(data) < int(fs.option.SaveToFilerLimit) || strings.HasPrefix(r.URL.Path, filer.DirectoryEtcRoot) && len(data) < 4*1024 {
 				smallContent = data
 				chunkOffset += int64(len(data))
 				break
 			}
 		}
+
+		var contentHash []byte
+		if chunker != nil {
+			sum := sha256.Sum256(data)
+			contentHash = sum[:]
+			if chunk := fs.filer.FindDedupChunk(context.Background(), dedupScope, contentHash); chunk != nil {
+				chunk.Offset, chunk.Mtime = chunkOffset, time.Now().UnixNano()
+				fileChunks = append(fileChunks, chunk)
+				glog.V(4).Infof("deduplicated %s chunk %d to %s [%d,%d)", fileName, len(fileChunks), chunk.GetFileIdString(), chunkOffset, chunkOffset+int64(chunk.Size))
+				chunkOffset += int64(chunk.Size)
+				continue
+			}
+		}
 		dataReader := util.NewBytesReader(data)
 
 		// retry to assign a different file id
@@ -57,7 +88,7 @@ func (fs *FilerServer) uploadReaderToChunks(w http.ResponseWriter, r *http.Reque
 			}
 
 			// upload the chunk to the volume server
-			uploadResult, uploadErr, _ = fs.doUpload(urlLocation, w, r, dataReader, fileName, contentType, nil, auth)
+			uploadResult, uploadErr, _ = fs.doUpload(urlLocation, w, r, dataReader, fileName, contentType, nil, auth, fs.option.Cipher || sse != nil)
 			if uploadErr != nil {
 				time.Sleep(251 * time.Millisecond)
 				continue
@@ -72,7 +103,8 @@ func (fs *FilerServer) uploadReaderToChunks(w http.ResponseWriter, r *http.Reque
 		if uploadResult.Size == 0 {
 			break
 		}
-		if chunkOffset == 0 {
+		// the chunker reads ahead of the chunk
+		if chunkOffset == 0 && chunker == nil {
 			uploadedMd5 := util.Base64Md5ToBytes(uploadResult.ContentMd5)
 			readedMd5 := md5Hash.Sum(nil)
 			if !bytes.Equal(uploadedMd5, readedMd5) {
@@ -81,7 +113,13 @@ func (fs *FilerServer) uploadReaderToChunks(w http.ResponseWriter, r *http.Reque
 		}
 
 		// Save to chunk manifest structure
-		fileChunks = append(fileChunks, uploadResult.ToPbFileChunk(fileId, chunkOffset))
+		chunk := uploadResult.ToPbFileChunk(fileId, chunkOffset)
+		fileChunks = append(fileChunks, chunk)
+		if contentHash != nil {
+			if err := fs.filer.AddDedupChunk(context.Background(), dedupScope, contentHash, chunk); err != nil {
+				glog.V(0).Infof("index dedup chunk %s: %v", fileId, err)
+			}
+		}
 
 		glog.V(4).Infof("uploaded %s chunk %d to %s [%d,%d)", fileName, len(fileChunks), fileId, chunkOffset, chunkOffset+int64(uploadResult.Size))
 
@@ -89,7 +127,7 @@ func (fs *FilerServer) uploadReaderToChunks(w http.ResponseWriter, r *http.Reque
 		chunkOffset = chunkOffset + int64(uploadResult.Size)
 
 		// if last chunk was not at full chunk size, but already exhausted the reader
-		if int64(uploadResult.Size) < int64(chunkSize) {
+		if chunker == nil && int64(uploadResult.Size) < int64(chunkSize) {
 			break
 		}
 	}
@@ -97,7 +135,15 @@ func (fs *FilerServer) uploadReaderToChunks(w http.ResponseWriter, r *http.Reque
 	return fileChunks, md5Hash, chunkOffset, nil, smallContent
 }
 
-func (fs *FilerServer) doUpload(urlLocation string, w http.ResponseWriter, r *http.Request, limitedReader io.Reader, fileName string, contentType string, pairMap map[string]string, auth security.EncodedJwt) (*operation.UploadResult, error, []byte) {
+// the encrypted chunks and the chunks to expire are not shared
+func (fs *FilerServer) isDedupUpload(r *http.Request, so *operation.StorageOption, sse *serverSideEncryption) bool {
+	if fs.option.Cipher || sse != nil || so.TtlSeconds != 0 {
+		return false
+	}
+	return fs.filer.FilerConf.MatchStorageRule(r.URL.Path).Dedup
+}
+
+func (fs *FilerServer) doUpload(urlLocation string, w http.ResponseWriter, r *http.Request, limitedReader io.Reader, fileName string, contentType string, pairMap map[string]string, auth security.EncodedJwt, cipher bool) (*operation.UploadResult, error, []byte) {
 
 	stats.FilerRequestCounter.WithLabelValues("chunkUpload").Inc()
 	start := time.Now()
@@ -105,7 +151,7 @@ func (fs *FilerServer) doUpload(urlLocation string, w http.ResponseWriter, r *ht
 		stats.FilerRequestHistogram.WithLabelValues("chunkUpload").Observe(time.Since(start).Seconds())
 	}()
 
-	uploadResult, err, data := operation.Upload(urlLocation, fileName, fs.option.Cipher, limitedReader, false, contentType, pairMap, auth)
+	uploadResult, err, data := operation.Upload(urlLocation, fileName, cipher, limitedReader, false, contentType, pairMap, auth)
 	if uploadResult != nil && uploadResult.RetryCount > 0 {
 		stats.FilerRequestCounter.WithLabelValues("chunkUploadRetry").Add(float64(uploadResult.RetryCount))
 	}
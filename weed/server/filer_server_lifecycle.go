@@ -0,0 +1,389 @@
+package weed_server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/lifecycle"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+const (
+	lifecycleLockName      = "s3.lifecycle"
+	lifecycleStandardClass = "STANDARD"
+)
+
+// loopProcessingLifecycle periodically applies the S3 bucket lifecycle rules.
+// All filers sharing the same store take turns through a lock leased from the master,
+// so only one of them scans the buckets in each interval.
+func (fs *FilerServer) loopProcessingLifecycle(interval time.Duration) {
+
+	var running int32
+	for {
+		time.Sleep(interval)
+
+		fs.runExclusively(&running, lifecycleLockName, interval, func() {
+			glog.V(1).Infof("start processing bucket lifecycle rules")
+			fs.processLifecycle(context.Background(), time.Now())
+		})
+	}
+
+}
+
+// runExclusively runs the task holding the lock of the name, unless the task has run within the interval,
+// by this filer or by a peer filer sharing the filer store
+func (fs *FilerServer) runExclusively(running *int32, name string, interval time.Duration, task func()) {
+
+	unlock, isLocked := fs.filer.TryLockStore(running, name)
+	if !isLocked {
+		return
+	}
+	defer unlock()
+
+	ctx := context.Background()
+	key := []byte(name + ".lastRun")
+	value, err := fs.filer.Store.KvGet(ctx, key)
+	if err != nil && err != filer.ErrKvNotFound {
+		glog.Errorf("read last run of %s: %v", name, err)
+		return
+	}
+	if lastRunNs, parseErr := strconv.ParseInt(string(value), 10, 64); parseErr == nil && time.Now().UnixNano() < lastRunNs+int64(interval) {
+		return
+	}
+
+	task()
+
+	if err = fs.filer.Store.KvPut(ctx, key, []byte(strconv.FormatInt(time.Now().UnixNano(), 10))); err != nil {
+		glog.Errorf("write last run of %s: %v", name, err)
+	}
+}
+
+func (fs *FilerServer) processLifecycle(ctx context.Context, now time.Time) {
+
+	err := fs.listEntriesByPage(ctx, util.FullPath(fs.filer.DirBucketsPath), func(bucketEntry *filer.Entry) error {
+		if !bucketEntry.IsDirectory() || bucketEntry.Extended == nil || len(bucketEntry.Extended[xhttp.AmzBucketLifecycle]) == 0 {
+			return nil
+		}
+		rules, parseErr := lifecycle.Parse(bucketEntry.Extended[xhttp.AmzBucketLifecycle])
+		if parseErr != nil {
+			glog.Errorf("parse lifecycle rules of %s: %v", bucketEntry.FullPath, parseErr)
+			return nil
+		}
+		scanner := &lifecycleScanner{
+			fs:         fs,
+			bucketDir:  bucketEntry.FullPath,
+			rules:      rules,
+			versioning: string(bucketEntry.Extended[xhttp.AmzBucketVersioning]),
+			now:        now,
+		}
+		if scanErr := scanner.scanObjects(ctx, bucketEntry.FullPath); scanErr != nil {
+			glog.Errorf("apply lifecycle rules to %s: %v", bucketEntry.FullPath, scanErr)
+		}
+		return nil
+	})
+	if err != nil {
+		glog.Errorf("list buckets under %s: %v", fs.filer.DirBucketsPath, err)
+	}
+
+}
+
+// listEntriesByPage visits all entries of one directory, page by page,
+// so entries can be deleted or moved while visiting.
+func (fs *FilerServer) listEntriesByPage(ctx context.Context, dir util.FullPath, fn func(entry *filer.Entry) error) error {
+	lastFileName := ""
+	for {
+		entries, hasMore, err := fs.filer.ListDirectoryEntries(ctx, dir, lastFileName, false, filer.PaginationSize, "", "", "")
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err = fn(entry); err != nil {
+				return err
+			}
+			lastFileName = entry.Name()
+		}
+		if !hasMore || len(entries) == 0 {
+			return nil
+		}
+	}
+}
+
+type lifecycleScanner struct {
+	fs         *FilerServer
+	bucketDir  util.FullPath
+	rules      *lifecycle.Lifecycle
+	versioning string
+	now        time.Time
+}
+
+func (s *lifecycleScanner) objectKey(p util.FullPath) string {
+	return strings.TrimPrefix(string(p), string(s.bucketDir)+"/")
+}
+
+func objectTags(entry *filer.Entry) map[string]string {
+	tags := make(map[string]string)
+	for k, v := range entry.Extended {
+		if strings.HasPrefix(k, xhttp.AmzObjectTagging+"-") {
+			tags[k[len(xhttp.AmzObjectTagging)+1:]] = string(v)
+		}
+	}
+	return tags
+}
+
+func objectVersionId(entry *filer.Entry) string {
+	if v, found := entry.Extended[xhttp.SeaweedVersionId]; found && len(v) > 0 {
+		return string(v)
+	}
+	return s3_constants.NullVersionId
+}
+
+func isDeleteMarker(entry *filer.Entry) bool {
+	_, found := entry.Extended[xhttp.SeaweedDeleteMarker]
+	return found
+}
+
+func (s *lifecycleScanner) scanObjects(ctx context.Context, dir util.FullPath) error {
+	return s.fs.listEntriesByPage(ctx, dir, func(entry *filer.Entry) error {
+		if entry.IsDirectory() {
+			if dir == s.bucketDir && entry.Name() == ".uploads" {
+				return s.scanUploads(ctx, entry.FullPath)
+			}
+			if dir == s.bucketDir && entry.Name() == s3_constants.VersionsFolder {
+				return s.scanVersions(ctx, entry.FullPath)
+			}
+			return s.scanObjects(ctx, entry.FullPath)
+		}
+		key, tags := s.objectKey(entry.FullPath), objectTags(entry)
+		if s.rules.ExpireObject(key, tags, entry.Mtime, s.now) {
+			if err := s.expireObject(ctx, entry); err != nil {
+				glog.Errorf("expire %s: %v", entry.FullPath, err)
+			}
+			return nil
+		}
+		if storageClass := s.rules.TransitionObject(key, tags, entry.Mtime, s.now); storageClass != "" {
+			if err := s.transitionObject(ctx, entry, storageClass); err != nil {
+				glog.Errorf("transition %s to %s: %v", entry.FullPath, storageClass, err)
+			}
+		}
+		return nil
+	})
+}
+
+// expireObject deletes the current version of an object.
+// On a versioned bucket, the object is kept as an older version and a delete marker becomes the current version.
+func (s *lifecycleScanner) expireObject(ctx context.Context, entry *filer.Entry) error {
+
+	glog.V(1).Infof("lifecycle expires %s", entry.FullPath)
+
+	if s.versioning == "" {
+		return s.fs.filer.DeleteEntryMetaAndData(ctx, entry.FullPath, false, false, true, false, nil)
+	}
+
+	versionsDir := util.FullPath(fmt.Sprintf("%s/%s/%s", s.bucketDir, s3_constants.VersionsFolder, s.objectKey(entry.FullPath)))
+
+	markerVersionId := s3_constants.NewVersionId()
+	if s.versioning == s3_constants.VersioningSuspended {
+		// a suspended bucket replaces the "null" version
+		markerVersionId = s3_constants.NullVersionId
+		if err := s.fs.filer.DeleteEntryMetaAndData(ctx, versionsDir.Child(markerVersionId), false, false, true, false, nil); err != nil && err != filer_pb.ErrNotFound {
+			return err
+		}
+	}
+
+	if s.versioning == s3_constants.VersioningEnabled || objectVersionId(entry) != s3_constants.NullVersionId {
+		dir, name := entry.FullPath.DirAndName()
+		if _, err := s.fs.AtomicRenameEntry(ctx, &filer_pb.AtomicRenameEntryRequest{
+			OldDirectory: dir,
+			OldName:      name,
+			NewDirectory: string(versionsDir),
+			NewName:      objectVersionId(entry),
+		}); err != nil {
+			return err
+		}
+	} else if err := s.fs.filer.DeleteEntryMetaAndData(ctx, entry.FullPath, false, false, true, false, nil); err != nil {
+		return err
+	}
+
+	return s.fs.filer.CreateEntry(ctx, &filer.Entry{
+		FullPath: versionsDir.Child(markerVersionId),
+		Attr: filer.Attr{
+			Mtime:  s.now,
+			Crtime: s.now,
+			Mode:   0770,
+			Uid:    filer.OS_UID,
+			Gid:    filer.OS_GID,
+		},
+		Extended: map[string][]byte{
+			xhttp.SeaweedVersionId:    []byte(markerVersionId),
+			xhttp.SeaweedDeleteMarker: []byte("true"),
+		},
+	}, false, false, nil)
+}
+
+// scanVersions walks <bucket>/.versions, where each folder keeps the older versions of one object
+func (s *lifecycleScanner) scanVersions(ctx context.Context, dir util.FullPath) error {
+	var versions []*filer.Entry
+	err := s.fs.listEntriesByPage(ctx, dir, func(entry *filer.Entry) error {
+		if entry.IsDirectory() {
+			return s.scanVersions(ctx, entry.FullPath)
+		}
+		versions = append(versions, entry)
+		return nil
+	})
+	if err != nil || len(versions) == 0 {
+		return err
+	}
+
+	key := strings.TrimPrefix(string(dir), fmt.Sprintf("%s/%s/", s.bucketDir, s3_constants.VersionsFolder))
+	sort.SliceStable(versions, func(i, j int) bool {
+		if !versions[i].Mtime.Equal(versions[j].Mtime) {
+			return versions[i].Mtime.After(versions[j].Mtime)
+		}
+		return versions[i].Name() < versions[j].Name()
+	})
+
+	// the newest first, starting with the current version if the object still exists
+	current, findErr := s.fs.filer.FindEntry(ctx, s.bucketDir.Child(key))
+	if findErr != nil && findErr != filer_pb.ErrNotFound {
+		return findErr
+	}
+	all := versions
+	if current != nil && !current.IsDirectory() {
+		all = append([]*filer.Entry{current}, versions...)
+	}
+
+	remaining := []*filer.Entry{all[0]}
+	for i := 1; i < len(all); i++ {
+		noncurrentSince := all[i-1].Mtime
+		if s.rules.ExpireNoncurrentVersion(key, objectTags(all[i]), noncurrentSince, len(remaining)-1, s.now) {
+			glog.V(1).Infof("lifecycle expires noncurrent version %s", all[i].FullPath)
+			if err = s.fs.filer.DeleteEntryMetaAndData(ctx, all[i].FullPath, false, false, true, false, nil); err != nil {
+				glog.Errorf("expire noncurrent version %s: %v", all[i].FullPath, err)
+				remaining = append(remaining, all[i])
+			}
+			continue
+		}
+		remaining = append(remaining, all[i])
+	}
+
+	if len(remaining) == 1 && isDeleteMarker(remaining[0]) && s.rules.ExpireDeleteMarker(key) {
+		glog.V(1).Infof("lifecycle removes expired delete marker %s", remaining[0].FullPath)
+		if err = s.fs.filer.DeleteEntryMetaAndData(ctx, remaining[0].FullPath, false, false, true, false, nil); err != nil {
+			glog.Errorf("remove expired delete marker %s: %v", remaining[0].FullPath, err)
+		}
+	}
+
+	return nil
+}
+
+// scanUploads aborts the stale multipart uploads kept under <bucket>/.uploads
+func (s *lifecycleScanner) scanUploads(ctx context.Context, dir util.FullPath) error {
+	return s.fs.listEntriesByPage(ctx, dir, func(entry *filer.Entry) error {
+		if !entry.IsDirectory() || entry.Extended == nil {
+			return nil
+		}
+		key := strings.TrimPrefix(string(entry.Extended["key"]), "/")
+		if !s.rules.AbortMultipartUpload(key, entry.Crtime, s.now) {
+			return nil
+		}
+		glog.V(1).Infof("lifecycle aborts multipart upload %s of %s", entry.Name(), key)
+		if err := s.fs.filer.DeleteEntryMetaAndData(ctx, entry.FullPath, true, true, true, false, nil); err != nil {
+			glog.Errorf("abort multipart upload %s: %v", entry.FullPath, err)
+		}
+		return nil
+	})
+}
+
+// transitionObject re-homes the object chunks to volumes on the disk type named after the storage class,
+// e.g. "GLACIER" goes to volumes with disk type "glacier", while "STANDARD" goes to the default disk type.
+func (s *lifecycleScanner) transitionObject(ctx context.Context, entry *filer.Entry, storageClass string) error {
+
+	currentClass := lifecycleStandardClass
+	if v, found := entry.Extended[xhttp.AmzStorageClass]; found {
+		currentClass = string(v)
+	}
+	if currentClass == storageClass {
+		return nil
+	}
+	// the data keys of the encrypted objects are not available here
+	if IsServerSideEncrypted(entry.Extended) {
+		glog.V(1).Infof("lifecycle skips transitioning encrypted %s", entry.FullPath)
+		return nil
+	}
+
+	glog.V(1).Infof("lifecycle transitions %s from %s to %s", entry.FullPath, currentClass, storageClass)
+
+	diskType := ""
+	if storageClass != lifecycleStandardClass {
+		diskType = strings.ToLower(storageClass)
+	}
+	so := s.fs.detectStorageOption(string(entry.FullPath), "", "", 0, diskType, "", "")
+	saveFunc := s.fs.saveAsChunk(so)
+
+	dataChunks, _, err := filer.ResolveChunkManifest(s.fs.filer.MasterClient.GetLookupFileIdFunction(), entry.Chunks)
+	if err != nil {
+		return err
+	}
+
+	var chunks []*filer_pb.FileChunk
+	for _, chunk := range dataChunks {
+		data, readErr := filer.ReadAll(s.fs.filer.MasterClient, []*filer_pb.FileChunk{chunk})
+		if readErr != nil {
+			s.fs.filer.DeleteChunks(chunks)
+			return readErr
+		}
+		newChunk, _, _, saveErr := saveFunc(bytes.NewReader(data), entry.Name(), chunk.Offset)
+		if saveErr != nil {
+			s.fs.filer.DeleteChunks(chunks)
+			return saveErr
+		}
+		// keep the modification time, which decides how overlapping chunks are resolved
+		newChunk.Mtime = chunk.Mtime
+		chunks = append(chunks, newChunk)
+	}
+	if chunks, err = filer.MaybeManifestize(saveFunc, chunks); err != nil {
+		return err
+	}
+
+	newEntry := &filer.Entry{
+		FullPath:        entry.FullPath,
+		Attr:            entry.Attr,
+		Extended:        make(map[string][]byte),
+		Chunks:          chunks,
+		HardLinkId:      entry.HardLinkId,
+		HardLinkCounter: entry.HardLinkCounter,
+		Content:         entry.Content,
+	}
+	for k, v := range entry.Extended {
+		newEntry.Extended[k] = v
+	}
+	newEntry.Extended[xhttp.AmzStorageClass] = []byte(storageClass)
+	newEntry.DiskType = so.DiskType
+
+	// the object could be overwritten while its data is copied
+	latest, err := s.fs.filer.FindEntry(ctx, entry.FullPath)
+	if err != nil || !latest.Mtime.Equal(entry.Mtime) || len(latest.Chunks) != len(entry.Chunks) {
+		s.fs.filer.DeleteChunks(chunks)
+		return err
+	}
+
+	// the data is only moved, which is allowed for locked objects
+	if err = s.fs.filer.UpdateEntry(filer.WithMovingEntry(ctx), entry, newEntry); err != nil {
+		s.fs.filer.DeleteChunks(chunks)
+		return err
+	}
+	s.fs.filer.NotifyUpdateEvent(ctx, entry, newEntry, true, false, nil)
+	s.fs.filer.DeleteChunks(entry.Chunks)
+
+	return nil
+}
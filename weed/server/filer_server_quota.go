@@ -0,0 +1,68 @@
+package weed_server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+// checkQuota checks writing a file of the size to the path, which does not add an object if the file exists
+func (fs *FilerServer) checkQuota(ctx context.Context, path string, size int64) error {
+	err := fs.filer.CheckQuota(path, size, 1)
+	if errors.Is(err, filer.ErrQuotaExceeded) {
+		if existing, findErr := fs.filer.FindEntry(ctx, util.FullPath(path)); findErr == nil && !existing.IsDirectory() {
+			err = fs.filer.CheckQuota(path, size-int64(existing.Size()), 0)
+		}
+	}
+	return err
+}
+
+func (fs *FilerServer) GetDirectoryUsage(ctx context.Context, req *filer_pb.GetDirectoryUsageRequest) (*filer_pb.GetDirectoryUsageResponse, error) {
+
+	resp := &filer_pb.GetDirectoryUsageResponse{}
+	added := make(map[string]bool)
+	addUsage := func(location string, rule *filer_pb.FilerConf_PathConf) {
+		if added[location] {
+			return
+		}
+		usage, found := fs.filer.DirectoryUsage(location)
+		if !found && rule == nil {
+			return
+		}
+		added[location] = true
+		directoryUsage := &filer_pb.DirectoryUsage{
+			LocationPrefix: location,
+			UsedBytes:      usage.Bytes,
+			UsedObjects:    usage.Objects,
+		}
+		if rule != nil {
+			directoryUsage.QuotaBytes = rule.QuotaBytes
+			directoryUsage.QuotaObjects = rule.QuotaObjects
+		}
+		resp.Usages = append(resp.Usages, directoryUsage)
+	}
+
+	rules := fs.filer.FilerConf.QuotaRules()
+	rulesByLocation := make(map[string]*filer_pb.FilerConf_PathConf)
+	for _, rule := range rules {
+		rulesByLocation[rule.LocationPrefix] = rule
+	}
+
+	if req.Path != "" {
+		subDirPrefix := strings.TrimSuffix(req.Path, "/") + "/"
+		for _, rule := range rules {
+			if strings.HasPrefix(req.Path, rule.LocationPrefix) || strings.HasPrefix(rule.LocationPrefix, subDirPrefix) {
+				addUsage(rule.LocationPrefix, rule)
+			}
+		}
+	}
+	for _, location := range req.LocationPrefixes {
+		addUsage(location, rulesByLocation[location])
+	}
+
+	return resp, nil
+}
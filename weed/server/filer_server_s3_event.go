@@ -0,0 +1,229 @@
+package weed_server
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3_constants"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3event"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/chrislusf/seaweedfs/weed/util/log_buffer"
+)
+
+// s3EventNotifier sends the S3 events of the changes made through this filer,
+// so each change is only notified once even with several filers.
+type s3EventNotifier struct {
+	// keyed by the target ARN
+	queues map[string]*s3event.Queue
+
+	sync.Mutex
+	// keyed by the bucket name, loaded when needed and dropped when the bucket entry changes
+	buckets map[string]*bucketNotification
+}
+
+type bucketNotification struct {
+	config *s3event.NotificationConfiguration
+	owner  string
+}
+
+func newS3EventNotifier(queues map[string]*s3event.Queue) *s3EventNotifier {
+	return &s3EventNotifier{
+		queues:  queues,
+		buckets: make(map[string]*bucketNotification),
+	}
+}
+
+// loopSendingS3Events follows the local metadata changes since the filer started
+func (fs *FilerServer) loopSendingS3Events() {
+	fs.loopFollowingLocalMetadata("s3 events", fs.onS3Event)
+}
+
+// loopFollowingLocalMetadata calls the function for each metadata change made through this filer since it started
+func (fs *FilerServer) loopFollowingLocalMetadata(name string, fn func(dir string, message *filer_pb.EventNotification, tsNs int64)) {
+
+	lastReadTime := time.Now()
+
+	eachLogEntryFn := eachLogEntryFn(func(dirPath string, eventNotification *filer_pb.EventNotification, tsNs int64) error {
+		fn(dirPath, eventNotification, tsNs)
+		return nil
+	})
+
+	for {
+		processedTsNs, err := fs.filer.ReadPersistedLogBuffer(lastReadTime, eachLogEntryFn)
+		if err != nil {
+			glog.Errorf("%s reading from persisted logs: %v", name, err)
+			time.Sleep(3127 * time.Millisecond)
+			continue
+		}
+		if processedTsNs != 0 {
+			lastReadTime = time.Unix(0, processedTsNs)
+		}
+
+		lastReadTime, err = fs.filer.LocalMetaLogBuffer.LoopProcessLogData(lastReadTime, func() bool {
+			fs.listenersLock.Lock()
+			fs.listenersCond.Wait()
+			fs.listenersLock.Unlock()
+			return true
+		}, eachLogEntryFn)
+		if err != nil && err != log_buffer.ResumeFromDiskError {
+			glog.Errorf("%s processed to %v: %v", name, lastReadTime, err)
+			time.Sleep(3127 * time.Millisecond)
+		}
+	}
+}
+
+func (fs *FilerServer) onS3Event(dir string, message *filer_pb.EventNotification, tsNs int64) {
+
+	bucketsPath := fs.filer.DirBucketsPath
+	if dir == bucketsPath {
+		// the bucket entry is changed, maybe with a new notification configuration
+		if message.OldEntry != nil {
+			fs.s3Events.forgetBucket(message.OldEntry.Name)
+		}
+		if message.NewEntry != nil {
+			fs.s3Events.forgetBucket(message.NewEntry.Name)
+		}
+		return
+	}
+	if !strings.HasPrefix(dir, bucketsPath+"/") {
+		return
+	}
+
+	var oldPath, newPath string
+	if message.OldEntry != nil && !message.OldEntry.IsDirectory {
+		oldPath = string(util.NewFullPath(dir, message.OldEntry.Name))
+	}
+	if message.NewEntry != nil && !message.NewEntry.IsDirectory {
+		newParentPath := message.NewParentPath
+		if newParentPath == "" {
+			newParentPath = dir
+		}
+		newPath = string(util.NewFullPath(newParentPath, message.NewEntry.Name))
+	}
+
+	if oldPath != "" && oldPath == newPath && sameS3ObjectData(message.OldEntry, message.NewEntry) {
+		// only the metadata is changed
+		return
+	}
+
+	newBucket, newKey, newIsVersion := fs.bucketAndKey(newPath)
+	if oldPath != "" && oldPath != newPath {
+		if bucket, key, isVersion := fs.bucketAndKey(oldPath); key != "" && !isVersion && !(newIsVersion && newBucket == bucket) {
+			// moving the object to the older versions is not a deletion
+			fs.notifyS3Event(s3event.ObjectRemovedDelete, bucket, key, message.OldEntry, tsNs)
+		}
+	}
+	if newKey != "" {
+		if !newIsVersion {
+			fs.notifyS3Event(s3event.ObjectCreatedPut, newBucket, newKey, message.NewEntry, tsNs)
+		} else if message.OldEntry == nil && message.NewEntry.Extended != nil && message.NewEntry.Extended[xhttp.SeaweedDeleteMarker] != nil {
+			fs.notifyS3Event(s3event.ObjectRemovedDeleteMarkerCreated, newBucket, newKey, message.NewEntry, tsNs)
+		}
+	}
+
+}
+
+// bucketAndKey splits the path of an object, or an object version, into the bucket and the object key.
+// The key is empty for the files not visible as objects, e.g., the multipart upload parts.
+func (fs *FilerServer) bucketAndKey(path string) (bucket, key string, isVersion bool) {
+	prefix := fs.filer.DirBucketsPath + "/"
+	if !strings.HasPrefix(path, prefix) {
+		return
+	}
+	parts := strings.SplitN(path[len(prefix):], "/", 2)
+	if len(parts) < 2 || parts[1] == "" {
+		return
+	}
+	bucket, key = parts[0], parts[1]
+	if strings.HasPrefix(key, ".uploads/") {
+		return bucket, "", false
+	}
+	if strings.HasPrefix(key, s3_constants.VersionsFolder+"/") {
+		// <bucket>/.versions/<object>/<versionId>
+		key = strings.TrimPrefix(key, s3_constants.VersionsFolder+"/")
+		if t := strings.LastIndex(key, "/"); t > 0 {
+			return bucket, key[:t], true
+		}
+		return bucket, "", true
+	}
+	return
+}
+
+func sameS3ObjectData(a, b *filer_pb.Entry) bool {
+	return filer.FileSize(a) == filer.FileSize(b) && filer.ETag(a) == filer.ETag(b) && bytes.Equal(a.Content, b.Content)
+}
+
+func (fs *FilerServer) notifyS3Event(eventName, bucket, key string, entry *filer_pb.Entry, tsNs int64) {
+
+	notification := fs.s3Events.getBucket(fs.filer, bucket)
+	if notification.config == nil {
+		return
+	}
+	destinations := notification.config.Match(eventName, key)
+	if len(destinations) == 0 {
+		return
+	}
+
+	change := &s3event.ObjectChange{
+		EventName: eventName,
+		Bucket:    bucket,
+		Owner:     notification.owner,
+		Key:       key,
+		Size:      int64(filer.FileSize(entry)),
+		ETag:      filer.ETag(entry),
+		TsNs:      tsNs,
+	}
+	if entry.Extended != nil {
+		change.VersionId = string(entry.Extended[xhttp.SeaweedVersionId])
+	}
+
+	for _, destination := range destinations {
+		queue, found := fs.s3Events.queues[destination.Arn]
+		if !found {
+			glog.V(1).Infof("s3 event target %s of bucket %s is not enabled", destination.Arn, bucket)
+			continue
+		}
+		queue.Add(bucket+"/"+key, s3event.NewEvent(change, destination))
+	}
+
+}
+
+func (n *s3EventNotifier) getBucket(f *filer.Filer, bucket string) *bucketNotification {
+	n.Lock()
+	defer n.Unlock()
+
+	if notification, found := n.buckets[bucket]; found {
+		return notification
+	}
+
+	notification := &bucketNotification{}
+	entry, err := f.FindEntry(context.Background(), util.NewFullPath(f.DirBucketsPath, bucket))
+	if err != nil {
+		if err != filer_pb.ErrNotFound {
+			glog.Errorf("read bucket %s: %v", bucket, err)
+			return notification
+		}
+	} else if entry.Extended != nil {
+		notification.owner = string(entry.Extended[xhttp.AmzIdentityId])
+		if data := entry.Extended[xhttp.AmzBucketNotification]; len(data) > 0 {
+			if notification.config, err = s3event.Parse(data); err != nil {
+				glog.Errorf("parse notification configuration of bucket %s: %v", bucket, err)
+			}
+		}
+	}
+	n.buckets[bucket] = notification
+	return notification
+}
+
+func (n *s3EventNotifier) forgetBucket(bucket string) {
+	n.Lock()
+	defer n.Unlock()
+	delete(n.buckets, bucket)
+}
@@ -0,0 +1,97 @@
+package weed_server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3event"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/stretchr/testify/assert"
+)
+
+type testS3EventTarget struct {
+	events chan *s3event.Event
+}
+
+func (t *testS3EventTarget) GetName() string {
+	return "test"
+}
+
+func (t *testS3EventTarget) Initialize(configuration util.Configuration, prefix string) error {
+	return nil
+}
+
+func (t *testS3EventTarget) Send(key string, event *s3event.Event) error {
+	t.events <- event
+	return nil
+}
+
+func (t *testS3EventTarget) next() (eventName, key string) {
+	select {
+	case event := <-t.events:
+		return event.Records[0].EventName, event.Records[0].S3.Object.Key
+	case <-time.After(time.Second):
+		return "", ""
+	}
+}
+
+func TestS3EventNotification(t *testing.T) {
+	target := &testS3EventTarget{events: make(chan *s3event.Event, 16)}
+	fs := &FilerServer{filer: &filer.Filer{DirBucketsPath: "/buckets"}}
+	fs.s3Events = newS3EventNotifier(map[string]*s3event.Queue{
+		s3event.TargetArn("test"): s3event.NewQueue(target, 0),
+	})
+	fs.s3Events.buckets["b1"] = &bucketNotification{
+		config: &s3event.NotificationConfiguration{
+			QueueConfigurations: []s3event.QueueConfiguration{{
+				Rule: s3event.Rule{Events: []string{"s3:ObjectCreated:*", "s3:ObjectRemoved:*"}},
+				Arn:  s3event.TargetArn("test"),
+			}},
+		},
+	}
+	fs.s3Events.buckets["b2"] = &bucketNotification{}
+
+	file := func(name string, size uint64) *filer_pb.Entry {
+		return &filer_pb.Entry{Name: name, Attributes: &filer_pb.FuseAttributes{FileSize: size}}
+	}
+
+	// created, overwritten, and only the metadata changed
+	fs.onS3Event("/buckets/b1/dir", &filer_pb.EventNotification{NewEntry: file("a b", 1)}, 1)
+	fs.onS3Event("/buckets/b1/dir", &filer_pb.EventNotification{OldEntry: file("a b", 1), NewEntry: file("a b", 2)}, 2)
+	fs.onS3Event("/buckets/b1/dir", &filer_pb.EventNotification{OldEntry: file("a b", 2), NewEntry: file("a b", 2)}, 3)
+	eventName, key := target.next()
+	assert.Equal(t, "ObjectCreated:Put", eventName)
+	assert.Equal(t, "dir/a+b", key)
+	eventName, _ = target.next()
+	assert.Equal(t, "ObjectCreated:Put", eventName)
+
+	// the multipart upload parts and the buckets without notifications
+	fs.onS3Event("/buckets/b1/.uploads/123", &filer_pb.EventNotification{NewEntry: file("0001.part", 1)}, 4)
+	fs.onS3Event("/buckets/b2", &filer_pb.EventNotification{NewEntry: file("x", 1)}, 5)
+
+	// moved to the older versions, and a delete marker is created
+	fs.onS3Event("/buckets/b1/dir", &filer_pb.EventNotification{OldEntry: file("a b", 2), NewEntry: file("v1", 2), NewParentPath: "/buckets/b1/.versions/dir/a b"}, 6)
+	marker := file("v2", 0)
+	marker.Extended = map[string][]byte{xhttp.SeaweedDeleteMarker: []byte("true"), xhttp.SeaweedVersionId: []byte("v2")}
+	fs.onS3Event("/buckets/b1/.versions/dir/a b", &filer_pb.EventNotification{NewEntry: marker}, 7)
+	eventName, key = target.next()
+	assert.Equal(t, "ObjectRemoved:DeleteMarkerCreated", eventName)
+	assert.Equal(t, "dir/a+b", key)
+
+	// deleted
+	fs.onS3Event("/buckets/b1", &filer_pb.EventNotification{OldEntry: file("c", 1)}, 8)
+	eventName, key = target.next()
+	assert.Equal(t, "ObjectRemoved:Delete", eventName)
+	assert.Equal(t, "c", key)
+
+	// a bucket entry change reloads the configuration
+	fs.onS3Event("/buckets", &filer_pb.EventNotification{OldEntry: &filer_pb.Entry{Name: "b1", IsDirectory: true}, NewEntry: &filer_pb.Entry{Name: "b1", IsDirectory: true}}, 9)
+	_, found := fs.s3Events.buckets["b1"]
+	assert.False(t, found)
+
+	eventName, _ = target.next()
+	assert.Equal(t, "", eventName)
+}
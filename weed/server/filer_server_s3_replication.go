@@ -0,0 +1,453 @@
+package weed_server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3replication"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+const (
+	s3ReplicationWorkers   = 4
+	s3ReplicationQueueSize = 1024
+	// how often the objects left pending are looked for, after the queue was full
+	s3ReplicationRescanInterval = 10 * time.Minute
+)
+
+// s3Replicator replicates the objects changed through this filer to the destination buckets of the replication rules,
+// so each change is only replicated once even with several filers.
+//
+// The objects are marked PENDING before being queued. The objects not queued because the queue is full,
+// or still queued when the filer stops, are left PENDING, and found again by scanning the buckets
+// when the filer starts, and after the queue was full.
+type s3Replicator struct {
+	// keyed by the target name
+	targets map[string]*s3replication.Target
+	tasks   chan *s3ReplicationTask
+
+	sync.Mutex
+	// keyed by the bucket name, loaded when needed and dropped when the bucket entry changes
+	buckets map[string]*s3replication.ReplicationConfiguration
+	// the etags of the queued objects, keyed by "<bucket>/<key>", so the same object is not queued twice
+	queued map[string]string
+	// whether any task is not queued since the last scan
+	overflowed bool
+}
+
+// s3ReplicationTask replicates one version of an object, or its deletion, to all its destinations
+type s3ReplicationTask struct {
+	bucket       string
+	key          string
+	etag         string
+	isDelete     bool
+	destinations []*s3replication.Rule
+}
+
+func newS3Replicator(targets map[string]*s3replication.Target) *s3Replicator {
+	return &s3Replicator{
+		targets: targets,
+		tasks:   make(chan *s3ReplicationTask, s3ReplicationQueueSize),
+		buckets: make(map[string]*s3replication.ReplicationConfiguration),
+		queued:  make(map[string]string),
+	}
+}
+
+func (fs *FilerServer) loopReplicatingS3Objects() {
+	for i := 0; i < s3ReplicationWorkers; i++ {
+		go func() {
+			for task := range fs.s3Replication.tasks {
+				fs.replicateS3Object(task)
+				fs.s3Replication.done(task)
+			}
+		}()
+	}
+	go fs.loopQueueingPendingS3Objects()
+	fs.loopFollowingLocalMetadata("s3 replication", fs.onS3Replication)
+}
+
+func (fs *FilerServer) onS3Replication(dir string, message *filer_pb.EventNotification, tsNs int64) {
+
+	bucketsPath := fs.filer.DirBucketsPath
+	if dir == bucketsPath {
+		// the bucket entry is changed, maybe with a new replication configuration
+		if message.OldEntry != nil {
+			fs.s3Replication.forgetBucket(message.OldEntry.Name)
+		}
+		if message.NewEntry != nil {
+			fs.s3Replication.forgetBucket(message.NewEntry.Name)
+		}
+		return
+	}
+	if !strings.HasPrefix(dir, bucketsPath+"/") {
+		return
+	}
+
+	var oldPath, newPath string
+	if message.OldEntry != nil && !message.OldEntry.IsDirectory {
+		oldPath = string(util.NewFullPath(dir, message.OldEntry.Name))
+	}
+	if message.NewEntry != nil && !message.NewEntry.IsDirectory {
+		newParentPath := message.NewParentPath
+		if newParentPath == "" {
+			newParentPath = dir
+		}
+		newPath = string(util.NewFullPath(newParentPath, message.NewEntry.Name))
+	}
+
+	if oldPath != "" && oldPath == newPath && sameS3ObjectData(message.OldEntry, message.NewEntry) {
+		// only the metadata is changed, e.g. the replication status
+		return
+	}
+
+	newBucket, newKey, newIsVersion := fs.bucketAndKey(newPath)
+	if oldPath != "" && oldPath != newPath {
+		if bucket, key, isVersion := fs.bucketAndKey(oldPath); key != "" && !isVersion && !(newIsVersion && newBucket == bucket) {
+			// moving the object to the older versions is not a deletion
+			fs.queueS3ReplicationDelete(bucket, key)
+		}
+	}
+	if newKey != "" {
+		if !newIsVersion {
+			fs.queueS3Replication(newBucket, newKey, message.NewEntry)
+		} else if message.OldEntry == nil && message.NewEntry.Extended != nil && message.NewEntry.Extended[xhttp.SeaweedDeleteMarker] != nil {
+			fs.queueS3ReplicationDelete(newBucket, newKey)
+		}
+	}
+
+}
+
+func (fs *FilerServer) queueS3Replication(bucket, key string, entry *filer_pb.Entry) {
+
+	config := fs.s3Replication.getBucket(fs.filer, bucket)
+	if config == nil {
+		return
+	}
+	if entry.Extended != nil && string(entry.Extended[xhttp.AmzReplicationStatus]) == s3replication.StatusReplica {
+		// the replicas are not replicated again, which also prevents loops of two-way replication
+		return
+	}
+	destinations := config.Destinations(key, objectTags(filer.FromPbEntry("", entry)))
+	if len(destinations) == 0 {
+		return
+	}
+
+	task := &s3ReplicationTask{
+		bucket:       bucket,
+		key:          key,
+		etag:         filer.ETag(entry),
+		destinations: destinations,
+	}
+	if fs.s3Replication.isQueued(task) || !fs.setS3ReplicationStatus(task, s3replication.StatusPending) {
+		return
+	}
+	if !fs.s3Replication.enqueue(task) {
+		glog.Warningf("s3 replication queue is full, %s/%s is left pending", bucket, key)
+	}
+
+}
+
+func (fs *FilerServer) queueS3ReplicationDelete(bucket, key string) {
+
+	config := fs.s3Replication.getBucket(fs.filer, bucket)
+	if config == nil {
+		return
+	}
+	destinations := config.DeleteDestinations(key)
+	if len(destinations) == 0 {
+		return
+	}
+
+	if !fs.s3Replication.enqueue(&s3ReplicationTask{
+		bucket:       bucket,
+		key:          key,
+		isDelete:     true,
+		destinations: destinations,
+	}) {
+		glog.Errorf("s3 replication queue is full, the deletion of %s/%s is not replicated", bucket, key)
+	}
+
+}
+
+// loopQueueingPendingS3Objects queues the objects left pending, once when the filer starts, and again after the queue was full
+func (fs *FilerServer) loopQueueingPendingS3Objects() {
+	for {
+		fs.queuePendingS3Objects(context.Background())
+		for !fs.s3Replication.takeOverflowed() {
+			time.Sleep(s3ReplicationRescanInterval)
+		}
+	}
+}
+
+func (fs *FilerServer) queuePendingS3Objects(ctx context.Context) {
+
+	err := fs.listEntriesByPage(ctx, util.FullPath(fs.filer.DirBucketsPath), func(bucketEntry *filer.Entry) error {
+		if !bucketEntry.IsDirectory() {
+			return nil
+		}
+		bucket := bucketEntry.Name()
+		config := fs.s3Replication.getBucket(fs.filer, bucket)
+		if config == nil {
+			return nil
+		}
+		if err := fs.queuePendingS3ObjectsUnder(ctx, bucket, config, bucketEntry.FullPath); err != nil {
+			glog.Errorf("queue pending s3 replication of %s: %v", bucketEntry.FullPath, err)
+		}
+		return nil
+	})
+	if err != nil {
+		glog.Errorf("queue pending s3 replication: %v", err)
+	}
+
+}
+
+var errS3ReplicationQueueFull = errors.New("the s3 replication queue is full")
+
+func (fs *FilerServer) queuePendingS3ObjectsUnder(ctx context.Context, bucket string, config *s3replication.ReplicationConfiguration, dir util.FullPath) error {
+	return fs.listEntriesByPage(ctx, dir, func(entry *filer.Entry) error {
+		_, key, isVersion := fs.bucketAndKey(string(entry.FullPath))
+		if key == "" || isVersion {
+			// the multipart uploads and the older versions
+			return nil
+		}
+		if entry.IsDirectory() {
+			return fs.queuePendingS3ObjectsUnder(ctx, bucket, config, entry.FullPath)
+		}
+		if entry.Extended == nil || string(entry.Extended[xhttp.AmzReplicationStatus]) != s3replication.StatusPending {
+			return nil
+		}
+		destinations := config.Destinations(key, objectTags(entry))
+		if len(destinations) == 0 {
+			return nil
+		}
+		task := &s3ReplicationTask{
+			bucket:       bucket,
+			key:          key,
+			etag:         filer.ETagEntry(entry),
+			destinations: destinations,
+		}
+		if fs.s3Replication.isQueued(task) {
+			return nil
+		}
+		if !fs.s3Replication.enqueue(task) {
+			return errS3ReplicationQueueFull
+		}
+		return nil
+	})
+}
+
+// replicateS3Object replicates to each destination, retrying the failed ones with backoff,
+// and sets the replication status of the object to COMPLETED, or FAILED if any destination failed
+func (fs *FilerServer) replicateS3Object(task *s3ReplicationTask) {
+
+	status := s3replication.StatusCompleted
+	for _, destination := range task.destinations {
+		targetName, bucket := s3replication.ParseDestinationArn(destination.Destination.Bucket)
+		target, found := fs.s3Replication.targets[targetName]
+		if !found {
+			glog.V(1).Infof("s3 replication target %s of bucket %s is not enabled", targetName, task.bucket)
+			status = s3replication.StatusFailed
+			continue
+		}
+
+		backoff := time.Second
+		for retry := 0; ; retry++ {
+			var err error
+			if task.isDelete {
+				err = target.Delete(bucket, task.key)
+			} else {
+				err = fs.putS3Replica(task, target, bucket, destination.Destination.StorageClass)
+			}
+			if err == nil {
+				break
+			}
+			if err == errS3ReplicationSkipped {
+				// the object is changed or deleted, and replicated by its own task
+				return
+			}
+			if err == errS3ReplicationUnsupported || retry >= target.MaxRetries {
+				glog.Errorf("replicate %s/%s to %s after %d retries: %v", task.bucket, task.key, destination.Destination.Bucket, retry, err)
+				status = s3replication.StatusFailed
+				break
+			}
+			glog.V(1).Infof("replicate %s/%s to %s: %v", task.bucket, task.key, destination.Destination.Bucket, err)
+			time.Sleep(backoff)
+			if backoff < time.Minute {
+				backoff *= 2
+			}
+		}
+	}
+
+	if !task.isDelete {
+		fs.setS3ReplicationStatus(task, status)
+	}
+
+}
+
+var (
+	errS3ReplicationSkipped     = errors.New("the object is changed")
+	errS3ReplicationUnsupported = errors.New("the objects encrypted with customer keys are not replicated")
+)
+
+func (fs *FilerServer) putS3Replica(task *s3ReplicationTask, target *s3replication.Target, bucket, storageClass string) error {
+
+	entry, err := fs.filer.FindEntry(context.Background(), util.NewFullPath(fs.filer.DirBucketsPath, task.bucket+"/"+task.key))
+	if err == filer_pb.ErrNotFound || (err == nil && filer.ETagEntry(entry) != task.etag) {
+		return errS3ReplicationSkipped
+	}
+	if err != nil {
+		return err
+	}
+
+	if _, found := entry.Extended[xhttp.SeaweedServerSideEncryptionCustomerKeyMD5]; found {
+		return errS3ReplicationUnsupported
+	}
+	chunks := entry.Chunks
+	if _, found := entry.Extended[xhttp.SeaweedServerSideEncryption]; found {
+		sse, err := fs.kmsEncryption()
+		if err != nil {
+			return err
+		}
+		if chunks, err = sse.decryptChunkKeys(fs.filer.MasterClient.GetLookupFileIdFunction(), entry.Chunks); err != nil {
+			return err
+		}
+	}
+
+	object := &s3replication.Object{
+		Key:          task.key,
+		ContentType:  entry.Mime,
+		Metadata:     make(map[string]string),
+		Tags:         objectTags(entry),
+		StorageClass: storageClass,
+	}
+	for k, v := range entry.Extended {
+		if strings.HasPrefix(k, xhttp.AmzUserMetaPrefix) {
+			object.Metadata[k[len(xhttp.AmzUserMetaPrefix):]] = string(v)
+		}
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		var err error
+		if len(entry.Content) > 0 {
+			_, err = writer.Write(entry.Content)
+		} else {
+			err = filer.StreamContent(fs.filer.MasterClient, writer, chunks, 0, int64(entry.Size()))
+		}
+		writer.CloseWithError(err)
+	}()
+	object.Body = reader
+	err = target.Put(bucket, object)
+	reader.CloseWithError(err)
+	return err
+
+}
+
+// setS3ReplicationStatus sets the replication status, if the object is not changed since the task is created
+func (fs *FilerServer) setS3ReplicationStatus(task *s3ReplicationTask, status string) bool {
+
+	ctx := context.Background()
+	entry, err := fs.filer.FindEntry(ctx, util.NewFullPath(fs.filer.DirBucketsPath, task.bucket+"/"+task.key))
+	if err != nil {
+		if err != filer_pb.ErrNotFound {
+			glog.Errorf("set replication status of %s/%s: %v", task.bucket, task.key, err)
+		}
+		return false
+	}
+	if filer.ETagEntry(entry) != task.etag {
+		return false
+	}
+
+	newEntry := entry.Clone()
+	newEntry.Content = entry.Content
+	newEntry.Extended = make(map[string][]byte)
+	for k, v := range entry.Extended {
+		newEntry.Extended[k] = v
+	}
+	newEntry.Extended[xhttp.AmzReplicationStatus] = []byte(status)
+	if err = fs.filer.UpdateEntry(ctx, entry, newEntry); err != nil {
+		glog.Errorf("set replication status of %s/%s: %v", task.bucket, task.key, err)
+		return false
+	}
+	return true
+
+}
+
+func (r *s3Replicator) getBucket(f *filer.Filer, bucket string) *s3replication.ReplicationConfiguration {
+	r.Lock()
+	defer r.Unlock()
+
+	if config, found := r.buckets[bucket]; found {
+		return config
+	}
+
+	var config *s3replication.ReplicationConfiguration
+	entry, err := f.FindEntry(context.Background(), util.NewFullPath(f.DirBucketsPath, bucket))
+	if err != nil {
+		if err != filer_pb.ErrNotFound {
+			glog.Errorf("read bucket %s: %v", bucket, err)
+			return nil
+		}
+	} else if entry.Extended != nil {
+		if data := entry.Extended[xhttp.AmzBucketReplication]; len(data) > 0 {
+			if config, err = s3replication.Parse(data); err != nil {
+				glog.Errorf("parse replication configuration of bucket %s: %v", bucket, err)
+			}
+		}
+	}
+	r.buckets[bucket] = config
+	return config
+}
+
+func (r *s3Replicator) forgetBucket(bucket string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.buckets, bucket)
+}
+
+func (r *s3Replicator) isQueued(task *s3ReplicationTask) bool {
+	r.Lock()
+	defer r.Unlock()
+	etag, found := r.queued[task.bucket+"/"+task.key]
+	return found && !task.isDelete && etag == task.etag
+}
+
+// enqueue queues the task without waiting, and tells whether it is queued
+func (r *s3Replicator) enqueue(task *s3ReplicationTask) bool {
+	r.Lock()
+	defer r.Unlock()
+	select {
+	case r.tasks <- task:
+		if !task.isDelete {
+			r.queued[task.bucket+"/"+task.key] = task.etag
+		}
+		return true
+	default:
+		r.overflowed = true
+		return false
+	}
+}
+
+func (r *s3Replicator) done(task *s3ReplicationTask) {
+	r.Lock()
+	defer r.Unlock()
+	if etag, found := r.queued[task.bucket+"/"+task.key]; found && !task.isDelete && etag == task.etag {
+		delete(r.queued, task.bucket+"/"+task.key)
+	}
+}
+
+func (r *s3Replicator) takeOverflowed() bool {
+	r.Lock()
+	defer r.Unlock()
+	overflowed := r.overflowed
+	r.overflowed = false
+	return overflowed
+}
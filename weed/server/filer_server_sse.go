@@ -0,0 +1,183 @@
+package weed_server
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/chrislusf/seaweedfs/weed/wdclient"
+	"github.com/golang/protobuf/proto"
+)
+
+// The S3 server side encryption encrypts each chunk with its own data key, the same as -encryptVolumeData.
+// The data keys are encrypted before being saved in the filer metadata,
+// by the master key of the key management service for SSE-S3,
+// or by the customer provided key for SSE-C, which is never saved.
+
+const sseAlgorithm = "AES256"
+
+var (
+	ErrSseInvalidAlgorithm    = errors.New("server side encryption only supports AES256")
+	ErrSseInvalidCustomerKey  = errors.New("server side encryption customer key should be a base64 encoded 256 bit key")
+	ErrSseCustomerKeyMD5      = errors.New("server side encryption customer key MD5 does not match the key")
+	ErrSseCustomerKeyMissing  = errors.New("server side encryption customer key is required for the encrypted object")
+	ErrSseCustomerKeyMismatch = errors.New("server side encryption customer key does not match the object")
+	ErrSseNotConfigured       = errors.New("server side encryption needs a key management service")
+)
+
+type serverSideEncryption struct {
+	// kept in the entry extended attributes
+	attributes map[string][]byte
+	encryptKey func(dataKey []byte) ([]byte, error)
+	decryptKey func(encryptedDataKey []byte) ([]byte, error)
+}
+
+// ParseSseCustomerKey validates the SSE-C algorithm, key, and key MD5, and returns the customer key.
+func ParseSseCustomerKey(algorithm, encodedKey, keyMD5 string) (util.CipherKey, error) {
+	if algorithm != sseAlgorithm {
+		return nil, ErrSseInvalidAlgorithm
+	}
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil || len(key) != 32 {
+		return nil, ErrSseInvalidCustomerKey
+	}
+	sum := md5.Sum(key)
+	if base64.StdEncoding.EncodeToString(sum[:]) != keyMD5 {
+		return nil, ErrSseCustomerKeyMD5
+	}
+	return key, nil
+}
+
+func customerKeyEncryption(key util.CipherKey, keyMD5 string) *serverSideEncryption {
+	return &serverSideEncryption{
+		attributes: map[string][]byte{
+			xhttp.SeaweedServerSideEncryptionCustomerAlgorithm: []byte(sseAlgorithm),
+			xhttp.SeaweedServerSideEncryptionCustomerKeyMD5:    []byte(keyMD5),
+		},
+		encryptKey: func(dataKey []byte) ([]byte, error) {
+			return util.Encrypt(dataKey, key)
+		},
+		decryptKey: func(encryptedDataKey []byte) ([]byte, error) {
+			return util.Decrypt(encryptedDataKey, key)
+		},
+	}
+}
+
+func (fs *FilerServer) kmsEncryption() (*serverSideEncryption, error) {
+	if fs.kms == nil {
+		return nil, ErrSseNotConfigured
+	}
+	return &serverSideEncryption{
+		attributes: map[string][]byte{
+			xhttp.SeaweedServerSideEncryption: []byte(sseAlgorithm),
+		},
+		encryptKey: fs.kms.Encrypt,
+		decryptKey: fs.kms.Decrypt,
+	}, nil
+}
+
+// sseForWrite returns the server side encryption requested by the headers, or nil if not requested.
+func (fs *FilerServer) sseForWrite(r *http.Request) (*serverSideEncryption, error) {
+	if algorithm := r.Header.Get(xhttp.AmzServerSideEncryptionCustomerAlgorithm); algorithm != "" {
+		keyMD5 := r.Header.Get(xhttp.AmzServerSideEncryptionCustomerKeyMD5)
+		key, err := ParseSseCustomerKey(algorithm, r.Header.Get(xhttp.AmzServerSideEncryptionCustomerKey), keyMD5)
+		if err != nil {
+			return nil, err
+		}
+		return customerKeyEncryption(key, keyMD5), nil
+	}
+	switch r.Header.Get(xhttp.AmzServerSideEncryption) {
+	case "":
+		return nil, nil
+	case sseAlgorithm:
+		return fs.kmsEncryption()
+	default:
+		return nil, ErrSseInvalidAlgorithm
+	}
+}
+
+// sseForRead returns the server side encryption of the entry, or nil if it is not encrypted.
+// The SSE-C objects can only be read with the same customer key.
+func (fs *FilerServer) sseForRead(r *http.Request, entry *filer.Entry) (*serverSideEncryption, error) {
+	return fs.sseForEntry(entry,
+		r.Header.Get(xhttp.AmzServerSideEncryptionCustomerAlgorithm),
+		r.Header.Get(xhttp.AmzServerSideEncryptionCustomerKey),
+		r.Header.Get(xhttp.AmzServerSideEncryptionCustomerKeyMD5))
+}
+
+// sseForEntry returns the server side encryption of the entry, with the SSE-C key of the request if it is needed
+func (fs *FilerServer) sseForEntry(entry *filer.Entry, algorithm, encodedKey, keyMD5 string) (*serverSideEncryption, error) {
+	if entryKeyMD5, found := entry.Extended[xhttp.SeaweedServerSideEncryptionCustomerKeyMD5]; found {
+		if algorithm == "" {
+			return nil, ErrSseCustomerKeyMissing
+		}
+		key, err := ParseSseCustomerKey(algorithm, encodedKey, keyMD5)
+		if err != nil {
+			return nil, err
+		}
+		if keyMD5 != string(entryKeyMD5) {
+			return nil, ErrSseCustomerKeyMismatch
+		}
+		return customerKeyEncryption(key, keyMD5), nil
+	}
+	if _, found := entry.Extended[xhttp.SeaweedServerSideEncryption]; found {
+		return fs.kmsEncryption()
+	}
+	return nil, nil
+}
+
+func sseErrorStatus(err error) int {
+	switch err {
+	case ErrSseCustomerKeyMismatch:
+		return http.StatusForbidden
+	case ErrSseNotConfigured:
+		return http.StatusNotImplemented
+	}
+	return http.StatusBadRequest
+}
+
+func IsServerSideEncrypted(extended map[string][]byte) bool {
+	_, sse := extended[xhttp.SeaweedServerSideEncryption]
+	_, ssec := extended[xhttp.SeaweedServerSideEncryptionCustomerKeyMD5]
+	return sse || ssec
+}
+
+// encryptChunkKeys replaces the data keys of the newly uploaded chunks with the encrypted data keys
+func (sse *serverSideEncryption) encryptChunkKeys(chunks []*filer_pb.FileChunk) error {
+	for _, chunk := range chunks {
+		if len(chunk.CipherKey) == 0 {
+			return fmt.Errorf("chunk %s is not encrypted", chunk.GetFileIdString())
+		}
+		encryptedKey, err := sse.encryptKey(chunk.CipherKey)
+		if err != nil {
+			return fmt.Errorf("encrypt data key of chunk %s: %v", chunk.GetFileIdString(), err)
+		}
+		chunk.CipherKey = encryptedKey
+	}
+	return nil
+}
+
+// decryptChunkKeys resolves the chunk manifests, and returns copies of the data chunks with the data keys decrypted
+func (sse *serverSideEncryption) decryptChunkKeys(lookupFileIdFn wdclient.LookupFileIdFunctionType, chunks []*filer_pb.FileChunk) ([]*filer_pb.FileChunk, error) {
+	dataChunks, _, err := filer.ResolveChunkManifest(lookupFileIdFn, chunks)
+	if err != nil {
+		return nil, err
+	}
+	decrypted := make([]*filer_pb.FileChunk, 0, len(dataChunks))
+	for _, chunk := range dataChunks {
+		dataKey, err := sse.decryptKey(chunk.CipherKey)
+		if err != nil {
+			return nil, fmt.Errorf("decrypt data key of chunk %s: %v", chunk.GetFileIdString(), err)
+		}
+		chunk = proto.Clone(chunk).(*filer_pb.FileChunk)
+		chunk.CipherKey = dataKey
+		decrypted = append(decrypted, chunk)
+	}
+	return decrypted, nil
+}
@@ -0,0 +1,96 @@
+package weed_server
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	xhttp "github.com/chrislusf/seaweedfs/weed/s3api/http"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func customerKeyHeaders(key []byte) http.Header {
+	sum := md5.Sum(key)
+	header := http.Header{}
+	header.Set(xhttp.AmzServerSideEncryptionCustomerAlgorithm, "AES256")
+	header.Set(xhttp.AmzServerSideEncryptionCustomerKey, base64.StdEncoding.EncodeToString(key))
+	header.Set(xhttp.AmzServerSideEncryptionCustomerKeyMD5, base64.StdEncoding.EncodeToString(sum[:]))
+	return header
+}
+
+func TestParseSseCustomerKey(t *testing.T) {
+
+	key := util.GenCipherKey()
+	header := customerKeyHeaders(key)
+
+	parsed, err := ParseSseCustomerKey("AES256", header.Get(xhttp.AmzServerSideEncryptionCustomerKey), header.Get(xhttp.AmzServerSideEncryptionCustomerKeyMD5))
+	assert.Nil(t, err)
+	assert.Equal(t, key, parsed)
+
+	_, err = ParseSseCustomerKey("aws:kms", header.Get(xhttp.AmzServerSideEncryptionCustomerKey), header.Get(xhttp.AmzServerSideEncryptionCustomerKeyMD5))
+	assert.Equal(t, ErrSseInvalidAlgorithm, err)
+
+	_, err = ParseSseCustomerKey("AES256", base64.StdEncoding.EncodeToString(key[:16]), header.Get(xhttp.AmzServerSideEncryptionCustomerKeyMD5))
+	assert.Equal(t, ErrSseInvalidCustomerKey, err)
+
+	_, err = ParseSseCustomerKey("AES256", header.Get(xhttp.AmzServerSideEncryptionCustomerKey), "bm90IGEgbWQ1")
+	assert.Equal(t, ErrSseCustomerKeyMD5, err)
+
+}
+
+func TestSseCustomerKeyChunks(t *testing.T) {
+
+	fs := &FilerServer{}
+	key := util.GenCipherKey()
+
+	r := &http.Request{Header: customerKeyHeaders(key)}
+	sse, err := fs.sseForWrite(r)
+	assert.Nil(t, err)
+
+	dataKey := util.GenCipherKey()
+	chunks := []*filer_pb.FileChunk{{FileId: "3,01637037d6", Size: 3, CipherKey: dataKey}}
+	assert.Nil(t, sse.encryptChunkKeys(chunks))
+	assert.NotEqual(t, []byte(dataKey), chunks[0].CipherKey)
+
+	entry := &filer.Entry{Chunks: chunks, Extended: sse.attributes}
+	assert.True(t, IsServerSideEncrypted(entry.Extended))
+	_, hasKey := entry.Extended[xhttp.AmzServerSideEncryptionCustomerKey]
+	assert.False(t, hasKey)
+
+	// the object can only be read with the same customer key
+	_, err = fs.sseForRead(&http.Request{Header: http.Header{}}, entry)
+	assert.Equal(t, ErrSseCustomerKeyMissing, err)
+	_, err = fs.sseForRead(&http.Request{Header: customerKeyHeaders(util.GenCipherKey())}, entry)
+	assert.Equal(t, ErrSseCustomerKeyMismatch, err)
+
+	sse, err = fs.sseForRead(r, entry)
+	assert.Nil(t, err)
+	decrypted, err := sse.decryptChunkKeys(nil, entry.Chunks)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(dataKey), decrypted[0].CipherKey)
+	assert.NotEqual(t, []byte(dataKey), entry.Chunks[0].CipherKey)
+
+}
+
+func TestSseWithoutKms(t *testing.T) {
+
+	fs := &FilerServer{}
+
+	header := http.Header{}
+	header.Set(xhttp.AmzServerSideEncryption, "AES256")
+	_, err := fs.sseForWrite(&http.Request{Header: header})
+	assert.Equal(t, ErrSseNotConfigured, err)
+
+	header.Set(xhttp.AmzServerSideEncryption, "aws:kms")
+	_, err = fs.sseForWrite(&http.Request{Header: header})
+	assert.Equal(t, ErrSseInvalidAlgorithm, err)
+
+	sse, err := fs.sseForWrite(&http.Request{Header: http.Header{}})
+	assert.Nil(t, err)
+	assert.Nil(t, sse)
+
+}
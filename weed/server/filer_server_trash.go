@@ -0,0 +1,96 @@
+package weed_server
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/glog"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc/metadata"
+)
+
+const trashLockName = "filer.trash"
+
+// loopPurgingTrash periodically deletes the expired entries in the trash, with their data.
+// Only one of the filers sharing the same store purges the trash in each interval.
+func (fs *FilerServer) loopPurgingTrash(interval time.Duration) {
+
+	var running int32
+	for {
+		time.Sleep(interval)
+
+		fs.runExclusively(&running, trashLockName, interval, func() {
+			fs.purgeTrash(context.Background(), time.Now())
+		})
+	}
+
+}
+
+// checkTrashAdmin only lets the admins read or restore the trashed entries through grpc,
+// if the filer signing key is configured, by a jwt signed for the purpose
+func (fs *FilerServer) checkTrashAdmin(ctx context.Context, p util.FullPath) error {
+	if !filer.IsInTrash(p) || len(fs.secret) == 0 {
+		return nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if !fs.hasFilerJwt(md, filer.TrashAdminKey) {
+		return fmt.Errorf("access to %s is denied: %s is only read by the admins", p, filer.DirectoryTrash)
+	}
+	return nil
+}
+
+func (fs *FilerServer) purgeTrash(ctx context.Context, now time.Time) {
+
+	err := fs.listEntriesByPage(ctx, filer.DirectoryTrash, func(entry *filer.Entry) error {
+		_, _, expiresAt, found := filer.ParseTrashInfo(entry.Extended)
+		if !found || now.Before(expiresAt) {
+			return nil
+		}
+		glog.V(2).Infof("purge expired %s", entry.FullPath)
+		if err := fs.filer.DeleteEntryMetaAndData(ctx, entry.FullPath, true, true, true, false, nil); err != nil {
+			glog.Errorf("purge expired %s: %v", entry.FullPath, err)
+		}
+		return nil
+	})
+	if err != nil {
+		glog.Errorf("list %s: %v", filer.DirectoryTrash, err)
+	}
+
+}
+
+func (fs *FilerServer) RestoreEntry(ctx context.Context, req *filer_pb.RestoreEntryRequest) (*filer_pb.RestoreEntryResponse, error) {
+
+	glog.V(1).Infof("RestoreEntry %v", req)
+
+	if err := fs.checkTrashAdmin(ctx, filer.DirectoryTrash); err != nil {
+		return nil, err
+	}
+
+	var targetPath util.FullPath
+	if req.TargetPath != "" {
+		targetPath = util.FullPath(filepath.ToSlash(req.TargetPath))
+	}
+
+	ctx, err := fs.filer.BeginTransaction(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	restoredPath, err := fs.filer.RestoreEntry(ctx, req.Name, targetPath)
+	if err != nil {
+		fs.filer.RollbackTransaction(ctx)
+		return nil, fmt.Errorf("restore %s: %v", req.Name, err)
+	}
+	if err = fs.filer.CommitTransaction(ctx); err != nil {
+		fs.filer.RollbackTransaction(ctx)
+		return nil, fmt.Errorf("restore %s commit: %v", req.Name, err)
+	}
+
+	return &filer_pb.RestoreEntryResponse{
+		Path: string(restoredPath),
+	}, nil
+}
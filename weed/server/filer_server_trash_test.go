@@ -0,0 +1,33 @@
+package weed_server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCheckTrashAdmin(t *testing.T) {
+
+	withToken := func(signingKey security.SigningKey, purpose string) context.Context {
+		token := security.GenJwtForFilerServer(signingKey, 10, purpose)
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(filer.TrashAdminKey, string(token)))
+	}
+
+	fs := &FilerServer{secret: security.SigningKey("filer secret")}
+	assert.NoError(t, fs.checkTrashAdmin(context.Background(), "/home/chris"))
+	assert.NoError(t, fs.checkTrashAdmin(context.Background(), "/.trashed"))
+	assert.Error(t, fs.checkTrashAdmin(context.Background(), filer.DirectoryTrash))
+	assert.Error(t, fs.checkTrashAdmin(context.Background(), filer.DirectoryTrash+"/1634567890123456789-report.pdf"))
+
+	assert.NoError(t, fs.checkTrashAdmin(withToken(fs.secret, filer.TrashAdminKey), filer.DirectoryTrash))
+	assert.Error(t, fs.checkTrashAdmin(withToken(fs.secret, filer.BypassGovernanceRetentionKey), filer.DirectoryTrash))
+	assert.Error(t, fs.checkTrashAdmin(withToken(security.SigningKey("other secret"), filer.TrashAdminKey), filer.DirectoryTrash))
+
+	// the filer grpc is only secured by tls without the signing key
+	assert.NoError(t, (&FilerServer{}).checkTrashAdmin(context.Background(), filer.DirectoryTrash))
+
+}
@@ -1,66 +1,124 @@
 package weed_server
 
 import (
+	"fmt"
+
 	"github.com/chrislusf/seaweedfs/weed/glog"
 	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/query"
 	"github.com/chrislusf/seaweedfs/weed/query/json"
+	"github.com/chrislusf/seaweedfs/weed/query/sql"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
+	"github.com/chrislusf/seaweedfs/weed/util"
 	"github.com/tidwall/gjson"
 )
 
 func (vs *VolumeServer) Query(req *volume_server_pb.QueryRequest, stream volume_server_pb.VolumeServer_QueryServer) error {
 
-	for _, fid := range req.FromFileIds {
+	if req.InputSerialization == nil {
+		return fmt.Errorf("missing input serialization")
+	}
 
-		vid, id_cookie, err := operation.ParseFileId(fid)
+	if req.Filter != nil {
+		return vs.queryJsonFilter(req, stream)
+	}
+
+	input, err := query.NewInput(req.InputSerialization)
+	if err != nil {
+		return err
+	}
+	if !input.Splittable() {
+		return fmt.Errorf("records can not be split by the record delimiter")
+	}
+	where, err := sql.ParseWhere(req.Where)
+	if err != nil {
+		return err
+	}
+
+	// the file ids are consecutive parts of the same object
+	var data []byte
+	for _, fid := range req.FromFileIds {
+		n, err := vs.readQueriedNeedle(fid)
 		if err != nil {
-			glog.V(0).Infof("volume query failed to parse fid %s: %v", fid, err)
 			return err
 		}
+		needleData := n.Data
+		if n.IsCompressed() {
+			if needleData, err = util.DecompressData(n.Data); err != nil {
+				glog.V(0).Infof("volume query failed to decompress fid %s: %v", fid, err)
+				return err
+			}
+		}
+		data = append(data, needleData...)
+	}
 
-		n := new(needle.Needle)
-		volumeId, _ := needle.NewVolumeId(vid)
-		n.ParsePath(id_cookie)
+	return input.FilterRecords(data, req.PartialHead, where, func(stripe *volume_server_pb.QueriedStripe) error {
+		return stream.Send(stripe)
+	})
 
-		cookie := n.Cookie
-		if _, err := vs.store.ReadVolumeNeedle(volumeId, n, nil); err != nil {
-			glog.V(0).Infof("volume query failed to read fid %s: %v", fid, err)
-			return err
-		}
+}
 
-		if n.Cookie != cookie {
-			glog.V(0).Infof("volume query failed to read fid cookie %s: %v", fid, err)
-			return err
-		}
+func (vs *VolumeServer) readQueriedNeedle(fid string) (*needle.Needle, error) {
 
-		if req.InputSerialization.CsvInput != nil {
+	vid, id_cookie, err := operation.ParseFileId(fid)
+	if err != nil {
+		glog.V(0).Infof("volume query failed to parse fid %s: %v", fid, err)
+		return nil, err
+	}
 
-		}
+	n := new(needle.Needle)
+	volumeId, _ := needle.NewVolumeId(vid)
+	n.ParsePath(id_cookie)
 
-		if req.InputSerialization.JsonInput != nil {
+	cookie := n.Cookie
+	if _, err := vs.store.ReadVolumeNeedle(volumeId, n, nil); err != nil {
+		glog.V(0).Infof("volume query failed to read fid %s: %v", fid, err)
+		return nil, err
+	}
 
-			stripe := &volume_server_pb.QueriedStripe{
-				Records: nil,
-			}
+	if n.Cookie != cookie {
+		glog.V(0).Infof("volume query failed to read fid cookie %s", fid)
+		return nil, fmt.Errorf("unexpected cookie for %s", fid)
+	}
 
-			filter := json.Query{
-				Field: req.Filter.Field,
-				Op:    req.Filter.Operand,
-				Value: req.Filter.Value,
-			}
-			gjson.ForEachLine(string(n.Data), func(line gjson.Result) bool {
-				passedFilter, values := json.QueryJson(line.Raw, req.Selections, filter)
-				if !passedFilter {
-					return true
-				}
-				stripe.Records = json.ToJson(stripe.Records, req.Selections, values)
+	return n, nil
+}
+
+// queryJsonFilter serves the single field filter over JSON lines
+func (vs *VolumeServer) queryJsonFilter(req *volume_server_pb.QueryRequest, stream volume_server_pb.VolumeServer_QueryServer) error {
+
+	if req.InputSerialization.JsonInput == nil {
+		return fmt.Errorf("the filter only supports JSON lines")
+	}
+
+	for _, fid := range req.FromFileIds {
+
+		n, err := vs.readQueriedNeedle(fid)
+		if err != nil {
+			return err
+		}
+
+		stripe := &volume_server_pb.QueriedStripe{
+			Records: nil,
+		}
+
+		filter := json.Query{
+			Field: req.Filter.Field,
+			Op:    req.Filter.Operand,
+			Value: req.Filter.Value,
+		}
+		gjson.ForEachLine(string(n.Data), func(line gjson.Result) bool {
+			passedFilter, values := json.QueryJson(line.Raw, req.Selections, filter)
+			if !passedFilter {
 				return true
-			})
-			err = stream.Send(stripe)
-			if err != nil {
-				return err
 			}
+			stripe.Records = json.ToJson(stripe.Records, req.Selections, values)
+			return true
+		})
+		err = stream.Send(stripe)
+		if err != nil {
+			return err
 		}
 
 	}
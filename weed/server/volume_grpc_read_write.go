@@ -3,7 +3,9 @@ package weed_server
 import (
 	"context"
 	"fmt"
+	"github.com/chrislusf/seaweedfs/weed/operation"
 	"github.com/chrislusf/seaweedfs/weed/pb/volume_server_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
 	"github.com/chrislusf/seaweedfs/weed/storage/needle"
 	"github.com/chrislusf/seaweedfs/weed/storage/types"
 )
@@ -36,3 +38,61 @@ func (vs *VolumeServer) WriteNeedleBlob(ctx context.Context, req *volume_server_
 
 	return resp, nil
 }
+
+// CopyNeedle uploads the needle data as is to a new file id, so the filer can copy whole chunks
+// from volume server to volume server, without decompressing or decrypting the data.
+func (vs *VolumeServer) CopyNeedle(ctx context.Context, req *volume_server_pb.CopyNeedleRequest) (resp *volume_server_pb.CopyNeedleResponse, err error) {
+	resp = &volume_server_pb.CopyNeedleResponse{}
+
+	volumeId := needle.VolumeId(req.VolumeId)
+	n := &needle.Needle{
+		Id: types.NeedleId(req.NeedleId),
+	}
+
+	var count int
+	if vs.store.HasVolume(volumeId) {
+		count, err = vs.store.ReadVolumeNeedle(volumeId, n, nil)
+	} else if _, hasEcVolume := vs.store.FindEcVolume(volumeId); hasEcVolume {
+		count, err = vs.store.ReadEcShardNeedle(volumeId, n)
+	} else {
+		return nil, fmt.Errorf("not found volume id %d", req.VolumeId)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read needle %d,%x: %v", req.VolumeId, req.NeedleId, err)
+	}
+	if count < 0 || n.Cookie != types.Cookie(req.Cookie) {
+		return nil, fmt.Errorf("not found needle %d,%x", req.VolumeId, req.NeedleId)
+	}
+
+	// only uploaded to the volume servers known to the master
+	targetUrl, err := vs.lookupTargetUrl(req.TargetFileId, req.TargetFsync)
+	if err != nil {
+		return nil, err
+	}
+	uploadResult, err := operation.UploadData(targetUrl, string(n.Name), false, n.Data, n.IsCompressed(), string(n.Mime), nil, security.EncodedJwt(req.TargetAuth))
+	if err != nil {
+		return nil, fmt.Errorf("upload needle %d,%x to %s: %v", req.VolumeId, req.NeedleId, targetUrl, err)
+	}
+	resp.IsCompressed = uploadResult.Gzip > 0
+
+	return resp, nil
+}
+
+func (vs *VolumeServer) lookupTargetUrl(fileId string, fsync bool) (string, error) {
+	targetFileId, err := needle.ParseFileIdFromString(fileId)
+	if err != nil {
+		return "", fmt.Errorf("parse target file id %s: %v", fileId, err)
+	}
+	lookupResult, err := operation.Lookup(vs.GetMaster, targetFileId.VolumeId.String())
+	if err != nil {
+		return "", fmt.Errorf("lookup target volume %d: %v", targetFileId.VolumeId, err)
+	}
+	if len(lookupResult.Locations) == 0 {
+		return "", fmt.Errorf("target volume %d is not found", targetFileId.VolumeId)
+	}
+	targetUrl := "http://" + lookupResult.Locations[0].Url + "/" + targetFileId.String()
+	if fsync {
+		targetUrl += "?fsync=true"
+	}
+	return targetUrl, nil
+}
@@ -36,6 +36,15 @@ func (c *commandFsConfigure) Help() string {
 	# example: configure adding only 1 physical volume for each bucket collection
 	fs.configure -locationPrfix=/buckets/ -volumeGrowthCount=1
 
+	# example: limit a bucket to 10GiB and 1 million files
+	fs.configure -locationPrfix=/buckets/bucket1/ -quotaBytes=10737418240 -quotaObjects=1000000
+
+	# example: keep the deleted files under /home/ in the trash for 7 days
+	fs.configure -locationPrefix=/home/ -trashRetentionDays=7
+
+	# example: store the identical chunks of the uploads to a bucket only once
+	fs.configure -locationPrefix=/buckets/ci-cache/ -dedup
+
 	# apply the changes
 	fs.configure -locationPrfix=/my/folder -collection=abc -apply
 
@@ -55,6 +64,10 @@ func (c *commandFsConfigure) Do(args []string, commandEnv *CommandEnv, writer io
 	diskType := fsConfigureCommand.String("disk", "", "[hdd|ssd|<tag>] hard drive or solid state drive or any tag")
 	fsync := fsConfigureCommand.Bool("fsync", false, "fsync for the writes")
 	volumeGrowthCount := fsConfigureCommand.Int("volumeGrowthCount", 0, "the number of physical volumes to add if no writable volumes")
+	quotaBytes := fsConfigureCommand.Uint64("quotaBytes", 0, "the total size limit of the files under the location")
+	quotaObjects := fsConfigureCommand.Uint64("quotaObjects", 0, "the limit of the number of files under the location")
+	trashRetentionDays := fsConfigureCommand.Uint("trashRetentionDays", 0, "keep the deleted files and folders in the trash for these days")
+	dedup := fsConfigureCommand.Bool("dedup", false, "split the uploads by the content and store the identical chunks only once")
 	isDelete := fsConfigureCommand.Bool("delete", false, "delete the configuration by locationPrefix")
 	apply := fsConfigureCommand.Bool("apply", false, "update and apply filer configuration")
 	if err = fsConfigureCommand.Parse(args); err != nil {
@@ -77,13 +90,17 @@ func (c *commandFsConfigure) Do(args []string, commandEnv *CommandEnv, writer io
 
 	if *locationPrefix != "" {
 		locConf := &filer_pb.FilerConf_PathConf{
-			LocationPrefix:    *locationPrefix,
-			Collection:        *collection,
-			Replication:       *replication,
-			Ttl:               *ttl,
-			Fsync:             *fsync,
-			DiskType:          *diskType,
-			VolumeGrowthCount: uint32(*volumeGrowthCount),
+			LocationPrefix:     *locationPrefix,
+			Collection:         *collection,
+			Replication:        *replication,
+			Ttl:                *ttl,
+			Fsync:              *fsync,
+			DiskType:           *diskType,
+			VolumeGrowthCount:  uint32(*volumeGrowthCount),
+			QuotaBytes:         *quotaBytes,
+			QuotaObjects:       *quotaObjects,
+			TrashRetentionDays: uint32(*trashRetentionDays),
+			Dedup:              *dedup,
 		}
 
 		// check collection
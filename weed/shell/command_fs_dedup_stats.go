@@ -0,0 +1,80 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsDedupStats{})
+}
+
+type commandFsDedupStats struct {
+}
+
+func (c *commandFsDedupStats) Name() string {
+	return "fs.dedup.stats"
+}
+
+func (c *commandFsDedupStats) Help() string {
+	return `show the space saved by the chunk deduplication
+
+	fs.dedup.stats [/dir]
+
+	The deduplication is enabled by "fs.configure -locationPrefix=<path> -dedup -apply".
+	The deduplicated chunks referenced by the files under the directory are counted,
+	by reading all the entries, so it can take a while for a large directory.
+
+`
+}
+
+func (c *commandFsDedupStats) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	path, err := commandEnv.parseUrl(findInputDirectory(args))
+	if err != nil {
+		return err
+	}
+
+	// the sizes of the unique chunks, by file id
+	uniqueChunks := make(map[string]uint64)
+	var referencedChunks, referencedBytes uint64
+	var statsLock sync.Mutex
+	err = filer_pb.TraverseBfs(commandEnv, util.FullPath(path), func(parentPath util.FullPath, entry *filer_pb.Entry) {
+		chunks, _, resolveErr := filer.ResolveChunkManifest(filer.LookupFn(commandEnv), entry.Chunks)
+		if resolveErr != nil {
+			fmt.Fprintf(writer, "resolve chunk manifests of %s: %v\n", parentPath.Child(entry.Name), resolveErr)
+		}
+		statsLock.Lock()
+		defer statsLock.Unlock()
+		for _, chunk := range chunks {
+			if !chunk.IsDedup {
+				continue
+			}
+			referencedChunks++
+			referencedBytes += chunk.Size
+			uniqueChunks[chunk.GetFileIdString()] = chunk.Size
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	var uniqueBytes uint64
+	for _, size := range uniqueChunks {
+		uniqueBytes += size
+	}
+	fmt.Fprintf(writer, "unique chunks:     %d\t%s\n", len(uniqueChunks), util.BytesToHumanReadable(uniqueBytes))
+	fmt.Fprintf(writer, "referenced chunks: %d\t%s\n", referencedChunks, util.BytesToHumanReadable(referencedBytes))
+	var ratio float64
+	if uniqueBytes > 0 {
+		ratio = float64(referencedBytes) / float64(uniqueBytes)
+	}
+	fmt.Fprintf(writer, "saved:             %s\tdedup ratio %.2f\n", util.BytesToHumanReadable(referencedBytes-uniqueBytes), ratio)
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsDirStats{})
+}
+
+type commandFsDirStats struct {
+}
+
+func (c *commandFsDirStats) Name() string {
+	return "fs.dir.stats"
+}
+
+func (c *commandFsDirStats) Help() string {
+	return `show the total size, the file count and the latest mtime of the files under a directory
+
+	fs.dir.stats /buckets/warehouse             # read the counters kept by the filer
+	fs.dir.stats -rebuild /buckets/warehouse    # count the files again in the background
+
+	Unlike fs.du, the directory tree is not walked. The counters are updated by the filer for each change,
+	and are approximate when the changes are made during a rebuild.
+
+`
+}
+
+func (c *commandFsDirStats) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	dirStatsCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	rebuild := dirStatsCommand.Bool("rebuild", false, "count the files under the directory again")
+	if err = dirStatsCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	path := "."
+	if dirStatsCommand.NArg() > 0 {
+		path = dirStatsCommand.Arg(0)
+	}
+	dir, err := commandEnv.parseUrl(path)
+	if err != nil {
+		return err
+	}
+
+	return commandEnv.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+		if *rebuild {
+			if _, err := client.RebuildDirectoryStats(context.Background(), &filer_pb.RebuildDirectoryStatsRequest{
+				Directory: dir,
+			}); err != nil {
+				return err
+			}
+			fmt.Fprintf(writer, "rebuilding the directory stats of %s in the background\n", dir)
+			return nil
+		}
+
+		resp, err := client.GetDirectoryStats(context.Background(), &filer_pb.GetDirectoryStatsRequest{
+			Directory: dir,
+		})
+		if err != nil {
+			return err
+		}
+		stats := resp.Stats
+		var latestMtime string
+		if stats.GetLatestMtime() > 0 {
+			latestMtime = time.Unix(stats.GetLatestMtime(), 0).Format("2006-01-02 15:04:05")
+		}
+		fmt.Fprintf(writer, "%s\t%d files\t%d bytes (%s)\tlatest mtime %s\n", dir, stats.GetFileCount(), stats.GetTotalSize(),
+			util.BytesToHumanReadable(stats.GetTotalSize()), latestMtime)
+		return nil
+	})
+
+}
@@ -0,0 +1,124 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsMetaRestore{})
+}
+
+type commandFsMetaRestore struct {
+}
+
+func (c *commandFsMetaRestore) Name() string {
+	return "fs.meta.restore"
+}
+
+func (c *commandFsMetaRestore) Help() string {
+	return `restore the meta data of a directory tree as of a time, from the filer metadata log
+
+	The changes made after the time are undone, from the last change back to the first one,
+	so the metadata log should cover the time. The deleted file chunks are recovered if they are
+	not garbage collected by the volume servers yet, otherwise the files are skipped as lost.
+
+	fs.meta.restore -path=/buckets/warehouse -time=2021-10-18T15:04:05Z          # list the changes
+	fs.meta.restore -path=/buckets/warehouse -time=1634569445 -force              # restore in place
+	fs.meta.restore -path=/buckets/warehouse -time=1634569445 -to=/restored -force # restore into a new directory
+
+	The time is in RFC3339 format, local time as 2006-01-02T15:04:05, or unix seconds.
+
+`
+}
+
+func (c *commandFsMetaRestore) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	metaRestoreCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	path := metaRestoreCommand.String("path", "", "the directory to restore")
+	timeString := metaRestoreCommand.String("time", "", "restore the meta data as of this time")
+	to := metaRestoreCommand.String("to", "", "restore into this new directory instead of in place")
+	applyChanges := metaRestoreCommand.Bool("force", false, "apply the changes")
+	if err = metaRestoreCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	if *path == "" || *timeString == "" {
+		return fmt.Errorf("need the -path and -time to restore")
+	}
+	t, err := parseRestoreTime(*timeString)
+	if err != nil {
+		return err
+	}
+	if t.After(time.Now()) {
+		return fmt.Errorf("the time %v is in the future", t)
+	}
+	dir, err := commandEnv.parseUrl(*path)
+	if err != nil {
+		return err
+	}
+	var targetDir string
+	if *to != "" {
+		if targetDir, err = commandEnv.parseUrl(*to); err != nil {
+			return err
+		}
+	}
+
+	var resp *filer_pb.RestoreMetadataResponse
+	err = commandEnv.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+		resp, err = client.RestoreMetadata(context.Background(), &filer_pb.RestoreMetadataRequest{
+			Directory:       dir,
+			TsNs:            t.UnixNano(),
+			TargetDirectory: targetDir,
+			DryRun:          !*applyChanges,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var lostCount int
+	for _, change := range resp.Changes {
+		path := change.Path
+		if change.IsDirectory {
+			path += "/"
+		}
+		switch {
+		case change.LostChunks > 0:
+			lostCount++
+			fmt.Fprintf(writer, "lost\t%s\t%d bytes, %d chunks are garbage collected\n", path, change.FileSize, change.LostChunks)
+		case change.RecoveredChunks > 0:
+			fmt.Fprintf(writer, "%s\t%s\t%d bytes, %d chunks recovered\n", change.Action, path, change.FileSize, change.RecoveredChunks)
+		default:
+			fmt.Fprintf(writer, "%s\t%s\t%d bytes\n", change.Action, path, change.FileSize)
+		}
+	}
+
+	fmt.Fprintf(writer, "%d changes, %d lost entries\n", len(resp.Changes), lostCount)
+	if !*applyChanges && len(resp.Changes) > 0 {
+		fmt.Fprintf(writer, "use -force to apply the changes\n")
+	}
+	return nil
+
+}
+
+func parseRestoreTime(s string) (time.Time, error) {
+	if seconds, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02T15:04:05", s, time.Local)
+	if err != nil {
+		return t, fmt.Errorf("invalid time %s", s)
+	}
+	return t, nil
+}
@@ -0,0 +1,65 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsSnapshotCreate{})
+}
+
+type commandFsSnapshotCreate struct {
+}
+
+func (c *commandFsSnapshotCreate) Name() string {
+	return "fs.snapshot.create"
+}
+
+func (c *commandFsSnapshotCreate) Help() string {
+	return `create a read-only snapshot of a directory tree
+
+	The snapshot shares the file chunks with the directory, and the chunks are kept until
+	the last snapshot using them is deleted. The snapshot is read by <directory>/.snapshots/<name>.
+
+	fs.snapshot.create /buckets/warehouse                       # the name defaults to the current time
+	fs.snapshot.create -name=before-migration /buckets/warehouse
+
+`
+}
+
+func (c *commandFsSnapshotCreate) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	snapshotCreateCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	name := snapshotCreateCommand.String("name", time.Now().Format("20060102-150405"), "the snapshot name")
+	if err = snapshotCreateCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	dir, err := commandEnv.parseUrl(findInputDirectory(snapshotCreateCommand.Args()))
+	if err != nil {
+		return err
+	}
+
+	err = commandEnv.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.CreateSnapshot(context.Background(), &filer_pb.CreateSnapshotRequest{
+			Directory: dir,
+			Name:      *name,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(writer, "created snapshot %s\n", util.NewFullPath(dir, filer.SnapshotsDirName).Child(*name))
+	return nil
+
+}
@@ -0,0 +1,55 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsSnapshotDelete{})
+}
+
+type commandFsSnapshotDelete struct {
+}
+
+func (c *commandFsSnapshotDelete) Name() string {
+	return "fs.snapshot.delete"
+}
+
+func (c *commandFsSnapshotDelete) Help() string {
+	return `delete a snapshot of a directory tree, and the file chunks only used by the snapshot
+
+	fs.snapshot.delete -name=before-migration /buckets/warehouse
+
+`
+}
+
+func (c *commandFsSnapshotDelete) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	snapshotDeleteCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	name := snapshotDeleteCommand.String("name", "", "the snapshot name")
+	if err = snapshotDeleteCommand.Parse(args); err != nil {
+		return nil
+	}
+	if *name == "" {
+		return fmt.Errorf("need the snapshot name")
+	}
+
+	dir, err := commandEnv.parseUrl(findInputDirectory(snapshotDeleteCommand.Args()))
+	if err != nil {
+		return err
+	}
+
+	return commandEnv.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+		_, err := client.DeleteSnapshot(context.Background(), &filer_pb.DeleteSnapshotRequest{
+			Directory: dir,
+			Name:      *name,
+		})
+		return err
+	})
+
+}
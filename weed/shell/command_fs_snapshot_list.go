@@ -0,0 +1,58 @@
+package shell
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/util"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsSnapshotList{})
+}
+
+type commandFsSnapshotList struct {
+}
+
+func (c *commandFsSnapshotList) Name() string {
+	return "fs.snapshot.list"
+}
+
+func (c *commandFsSnapshotList) Help() string {
+	return `list the snapshots of directory trees
+
+	fs.snapshot.list                       # list all snapshots
+	fs.snapshot.list /buckets/warehouse    # list the snapshots of the directory
+
+`
+}
+
+func (c *commandFsSnapshotList) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	var dir string
+	if len(args) > 0 {
+		if dir, err = commandEnv.parseUrl(args[0]); err != nil {
+			return err
+		}
+		dir = strings.TrimSuffix(dir, "/")
+	}
+
+	return filer_pb.ReadDirAllEntries(commandEnv, util.FullPath(filer.DirectorySnapshots), "", func(entry *filer_pb.Entry, isLast bool) error {
+		snapshot := filer.ParseSnapshot(entry.Extended, time.Unix(entry.Attributes.Crtime, 0))
+		if snapshot == nil || (dir != "" && string(snapshot.Directory) != dir) {
+			return nil
+		}
+		state := "created"
+		if snapshot.IsCreating {
+			state = "creating"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", snapshot.CreatedAt.Format("2006-01-02 15:04:05"), state,
+			snapshot.Name, snapshot.Directory.Child(filer.SnapshotsDirName).Child(snapshot.Name))
+		return nil
+	})
+
+}
@@ -0,0 +1,118 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/security"
+	"github.com/chrislusf/seaweedfs/weed/util"
+	"google.golang.org/grpc/metadata"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsTrashList{})
+}
+
+type commandFsTrashList struct {
+}
+
+func (c *commandFsTrashList) Name() string {
+	return "fs.trash.list"
+}
+
+func (c *commandFsTrashList) Help() string {
+	return `list the deleted entries kept in the trash
+
+	The entries deleted under the locations configured with a trash retention, e.g.
+		fs.configure -locationPrefix=/home/ -trashRetentionDays=7 -apply
+	are kept in the trash until they expire, and can be restored by fs.trash.restore.
+	If the filers are configured with jwt.filer_signing.key, the trash is only read with the same key in security.toml.
+
+	fs.trash.list                 # list all trashed entries
+	fs.trash.list /home/chris     # list the trashed entries deleted under /home/chris
+
+`
+}
+
+func (c *commandFsTrashList) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	var pathPrefix string
+	if len(args) > 0 {
+		if pathPrefix, err = commandEnv.parseUrl(args[0]); err != nil {
+			return err
+		}
+	}
+
+	return listTrash(commandEnv, func(entry *filer_pb.Entry) error {
+		originalPath, deletedAt, expiresAt, found := filer.ParseTrashInfo(entry.Extended)
+		if !found || !strings.HasPrefix(originalPath, pathPrefix) {
+			return nil
+		}
+		size := "dir"
+		if !entry.IsDirectory {
+			size = fmt.Sprintf("%d", filer.FileSize(entry))
+		}
+		fmt.Fprintf(writer, "%s\tdeleted:%s\texpires:%s\t%10s\t%s\n", entry.Name,
+			deletedAt.Format("2006-01-02 15:04:05"), expiresAt.Format("2006-01-02 15:04:05"), size, originalPath)
+		return nil
+	})
+
+}
+
+// withTrashAdminJwt signs the access to the trash with the filer signing key in security.toml, which the filer verifies
+func withTrashAdminJwt(ctx context.Context) context.Context {
+	v := util.GetViper()
+	signingKey := security.SigningKey(v.GetString("jwt.filer_signing.key"))
+	if len(signingKey) == 0 {
+		return ctx
+	}
+	token := security.GenJwtForFilerServer(signingKey, v.GetInt("jwt.filer_signing.expires_after_seconds"), filer.TrashAdminKey)
+	return metadata.AppendToOutgoingContext(ctx, filer.TrashAdminKey, string(token))
+}
+
+// listTrash lists all the trashed entries, page by page
+func listTrash(commandEnv *CommandEnv, fn func(entry *filer_pb.Entry) error) error {
+
+	var limit uint32 = 1024
+	startFrom := ""
+	return commandEnv.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+		for {
+			ctx, cancel := context.WithCancel(withTrashAdminJwt(context.Background()))
+			stream, err := client.ListEntries(ctx, &filer_pb.ListEntriesRequest{
+				Directory:         filer.DirectoryTrash,
+				StartFromFileName: startFrom,
+				Limit:             limit,
+			})
+			if err != nil {
+				cancel()
+				return fmt.Errorf("list %s: %v", filer.DirectoryTrash, err)
+			}
+			var count uint32
+			for {
+				resp, recvErr := stream.Recv()
+				if recvErr == io.EOF {
+					break
+				}
+				if recvErr != nil {
+					cancel()
+					return fmt.Errorf("list %s: %v", filer.DirectoryTrash, recvErr)
+				}
+				count++
+				startFrom = resp.Entry.Name
+				if err = fn(resp.Entry); err != nil {
+					cancel()
+					return err
+				}
+			}
+			cancel()
+			if count < limit {
+				return nil
+			}
+		}
+	})
+
+}
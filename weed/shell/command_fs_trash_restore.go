@@ -0,0 +1,96 @@
+package shell
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+)
+
+func init() {
+	Commands = append(Commands, &commandFsTrashRestore{})
+}
+
+type commandFsTrashRestore struct {
+}
+
+func (c *commandFsTrashRestore) Name() string {
+	return "fs.trash.restore"
+}
+
+func (c *commandFsTrashRestore) Help() string {
+	return `restore a deleted entry from the trash
+
+	# restore by the name listed by fs.trash.list, to the original path
+	fs.trash.restore 1634567890123456789-report.pdf
+
+	# restore the last deleted entry of the original path
+	fs.trash.restore /home/chris/report.pdf
+
+	# restore to another path
+	fs.trash.restore -to=/home/chris/report.old.pdf /home/chris/report.pdf
+
+`
+}
+
+func (c *commandFsTrashRestore) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	trashRestoreCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	to := trashRestoreCommand.String("to", "", "restore to this path instead of the original path")
+	if err = trashRestoreCommand.Parse(args); err != nil {
+		return nil
+	}
+	if trashRestoreCommand.NArg() != 1 {
+		return fmt.Errorf("need the name of the trashed entry, or its original path")
+	}
+
+	name := trashRestoreCommand.Arg(0)
+	if strings.HasPrefix(name, "/") {
+		if name, err = findLastTrashed(commandEnv, name); err != nil {
+			return err
+		}
+	}
+
+	var targetPath string
+	if *to != "" {
+		if targetPath, err = commandEnv.parseUrl(*to); err != nil {
+			return err
+		}
+	}
+
+	return commandEnv.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.RestoreEntry(withTrashAdminJwt(context.Background()), &filer_pb.RestoreEntryRequest{
+			Name:       name,
+			TargetPath: targetPath,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(writer, "restored %s to %s\n", name, resp.Path)
+		return nil
+	})
+
+}
+
+// findLastTrashed returns the name of the last trashed entry deleted from the original path
+func findLastTrashed(commandEnv *CommandEnv, originalPath string) (name string, err error) {
+
+	var lastDeletedAt time.Time
+	err = listTrash(commandEnv, func(entry *filer_pb.Entry) error {
+		path, deletedAt, _, found := filer.ParseTrashInfo(entry.Extended)
+		if found && path == originalPath && !deletedAt.Before(lastDeletedAt) {
+			name, lastDeletedAt = entry.Name, deletedAt
+		}
+		return nil
+	})
+	if err == nil && name == "" {
+		err = fmt.Errorf("%s is not found in the trash", originalPath)
+	}
+	return
+
+}
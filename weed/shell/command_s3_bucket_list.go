@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"strings"
 
 	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
 )
@@ -45,14 +46,10 @@ func (c *commandS3BucketList) Do(args []string, commandEnv *CommandEnv, writer i
 		return fmt.Errorf("read buckets: %v", err)
 	}
 
+	var buckets []*filer_pb.Entry
 	err = filer_pb.List(commandEnv, filerBucketsPath, "", func(entry *filer_pb.Entry, isLast bool) error {
-		if !entry.IsDirectory {
-			return nil
-		}
-		if entry.Attributes.Replication == "" || entry.Attributes.Replication == "000" {
-			fmt.Fprintf(writer, "  %s\n", entry.Name)
-		} else {
-			fmt.Fprintf(writer, "  %s\t\t\treplication: %s\n", entry.Name, entry.Attributes.Replication)
+		if entry.IsDirectory {
+			buckets = append(buckets, entry)
 		}
 		return nil
 	}, "", false, math.MaxUint32)
@@ -60,6 +57,28 @@ func (c *commandS3BucketList) Do(args []string, commandEnv *CommandEnv, writer i
 		return fmt.Errorf("list buckets under %v: %v", filerBucketsPath, err)
 	}
 
+	usages, err := readBucketUsages(commandEnv, filerBucketsPath, buckets)
+	if err != nil {
+		return fmt.Errorf("read bucket usages: %v", err)
+	}
+
+	for _, entry := range buckets {
+		fmt.Fprintf(writer, "  %s", entry.Name)
+		if usage, found := usages[entry.Name]; found {
+			fmt.Fprintf(writer, "	size:%d	file:%d", usage.UsedBytes, usage.UsedObjects)
+			if usage.QuotaBytes > 0 {
+				fmt.Fprintf(writer, "	quota size:%d", usage.QuotaBytes)
+			}
+			if usage.QuotaObjects > 0 {
+				fmt.Fprintf(writer, "	quota file:%d", usage.QuotaObjects)
+			}
+		}
+		if entry.Attributes.Replication != "" && entry.Attributes.Replication != "000" {
+			fmt.Fprintf(writer, "	replication: %s", entry.Attributes.Replication)
+		}
+		fmt.Fprintln(writer)
+	}
+
 	return err
 
 }
@@ -79,3 +98,27 @@ func readFilerBucketsPath(filerClient filer_pb.FilerClient) (filerBucketsPath st
 
 	return filerBucketsPath, err
 }
+
+// readBucketUsages reads the usages and the quotas of the buckets tracked by the filer
+func readBucketUsages(filerClient filer_pb.FilerClient, filerBucketsPath string, buckets []*filer_pb.Entry) (usages map[string]*filer_pb.DirectoryUsage, err error) {
+	usages = make(map[string]*filer_pb.DirectoryUsage)
+	if len(buckets) == 0 {
+		return
+	}
+	request := &filer_pb.GetDirectoryUsageRequest{}
+	for _, entry := range buckets {
+		request.LocationPrefixes = append(request.LocationPrefixes, filerBucketsPath+"/"+entry.Name+"/")
+	}
+	err = filerClient.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+		resp, err := client.GetDirectoryUsage(context.Background(), request)
+		if err != nil {
+			return err
+		}
+		for _, usage := range resp.Usages {
+			bucket := strings.TrimSuffix(strings.TrimPrefix(usage.LocationPrefix, filerBucketsPath+"/"), "/")
+			usages[bucket] = usage
+		}
+		return nil
+	})
+	return
+}
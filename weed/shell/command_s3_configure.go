@@ -29,6 +29,16 @@ func (c *commandS3Configure) Help() string {
 
 	# see the current configuration file content
 	s3.configure
+
+	# limit the total size and the number of objects in the buckets owned by a user, 0 for no limit
+	s3.configure -user=me -quotaBytes=10737418240 -quotaObjects=1000000 -apply
+
+	# put a user in an account, with its own bucket namespace
+	s3.configure -user=me -account=acme -actions=Read,Write,List -apply
+
+	# limit the requests per second and the bytes per second of a user, or of buckets, 0 for no limit
+	s3.configure -user=me -writeRequests=100 -writeBytes=104857600 -apply
+	s3.configure -buckets=logs,acme@logs -readRequests=1000 -readBytes=0 -apply
 	`
 }
 
@@ -40,6 +50,13 @@ func (c *commandS3Configure) Do(args []string, commandEnv *CommandEnv, writer io
 	buckets := s3ConfigureCommand.String("buckets", "", "bucket name")
 	accessKey := s3ConfigureCommand.String("access_key", "", "specify the access key")
 	secretKey := s3ConfigureCommand.String("secret_key", "", "specify the secret key")
+	quotaBytes := s3ConfigureCommand.Int64("quotaBytes", -1, "the total size limit of the buckets owned by the user, 0 for no limit")
+	quotaObjects := s3ConfigureCommand.Int64("quotaObjects", -1, "the limit of the number of objects in the buckets owned by the user, 0 for no limit")
+	readRequests := s3ConfigureCommand.Int64("readRequests", -1, "the GET and HEAD requests per second of the user or the buckets, 0 for no limit")
+	writeRequests := s3ConfigureCommand.Int64("writeRequests", -1, "the other requests per second of the user or the buckets, 0 for no limit")
+	readBytes := s3ConfigureCommand.Int64("readBytes", -1, "the download bytes per second of the user or the buckets, 0 for no limit")
+	writeBytes := s3ConfigureCommand.Int64("writeBytes", -1, "the upload bytes per second of the user or the buckets, 0 for no limit")
+	account := s3ConfigureCommand.String("account", "", "the account of the user, which owns a separate bucket namespace")
 	isDelete := s3ConfigureCommand.Bool("delete", false, "delete users, actions or access keys")
 	apply := s3ConfigureCommand.Bool("apply", false, "update and apply s3 configuration")
 
@@ -61,6 +78,22 @@ func (c *commandS3Configure) Do(args []string, commandEnv *CommandEnv, writer io
 		}
 	}
 
+	rateLimit := func(limit *iam_pb.RateLimit) *iam_pb.RateLimit {
+		return setRateLimit(limit, *readRequests, *writeRequests, *readBytes, *writeBytes)
+	}
+	if *user == "" && *buckets != "" {
+		for _, bucket := range strings.Split(*buckets, ",") {
+			if limit := rateLimit(s3cfg.BucketRateLimits[bucket]); limit != nil {
+				if s3cfg.BucketRateLimits == nil {
+					s3cfg.BucketRateLimits = make(map[string]*iam_pb.RateLimit)
+				}
+				s3cfg.BucketRateLimits[bucket] = limit
+			} else {
+				delete(s3cfg.BucketRateLimits, bucket)
+			}
+		}
+	}
+
 	idx := 0
 	changed := false
 	if *user != "" {
@@ -119,6 +152,11 @@ func (c *commandS3Configure) Do(args []string, commandEnv *CommandEnv, writer io
 				s3cfg.Identities = append(s3cfg.Identities[:idx], s3cfg.Identities[idx+1:]...)
 			}
 		} else {
+			setIdentityQuota(s3cfg.Identities[idx], *quotaBytes, *quotaObjects)
+			s3cfg.Identities[idx].RateLimit = rateLimit(s3cfg.Identities[idx].RateLimit)
+			if *account != "" {
+				s3cfg.Identities[idx].Account = *account
+			}
 			if *actions != "" {
 				for _, cmdAction := range cmdActions {
 					found := false
@@ -155,11 +193,14 @@ func (c *commandS3Configure) Do(args []string, commandEnv *CommandEnv, writer io
 			Name:        *user,
 			Actions:     cmdActions,
 			Credentials: []*iam_pb.Credential{},
+			Account:     *account,
 		}
 		if *user != "anonymous" {
 			identity.Credentials = append(identity.Credentials,
 				&iam_pb.Credential{AccessKey: *accessKey, SecretKey: *secretKey})
 		}
+		setIdentityQuota(&identity, *quotaBytes, *quotaObjects)
+		identity.RateLimit = rateLimit(nil)
 		s3cfg.Identities = append(s3cfg.Identities, &identity)
 	}
 
@@ -181,3 +222,36 @@ func (c *commandS3Configure) Do(args []string, commandEnv *CommandEnv, writer io
 
 	return nil
 }
+
+// setIdentityQuota changes the quotas which are not negative
+func setIdentityQuota(identity *iam_pb.Identity, quotaBytes, quotaObjects int64) {
+	if quotaBytes >= 0 {
+		identity.QuotaBytes = uint64(quotaBytes)
+	}
+	if quotaObjects >= 0 {
+		identity.QuotaObjects = uint64(quotaObjects)
+	}
+}
+
+// setRateLimit changes the limits which are not negative, and returns nil if there is no limit left
+func setRateLimit(limit *iam_pb.RateLimit, readRequests, writeRequests, readBytes, writeBytes int64) *iam_pb.RateLimit {
+	if limit == nil {
+		limit = &iam_pb.RateLimit{}
+	}
+	if readRequests >= 0 {
+		limit.ReadRequests = uint64(readRequests)
+	}
+	if writeRequests >= 0 {
+		limit.WriteRequests = uint64(writeRequests)
+	}
+	if readBytes >= 0 {
+		limit.ReadBytes = uint64(readBytes)
+	}
+	if writeBytes >= 0 {
+		limit.WriteBytes = uint64(writeBytes)
+	}
+	if limit.ReadRequests == 0 && limit.WriteRequests == 0 && limit.ReadBytes == 0 && limit.WriteBytes == 0 {
+		return nil
+	}
+	return limit
+}
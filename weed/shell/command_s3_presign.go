@@ -0,0 +1,168 @@
+package shell
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/chrislusf/seaweedfs/weed/filer"
+	"github.com/chrislusf/seaweedfs/weed/pb/filer_pb"
+	"github.com/chrislusf/seaweedfs/weed/pb/iam_pb"
+	"github.com/chrislusf/seaweedfs/weed/s3api/s3presign"
+)
+
+func init() {
+	Commands = append(Commands, &commandS3Presign{})
+}
+
+type commandS3Presign struct {
+}
+
+func (c *commandS3Presign) Name() string {
+	return "s3.presign"
+}
+
+func (c *commandS3Presign) Help() string {
+	return `generate presigned urls for the requests of a user
+
+	# download an object, the url is valid for one hour
+	s3.presign -user=me -bucket=photos -object=2021/a.jpg
+
+	# upload an object, the client must send the same content type and length
+	s3.presign -user=me -method=PUT -bucket=photos -object=2021/b.jpg -contentType=image/jpeg -contentLength=1048576 -expires=10m
+
+	# start a multipart upload, upload its first 20 parts, and complete it
+	s3.presign -user=me -method=POST -bucket=videos -object=a.mp4 -uploads
+	s3.presign -user=me -method=PUT -bucket=videos -object=a.mp4 -uploadId=<uploadId> -parts=20 -expires=24h
+	s3.presign -user=me -method=POST -bucket=videos -object=a.mp4 -uploadId=<uploadId>
+
+	The requests are still checked against the actions allowed to the user.
+`
+}
+
+func (c *commandS3Presign) Do(args []string, commandEnv *CommandEnv, writer io.Writer) (err error) {
+
+	presignCommand := flag.NewFlagSet(c.Name(), flag.ContinueOnError)
+	user := presignCommand.String("user", "", "the user signing the requests")
+	accessKey := presignCommand.String("access_key", "", "the access key of the user, the first one if not specified")
+	endpoint := presignCommand.String("endpoint", "http://localhost:8333", "the s3 endpoint the urls point to")
+	region := presignCommand.String("region", "us-east-1", "the region of the signature")
+	method := presignCommand.String("method", http.MethodGet, "the http method: GET, HEAD, PUT, POST or DELETE")
+	bucket := presignCommand.String("bucket", "", "the bucket name")
+	object := presignCommand.String("object", "", "the object key")
+	expires := presignCommand.Duration("expires", time.Hour, "how long the urls are valid, at most 168h")
+	contentType := presignCommand.String("contentType", "", "the content type the upload must have")
+	contentLength := presignCommand.Int64("contentLength", -1, "the exact size the upload must have")
+	contentMd5 := presignCommand.String("contentMd5", "", "the base64 encoded md5 the upload must have")
+	uploads := presignCommand.Bool("uploads", false, "start a multipart upload")
+	uploadId := presignCommand.String("uploadId", "", "the multipart upload id, to upload parts or to complete the upload")
+	partNumber := presignCommand.Int("partNumber", 0, "upload one part of the multipart upload")
+	parts := presignCommand.Int("parts", 0, "upload the parts numbered from 1 to this number")
+	if err = presignCommand.Parse(args); err != nil {
+		return nil
+	}
+
+	if *user == "" || *bucket == "" {
+		return fmt.Errorf("need -user and -bucket")
+	}
+	if (*partNumber > 0 || *parts > 0) && *uploadId == "" {
+		return fmt.Errorf("uploading parts needs -uploadId")
+	}
+
+	cred, err := readUserCredential(commandEnv, *user, *accessKey)
+	if err != nil {
+		return err
+	}
+
+	opts := s3presign.Options{
+		Endpoint:  *endpoint,
+		Region:    *region,
+		AccessKey: cred.AccessKey,
+		SecretKey: cred.SecretKey,
+		Method:    *method,
+		Bucket:    *bucket,
+		Object:    *object,
+		Expires:   *expires,
+		Query:     make(url.Values),
+		Headers:   make(http.Header),
+	}
+	if *contentType != "" {
+		opts.Headers.Set("Content-Type", *contentType)
+	}
+	if *contentLength >= 0 {
+		opts.Headers.Set("Content-Length", strconv.FormatInt(*contentLength, 10))
+	}
+	if *contentMd5 != "" {
+		opts.Headers.Set("Content-Md5", *contentMd5)
+	}
+	if *uploads {
+		opts.Query.Set("uploads", "")
+	}
+	if *uploadId != "" {
+		opts.Query.Set("uploadId", *uploadId)
+	}
+
+	var partNumbers []int
+	switch {
+	case *parts > 0:
+		for i := 1; i <= *parts; i++ {
+			partNumbers = append(partNumbers, i)
+		}
+	case *partNumber > 0:
+		partNumbers = append(partNumbers, *partNumber)
+	}
+
+	if len(partNumbers) == 0 {
+		presignedUrl, err := s3presign.PresignV4(opts)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(writer, "%s\n", presignedUrl)
+		return nil
+	}
+	for _, n := range partNumbers {
+		opts.Query.Set("partNumber", strconv.Itoa(n))
+		presignedUrl, err := s3presign.PresignV4(opts)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(writer, "%d\t%s\n", n, presignedUrl)
+	}
+
+	return nil
+}
+
+func readUserCredential(commandEnv *CommandEnv, user, accessKey string) (*iam_pb.Credential, error) {
+
+	var buf bytes.Buffer
+	if err := commandEnv.WithFilerClient(func(client filer_pb.SeaweedFilerClient) error {
+		return filer.ReadEntry(commandEnv.MasterClient, client, filer.IamConfigDirecotry, filer.IamIdentityFile, &buf)
+	}); err != nil && err != filer_pb.ErrNotFound {
+		return nil, err
+	}
+
+	s3cfg := &iam_pb.S3ApiConfiguration{}
+	if buf.Len() > 0 {
+		if err := filer.ParseS3ConfigurationFromBytes(buf.Bytes(), s3cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, identity := range s3cfg.Identities {
+		if identity.Name != user {
+			continue
+		}
+		for _, cred := range identity.Credentials {
+			if accessKey == "" || cred.AccessKey == accessKey {
+				return cred, nil
+			}
+		}
+		return nil, fmt.Errorf("user %s has no access key %s", user, accessKey)
+	}
+	return nil, fmt.Errorf("user %s not found", user)
+}
@@ -0,0 +1,133 @@
+package fastcdc
+
+import (
+	"io"
+	"math/bits"
+)
+
+// Chunker splits a stream by the content, with the FastCDC algorithm.
+// The same data is split at the same boundaries wherever it is in the stream,
+// so the unchanged parts of a modified file are still split into the same chunks.
+type Chunker struct {
+	reader  io.Reader
+	minSize int
+	avgSize int
+	maxSize int
+	// the masks with more bits are used before the average size, and the ones with less bits after it
+	maskS uint64
+	maskL uint64
+
+	buf   []byte
+	start int
+	end   int
+	eof   bool
+}
+
+var gear [256]uint64
+
+func init() {
+	// the boundaries should never change, so the gear table is generated by a fixed splitmix64 sequence
+	x := uint64(0x5EED5EED5EED5EED)
+	for i := range gear {
+		x += 0x9E3779B97F4A7C15
+		z := x
+		z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+		z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+		gear[i] = z ^ (z >> 31)
+	}
+}
+
+// NewChunker creates a chunker with the chunk sizes between minSize and maxSize, mostly around avgSize
+func NewChunker(reader io.Reader, minSize, avgSize, maxSize int) *Chunker {
+	if avgSize < 64 {
+		avgSize = 64
+	}
+	if minSize <= 0 || minSize > avgSize {
+		minSize = avgSize / 4
+	}
+	if maxSize < avgSize {
+		maxSize = avgSize * 4
+	}
+	avgBits := bits.Len(uint(avgSize)) - 1
+	return &Chunker{
+		reader:  reader,
+		minSize: minSize,
+		avgSize: avgSize,
+		maxSize: maxSize,
+		maskS:   topBitsMask(avgBits + 1),
+		maskL:   topBitsMask(avgBits - 1),
+		buf:     make([]byte, maxSize),
+	}
+}
+
+// the rolling hash shifts to the left, so its top bits are from the last 64 bytes
+func topBitsMask(n int) uint64 {
+	return ^uint64(0) << (64 - n)
+}
+
+// MinSize is the smallest chunk size, except for the last chunk
+func (c *Chunker) MinSize() int {
+	return c.minSize
+}
+
+// Next returns the next chunk, which is valid until the next call, or io.EOF after the last chunk
+func (c *Chunker) Next() ([]byte, error) {
+	if err := c.fill(); err != nil {
+		return nil, err
+	}
+	if c.start == c.end {
+		return nil, io.EOF
+	}
+	n := c.cut(c.buf[c.start:c.end])
+	chunk := c.buf[c.start : c.start+n]
+	c.start += n
+	return chunk, nil
+}
+
+// fill reads until the buffer has maxSize bytes, or the reader is exhausted
+func (c *Chunker) fill() error {
+	if c.eof || c.end-c.start >= c.maxSize {
+		return nil
+	}
+	copy(c.buf, c.buf[c.start:c.end])
+	c.end -= c.start
+	c.start = 0
+	n, err := io.ReadFull(c.reader, c.buf[c.end:])
+	c.end += n
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		c.eof = true
+		return nil
+	}
+	return err
+}
+
+// cut returns the length of the first chunk of the data
+func (c *Chunker) cut(data []byte) int {
+	n := len(data)
+	if n <= c.minSize {
+		return n
+	}
+	if n > c.maxSize {
+		n = c.maxSize
+	}
+	normalSize := c.avgSize
+	if n < normalSize {
+		normalSize = n
+	}
+
+	var fp uint64
+	i := c.minSize
+	for ; i < normalSize; i++ {
+		fp = (fp << 1) + gear[data[i]]
+		if fp&c.maskS == 0 {
+			return i
+		}
+	}
+	for ; i < n; i++ {
+		fp = (fp << 1) + gear[data[i]]
+		if fp&c.maskL == 0 {
+			return i
+		}
+	}
+	return n
+}
@@ -0,0 +1,62 @@
+package fastcdc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func split(t *testing.T, data []byte) (chunks [][32]byte) {
+	chunker := NewChunker(bytes.NewReader(data), 2*1024, 8*1024, 32*1024)
+	var total int
+	for {
+		chunk, err := chunker.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		assert.True(t, len(chunk) <= 32*1024)
+		total += len(chunk)
+		chunks = append(chunks, sha256.Sum256(chunk))
+	}
+	assert.Equal(t, len(data), total)
+	return
+}
+
+func TestChunkerBoundaries(t *testing.T) {
+
+	data := make([]byte, 1024*1024)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	original := split(t, data)
+	assert.True(t, len(original) > 1024*1024/32/1024, "%d chunks", len(original))
+	assert.True(t, len(original) < 1024*1024/2/1024, "%d chunks", len(original))
+
+	// insert some bytes in the middle, and most chunks should be the same
+	modified := append(append(append([]byte{}, data[:500000]...), []byte("inserted")...), data[500000:]...)
+	changed := split(t, modified)
+
+	existing := make(map[[32]byte]bool)
+	for _, h := range original {
+		existing[h] = true
+	}
+	var same int
+	for _, h := range changed {
+		if existing[h] {
+			same++
+		}
+	}
+	assert.True(t, same >= len(changed)-3, "%d of %d chunks are the same", same, len(changed))
+
+}
+
+func TestChunkerSmallData(t *testing.T) {
+
+	assert.Equal(t, 0, len(split(t, nil)))
+	assert.Equal(t, 1, len(split(t, []byte("hello"))))
+
+}